@@ -0,0 +1,1027 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: ride/payments/events/v1/events.proto
+
+package eventsv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransactionCreated_Type int32
+
+const (
+	TransactionCreated_TYPE_UNSPECIFIED TransactionCreated_Type = 0
+	TransactionCreated_TYPE_DEBIT       TransactionCreated_Type = 1
+	TransactionCreated_TYPE_CREDIT      TransactionCreated_Type = 2
+)
+
+// Enum value maps for TransactionCreated_Type.
+var (
+	TransactionCreated_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_DEBIT",
+		2: "TYPE_CREDIT",
+	}
+	TransactionCreated_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_DEBIT":       1,
+		"TYPE_CREDIT":      2,
+	}
+)
+
+func (x TransactionCreated_Type) Enum() *TransactionCreated_Type {
+	p := new(TransactionCreated_Type)
+	*p = x
+	return p
+}
+
+func (x TransactionCreated_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionCreated_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_events_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionCreated_Type) Type() protoreflect.EnumType {
+	return &file_ride_payments_events_v1_events_proto_enumTypes[0]
+}
+
+func (x TransactionCreated_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionCreated_Type.Descriptor instead.
+func (TransactionCreated_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type PayoutStatusChanged_Status int32
+
+const (
+	PayoutStatusChanged_STATUS_UNSPECIFIED PayoutStatusChanged_Status = 0
+	PayoutStatusChanged_STATUS_PENDING     PayoutStatusChanged_Status = 1
+	PayoutStatusChanged_STATUS_SUCCESS     PayoutStatusChanged_Status = 2
+	PayoutStatusChanged_STATUS_FAILED      PayoutStatusChanged_Status = 3
+	PayoutStatusChanged_STATUS_CANCELLED   PayoutStatusChanged_Status = 4
+)
+
+// Enum value maps for PayoutStatusChanged_Status.
+var (
+	PayoutStatusChanged_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_SUCCESS",
+		3: "STATUS_FAILED",
+		4: "STATUS_CANCELLED",
+	}
+	PayoutStatusChanged_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_SUCCESS":     2,
+		"STATUS_FAILED":      3,
+		"STATUS_CANCELLED":   4,
+	}
+)
+
+func (x PayoutStatusChanged_Status) Enum() *PayoutStatusChanged_Status {
+	p := new(PayoutStatusChanged_Status)
+	*p = x
+	return p
+}
+
+func (x PayoutStatusChanged_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayoutStatusChanged_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_events_v1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (PayoutStatusChanged_Status) Type() protoreflect.EnumType {
+	return &file_ride_payments_events_v1_events_proto_enumTypes[1]
+}
+
+func (x PayoutStatusChanged_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayoutStatusChanged_Status.Descriptor instead.
+func (PayoutStatusChanged_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type RechargeStatusChanged_Status int32
+
+const (
+	RechargeStatusChanged_STATUS_UNSPECIFIED RechargeStatusChanged_Status = 0
+	RechargeStatusChanged_STATUS_PENDING     RechargeStatusChanged_Status = 1
+	RechargeStatusChanged_STATUS_SUCCESS     RechargeStatusChanged_Status = 2
+	RechargeStatusChanged_STATUS_FAILED      RechargeStatusChanged_Status = 3
+)
+
+// Enum value maps for RechargeStatusChanged_Status.
+var (
+	RechargeStatusChanged_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_SUCCESS",
+		3: "STATUS_FAILED",
+	}
+	RechargeStatusChanged_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_SUCCESS":     2,
+		"STATUS_FAILED":      3,
+	}
+)
+
+func (x RechargeStatusChanged_Status) Enum() *RechargeStatusChanged_Status {
+	p := new(RechargeStatusChanged_Status)
+	*p = x
+	return p
+}
+
+func (x RechargeStatusChanged_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RechargeStatusChanged_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_events_v1_events_proto_enumTypes[2].Descriptor()
+}
+
+func (RechargeStatusChanged_Status) Type() protoreflect.EnumType {
+	return &file_ride_payments_events_v1_events_proto_enumTypes[2]
+}
+
+func (x RechargeStatusChanged_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RechargeStatusChanged_Status.Descriptor instead.
+func (RechargeStatusChanged_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type PayoutAccountUpdated_DestinationType int32
+
+const (
+	PayoutAccountUpdated_DESTINATION_TYPE_UNSPECIFIED  PayoutAccountUpdated_DestinationType = 0
+	PayoutAccountUpdated_DESTINATION_TYPE_BANK_ACCOUNT PayoutAccountUpdated_DestinationType = 1
+	PayoutAccountUpdated_DESTINATION_TYPE_UPI          PayoutAccountUpdated_DestinationType = 2
+)
+
+// Enum value maps for PayoutAccountUpdated_DestinationType.
+var (
+	PayoutAccountUpdated_DestinationType_name = map[int32]string{
+		0: "DESTINATION_TYPE_UNSPECIFIED",
+		1: "DESTINATION_TYPE_BANK_ACCOUNT",
+		2: "DESTINATION_TYPE_UPI",
+	}
+	PayoutAccountUpdated_DestinationType_value = map[string]int32{
+		"DESTINATION_TYPE_UNSPECIFIED":  0,
+		"DESTINATION_TYPE_BANK_ACCOUNT": 1,
+		"DESTINATION_TYPE_UPI":          2,
+	}
+)
+
+func (x PayoutAccountUpdated_DestinationType) Enum() *PayoutAccountUpdated_DestinationType {
+	p := new(PayoutAccountUpdated_DestinationType)
+	*p = x
+	return p
+}
+
+func (x PayoutAccountUpdated_DestinationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayoutAccountUpdated_DestinationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_events_v1_events_proto_enumTypes[3].Descriptor()
+}
+
+func (PayoutAccountUpdated_DestinationType) Type() protoreflect.EnumType {
+	return &file_ride_payments_events_v1_events_proto_enumTypes[3]
+}
+
+func (x PayoutAccountUpdated_DestinationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayoutAccountUpdated_DestinationType.Descriptor instead.
+func (PayoutAccountUpdated_DestinationType) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{4, 0}
+}
+
+// Published with the type "ride.payments.transaction.created" when a transaction is committed on a wallet.
+type TransactionCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Transaction, for example, "users/user1/wallet/transactions/transaction1"
+	Transaction string `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Relative resource name of the Wallet the transaction was committed on, for example, "users/user1/wallet"
+	Wallet string                  `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Type   TransactionCreated_Type `protobuf:"varint,3,opt,name=type,proto3,enum=ride.payments.events.v1.TransactionCreated_Type" json:"type,omitempty"`
+	// The transaction amount in the smallest currency unit.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 code of the currency of the amount, for example, "INR".
+	CurrencyCode string `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The balance of the wallet in the currency after the transaction.
+	BalanceAfter int64 `protobuf:"varint,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	// Id of the batch the transaction was created in, if any.
+	BatchId string `protobuf:"bytes,7,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Relative resource name of the Transaction reversed by this transaction, if any.
+	ReversalOf string `protobuf:"bytes,8,opt,name=reversal_of,json=reversalOf,proto3" json:"reversal_of,omitempty"`
+	// Reference of the transaction given by its creator, for example the id of a ride.
+	Reference  string                 `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *TransactionCreated) Reset() {
+	*x = TransactionCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_events_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCreated) ProtoMessage() {}
+
+func (x *TransactionCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_events_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCreated.ProtoReflect.Descriptor instead.
+func (*TransactionCreated) Descriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransactionCreated) GetTransaction() string {
+	if x != nil {
+		return x.Transaction
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetType() TransactionCreated_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionCreated_TYPE_UNSPECIFIED
+}
+
+func (x *TransactionCreated) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionCreated) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *TransactionCreated) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetReversalOf() string {
+	if x != nil {
+		return x.ReversalOf
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransactionCreated) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Published with the type "ride.payments.transfer.created" when a transfer between two wallets is created.
+type TransferCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Transfer, for example, "users/user1/wallet/transfers/transfer1"
+	Transfer string `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Relative resource name of the Wallet the amount is transferred from, for example, "users/user1/wallet"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Relative resource name of the Wallet the amount is transferred to, for example, "users/user2/wallet"
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The transfer amount in the smallest currency unit.
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 code of the currency of the amount, for example, "INR".
+	CurrencyCode string `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Reference of the transfer given by its creator.
+	Reference  string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+}
+
+func (x *TransferCreated) Reset() {
+	*x = TransferCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_events_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCreated) ProtoMessage() {}
+
+func (x *TransferCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_events_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCreated.ProtoReflect.Descriptor instead.
+func (*TransferCreated) Descriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferCreated) GetTransfer() string {
+	if x != nil {
+		return x.Transfer
+	}
+	return ""
+}
+
+func (x *TransferCreated) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TransferCreated) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *TransferCreated) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferCreated) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *TransferCreated) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransferCreated) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+// Published with the type "ride.payments.payout.status_changed" when a payout is created or its status changes.
+type PayoutStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Payout, for example, "users/user1/wallet/payouts/payout1"
+	Payout string `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	// Relative resource name of the Wallet the payout is sent from, for example, "users/user1/wallet"
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// The payout amount in the smallest currency unit.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 code of the currency of the amount, for example, "INR".
+	CurrencyCode string                     `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Status       PayoutStatusChanged_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ride.payments.events.v1.PayoutStatusChanged_Status" json:"status,omitempty"`
+	// Id of the transaction that debited the wallet, set once the payout succeeds.
+	TransactionId string `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Why the payout failed, set once the payout fails.
+	FailureReason string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *PayoutStatusChanged) Reset() {
+	*x = PayoutStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_events_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutStatusChanged) ProtoMessage() {}
+
+func (x *PayoutStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_events_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutStatusChanged.ProtoReflect.Descriptor instead.
+func (*PayoutStatusChanged) Descriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PayoutStatusChanged) GetPayout() string {
+	if x != nil {
+		return x.Payout
+	}
+	return ""
+}
+
+func (x *PayoutStatusChanged) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *PayoutStatusChanged) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayoutStatusChanged) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *PayoutStatusChanged) GetStatus() PayoutStatusChanged_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PayoutStatusChanged_STATUS_UNSPECIFIED
+}
+
+func (x *PayoutStatusChanged) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PayoutStatusChanged) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *PayoutStatusChanged) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Published with the type "ride.payments.recharge.status_changed" when a recharge is created or its status changes.
+type RechargeStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Recharge, for example, "users/user1/wallet/recharges/recharge1"
+	Recharge string `protobuf:"bytes,1,opt,name=recharge,proto3" json:"recharge,omitempty"`
+	// Relative resource name of the Wallet being recharged, for example, "users/user1/wallet"
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// The recharge amount in the smallest currency unit.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 code of the currency of the amount, for example, "INR".
+	CurrencyCode string                       `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Status       RechargeStatusChanged_Status `protobuf:"varint,5,opt,name=status,proto3,enum=ride.payments.events.v1.RechargeStatusChanged_Status" json:"status,omitempty"`
+	// Id of the transaction that credited the wallet, set once the recharge succeeds.
+	TransactionId string `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Why the recharge failed, set once the recharge fails.
+	FailureReason string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *RechargeStatusChanged) Reset() {
+	*x = RechargeStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_events_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RechargeStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeStatusChanged) ProtoMessage() {}
+
+func (x *RechargeStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_events_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeStatusChanged.ProtoReflect.Descriptor instead.
+func (*RechargeStatusChanged) Descriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RechargeStatusChanged) GetRecharge() string {
+	if x != nil {
+		return x.Recharge
+	}
+	return ""
+}
+
+func (x *RechargeStatusChanged) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *RechargeStatusChanged) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeStatusChanged) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *RechargeStatusChanged) GetStatus() RechargeStatusChanged_Status {
+	if x != nil {
+		return x.Status
+	}
+	return RechargeStatusChanged_STATUS_UNSPECIFIED
+}
+
+func (x *RechargeStatusChanged) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RechargeStatusChanged) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *RechargeStatusChanged) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Published with the type "ride.payments.payout_account.updated" when the destination of a payout account changes.
+// Account numbers and UPI ids are not included.
+type PayoutAccountUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the PayoutAccount, for example, "users/user1/wallet/payout-account"
+	PayoutAccount string `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+	// Relative resource name of the Wallet of the payout account, for example, "users/user1/wallet"
+	Wallet                string                               `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	DestinationType       PayoutAccountUpdated_DestinationType `protobuf:"varint,3,opt,name=destination_type,json=destinationType,proto3,enum=ride.payments.events.v1.PayoutAccountUpdated_DestinationType" json:"destination_type,omitempty"`
+	DestinationUpdateTime *timestamppb.Timestamp               `protobuf:"bytes,4,opt,name=destination_update_time,json=destinationUpdateTime,proto3" json:"destination_update_time,omitempty"`
+}
+
+func (x *PayoutAccountUpdated) Reset() {
+	*x = PayoutAccountUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_events_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutAccountUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutAccountUpdated) ProtoMessage() {}
+
+func (x *PayoutAccountUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_events_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutAccountUpdated.ProtoReflect.Descriptor instead.
+func (*PayoutAccountUpdated) Descriptor() ([]byte, []int) {
+	return file_ride_payments_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PayoutAccountUpdated) GetPayoutAccount() string {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return ""
+}
+
+func (x *PayoutAccountUpdated) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *PayoutAccountUpdated) GetDestinationType() PayoutAccountUpdated_DestinationType {
+	if x != nil {
+		return x.DestinationType
+	}
+	return PayoutAccountUpdated_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (x *PayoutAccountUpdated) GetDestinationUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DestinationUpdateTime
+	}
+	return nil
+}
+
+var File_ride_payments_events_v1_events_proto protoreflect.FileDescriptor
+
+var file_ride_payments_events_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xcc, 0x03, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x44, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x30, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
+	0x5f, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x6c, 0x4f, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x3d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x02, 0x22,
+	0xff, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xcd, 0x03, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x71,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x22, 0xbf, 0x03, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0x85, 0x03, 0x0a, 0x14, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x68, 0x0a, 0x10, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x52, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x70, 0x0a, 0x0f, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x49, 0x10, 0x02, 0x42, 0x4b, 0x5a, 0x49, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61,
+	0x70, 0x70, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ride_payments_events_v1_events_proto_rawDescOnce sync.Once
+	file_ride_payments_events_v1_events_proto_rawDescData = file_ride_payments_events_v1_events_proto_rawDesc
+)
+
+func file_ride_payments_events_v1_events_proto_rawDescGZIP() []byte {
+	file_ride_payments_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_ride_payments_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_ride_payments_events_v1_events_proto_rawDescData)
+	})
+	return file_ride_payments_events_v1_events_proto_rawDescData
+}
+
+var file_ride_payments_events_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ride_payments_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ride_payments_events_v1_events_proto_goTypes = []interface{}{
+	(TransactionCreated_Type)(0),              // 0: ride.payments.events.v1.TransactionCreated.Type
+	(PayoutStatusChanged_Status)(0),           // 1: ride.payments.events.v1.PayoutStatusChanged.Status
+	(RechargeStatusChanged_Status)(0),         // 2: ride.payments.events.v1.RechargeStatusChanged.Status
+	(PayoutAccountUpdated_DestinationType)(0), // 3: ride.payments.events.v1.PayoutAccountUpdated.DestinationType
+	(*TransactionCreated)(nil),                // 4: ride.payments.events.v1.TransactionCreated
+	(*TransferCreated)(nil),                   // 5: ride.payments.events.v1.TransferCreated
+	(*PayoutStatusChanged)(nil),               // 6: ride.payments.events.v1.PayoutStatusChanged
+	(*RechargeStatusChanged)(nil),             // 7: ride.payments.events.v1.RechargeStatusChanged
+	(*PayoutAccountUpdated)(nil),              // 8: ride.payments.events.v1.PayoutAccountUpdated
+	(*timestamppb.Timestamp)(nil),             // 9: google.protobuf.Timestamp
+}
+var file_ride_payments_events_v1_events_proto_depIdxs = []int32{
+	0, // 0: ride.payments.events.v1.TransactionCreated.type:type_name -> ride.payments.events.v1.TransactionCreated.Type
+	9, // 1: ride.payments.events.v1.TransactionCreated.create_time:type_name -> google.protobuf.Timestamp
+	9, // 2: ride.payments.events.v1.TransferCreated.create_time:type_name -> google.protobuf.Timestamp
+	1, // 3: ride.payments.events.v1.PayoutStatusChanged.status:type_name -> ride.payments.events.v1.PayoutStatusChanged.Status
+	9, // 4: ride.payments.events.v1.PayoutStatusChanged.update_time:type_name -> google.protobuf.Timestamp
+	2, // 5: ride.payments.events.v1.RechargeStatusChanged.status:type_name -> ride.payments.events.v1.RechargeStatusChanged.Status
+	9, // 6: ride.payments.events.v1.RechargeStatusChanged.update_time:type_name -> google.protobuf.Timestamp
+	3, // 7: ride.payments.events.v1.PayoutAccountUpdated.destination_type:type_name -> ride.payments.events.v1.PayoutAccountUpdated.DestinationType
+	9, // 8: ride.payments.events.v1.PayoutAccountUpdated.destination_update_time:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_ride_payments_events_v1_events_proto_init() }
+func file_ride_payments_events_v1_events_proto_init() {
+	if File_ride_payments_events_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ride_payments_events_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_events_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_events_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_events_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RechargeStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_events_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutAccountUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ride_payments_events_v1_events_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ride_payments_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_ride_payments_events_v1_events_proto_depIdxs,
+		EnumInfos:         file_ride_payments_events_v1_events_proto_enumTypes,
+		MessageInfos:      file_ride_payments_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_ride_payments_events_v1_events_proto = out.File
+	file_ride_payments_events_v1_events_proto_rawDesc = nil
+	file_ride_payments_events_v1_events_proto_goTypes = nil
+	file_ride_payments_events_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: ride/payments/v1alpha1/hold.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Hold_Status int32
+
+const (
+	Hold_STATUS_UNSPECIFIED Hold_Status = 0
+	// The funds are reserved.
+	Hold_STATUS_ACTIVE   Hold_Status = 1
+	Hold_STATUS_CAPTURED Hold_Status = 2
+	Hold_STATUS_RELEASED Hold_Status = 3
+	// The Hold expired before it was captured or released.
+	Hold_STATUS_EXPIRED Hold_Status = 4
+)
+
+// Enum value maps for Hold_Status.
+var (
+	Hold_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_ACTIVE",
+		2: "STATUS_CAPTURED",
+		3: "STATUS_RELEASED",
+		4: "STATUS_EXPIRED",
+	}
+	Hold_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_ACTIVE":      1,
+		"STATUS_CAPTURED":    2,
+		"STATUS_RELEASED":    3,
+		"STATUS_EXPIRED":     4,
+	}
+)
+
+func (x Hold_Status) Enum() *Hold_Status {
+	p := new(Hold_Status)
+	*p = x
+	return p
+}
+
+func (x Hold_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Hold_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_v1alpha1_hold_proto_enumTypes[0].Descriptor()
+}
+
+func (Hold_Status) Type() protoreflect.EnumType {
+	return &file_ride_payments_v1alpha1_hold_proto_enumTypes[0]
+}
+
+func (x Hold_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Hold_Status.Descriptor instead.
+func (Hold_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_hold_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A Hold reserves funds in a Wallet, for example for a ride in progress, until it is captured, released or expires.
+// Held funds are not part of Wallet.available_balance.
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Hold, for example, "users/user1/wallet/holds/hold1"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The amount to reserve in the smallest currency unit. **Must** be greater than 0.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The currency code of the amount. Defaults to "INR" if not set.
+	CurrencyCode string `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Output only. Current status of the Hold.
+	Status Hold_Status `protobuf:"varint,4,opt,name=status,proto3,enum=ride.payments.v1alpha1.Hold_Status" json:"status,omitempty"`
+	// Any type of identifier that explains what the funds are reserved for, for example a ride.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Timestamp after which the Hold no longer reserves funds. Defaults to a server configured duration after creation.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Output only. The amount moved out of the Wallet when the Hold was captured. The rest of the Hold is released.
+	CapturedAmount int64 `protobuf:"varint,7,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	// Output only. The batch id of the transactions created when the Hold was captured.
+	CaptureBatchId string `protobuf:"bytes,8,opt,name=capture_batch_id,json=captureBatchId,proto3" json:"capture_batch_id,omitempty"`
+	// Output only. Timestamp when the Hold was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. Timestamp when the Hold was last updated.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_hold_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_hold_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_hold_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Hold) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Hold) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() Hold_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Hold_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Hold) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Hold) GetCapturedAmount() int64 {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return 0
+}
+
+func (x *Hold) GetCaptureBatchId() string {
+	if x != nil {
+		return x.CaptureBatchId
+	}
+	return ""
+}
+
+func (x *Hold) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Hold) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+var File_ride_payments_v1alpha1_hold_proto protoreflect.FileDescriptor
+
+var file_ride_payments_v1alpha1_hold_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x16, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75,
+	0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x05, 0x0a, 0x04, 0x48, 0x6f,
+	0x6c, 0x64, 0x12, 0x50, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3c, 0xba, 0x48, 0x39, 0xd0, 0x01, 0x01, 0x72, 0x34, 0x32, 0x32, 0x5e, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x2f, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48,
+	0x11, 0xd0, 0x01, 0x01, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33,
+	0x7d, 0x24, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x3b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x28, 0x80, 0x01, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x71, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
+	0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x04, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72,
+	0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ride_payments_v1alpha1_hold_proto_rawDescOnce sync.Once
+	file_ride_payments_v1alpha1_hold_proto_rawDescData = file_ride_payments_v1alpha1_hold_proto_rawDesc
+)
+
+func file_ride_payments_v1alpha1_hold_proto_rawDescGZIP() []byte {
+	file_ride_payments_v1alpha1_hold_proto_rawDescOnce.Do(func() {
+		file_ride_payments_v1alpha1_hold_proto_rawDescData = protoimpl.X.CompressGZIP(file_ride_payments_v1alpha1_hold_proto_rawDescData)
+	})
+	return file_ride_payments_v1alpha1_hold_proto_rawDescData
+}
+
+var file_ride_payments_v1alpha1_hold_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ride_payments_v1alpha1_hold_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ride_payments_v1alpha1_hold_proto_goTypes = []interface{}{
+	(Hold_Status)(0),              // 0: ride.payments.v1alpha1.Hold.Status
+	(*Hold)(nil),                  // 1: ride.payments.v1alpha1.Hold
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_ride_payments_v1alpha1_hold_proto_depIdxs = []int32{
+	0, // 0: ride.payments.v1alpha1.Hold.status:type_name -> ride.payments.v1alpha1.Hold.Status
+	2, // 1: ride.payments.v1alpha1.Hold.expire_time:type_name -> google.protobuf.Timestamp
+	2, // 2: ride.payments.v1alpha1.Hold.create_time:type_name -> google.protobuf.Timestamp
+	2, // 3: ride.payments.v1alpha1.Hold.update_time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ride_payments_v1alpha1_hold_proto_init() }
+func file_ride_payments_v1alpha1_hold_proto_init() {
+	if File_ride_payments_v1alpha1_hold_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ride_payments_v1alpha1_hold_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ride_payments_v1alpha1_hold_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ride_payments_v1alpha1_hold_proto_goTypes,
+		DependencyIndexes: file_ride_payments_v1alpha1_hold_proto_depIdxs,
+		EnumInfos:         file_ride_payments_v1alpha1_hold_proto_enumTypes,
+		MessageInfos:      file_ride_payments_v1alpha1_hold_proto_msgTypes,
+	}.Build()
+	File_ride_payments_v1alpha1_hold_proto = out.File
+	file_ride_payments_v1alpha1_hold_proto_rawDesc = nil
+	file_ride_payments_v1alpha1_hold_proto_goTypes = nil
+	file_ride_payments_v1alpha1_hold_proto_depIdxs = nil
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: ride/payments/v1alpha1/ledger.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A LedgerDiscrepancy reports a Wallet whose balance in a currency does not match the sum of its Transactions in that currency,
+// or whose Transactions in that currency record running balances that do not match their amounts.
+type LedgerDiscrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Wallet string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// The balance stored on the Wallet, in smallest currency unit.
+	RecordedBalance int64 `protobuf:"varint,2,opt,name=recorded_balance,json=recordedBalance,proto3" json:"recorded_balance,omitempty"`
+	// The balance computed from the credits and debits of the Wallet, in smallest currency unit.
+	ComputedBalance int64 `protobuf:"varint,3,opt,name=computed_balance,json=computedBalance,proto3" json:"computed_balance,omitempty"`
+	// The recorded balance minus the computed balance.
+	Difference int64 `protobuf:"varint,4,opt,name=difference,proto3" json:"difference,omitempty"`
+	// The number of Transactions of the Wallet.
+	TransactionCount int32 `protobuf:"varint,5,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	// The number of Transactions of the Wallet that store the wallet id in the legacy walletId field.
+	LegacyTransactionCount int32 `protobuf:"varint,6,opt,name=legacy_transaction_count,json=legacyTransactionCount,proto3" json:"legacy_transaction_count,omitempty"`
+	// Whether the recorded balance was overwritten with the computed balance.
+	Repaired bool `protobuf:"varint,7,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	// The currency of the balances. For example, "INR".
+	CurrencyCode string `protobuf:"bytes,8,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Relative resource names of the Transactions whose balance after does not equal their balance before plus their amount.
+	InconsistentTransactions []string `protobuf:"bytes,9,rep,name=inconsistent_transactions,json=inconsistentTransactions,proto3" json:"inconsistent_transactions,omitempty"`
+}
+
+func (x *LedgerDiscrepancy) Reset() {
+	*x = LedgerDiscrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_ledger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerDiscrepancy) ProtoMessage() {}
+
+func (x *LedgerDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_ledger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerDiscrepancy.ProtoReflect.Descriptor instead.
+func (*LedgerDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LedgerDiscrepancy) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *LedgerDiscrepancy) GetRecordedBalance() int64 {
+	if x != nil {
+		return x.RecordedBalance
+	}
+	return 0
+}
+
+func (x *LedgerDiscrepancy) GetComputedBalance() int64 {
+	if x != nil {
+		return x.ComputedBalance
+	}
+	return 0
+}
+
+func (x *LedgerDiscrepancy) GetDifference() int64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *LedgerDiscrepancy) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *LedgerDiscrepancy) GetLegacyTransactionCount() int32 {
+	if x != nil {
+		return x.LegacyTransactionCount
+	}
+	return 0
+}
+
+func (x *LedgerDiscrepancy) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *LedgerDiscrepancy) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *LedgerDiscrepancy) GetInconsistentTransactions() []string {
+	if x != nil {
+		return x.InconsistentTransactions
+	}
+	return nil
+}
+
+var File_ride_payments_v1alpha1_ledger_proto protoreflect.FileDescriptor
+
+var file_ride_payments_v1alpha1_ledger_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x86, 0x03,
+	0x0a, 0x11, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x18, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x16, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x19, 0x69, 0x6e, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x69, 0x6e,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_ride_payments_v1alpha1_ledger_proto_rawDescOnce sync.Once
+	file_ride_payments_v1alpha1_ledger_proto_rawDescData = file_ride_payments_v1alpha1_ledger_proto_rawDesc
+)
+
+func file_ride_payments_v1alpha1_ledger_proto_rawDescGZIP() []byte {
+	file_ride_payments_v1alpha1_ledger_proto_rawDescOnce.Do(func() {
+		file_ride_payments_v1alpha1_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(file_ride_payments_v1alpha1_ledger_proto_rawDescData)
+	})
+	return file_ride_payments_v1alpha1_ledger_proto_rawDescData
+}
+
+var file_ride_payments_v1alpha1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ride_payments_v1alpha1_ledger_proto_goTypes = []interface{}{
+	(*LedgerDiscrepancy)(nil), // 0: ride.payments.v1alpha1.LedgerDiscrepancy
+}
+var file_ride_payments_v1alpha1_ledger_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ride_payments_v1alpha1_ledger_proto_init() }
+func file_ride_payments_v1alpha1_ledger_proto_init() {
+	if File_ride_payments_v1alpha1_ledger_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ride_payments_v1alpha1_ledger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerDiscrepancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ride_payments_v1alpha1_ledger_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ride_payments_v1alpha1_ledger_proto_goTypes,
+		DependencyIndexes: file_ride_payments_v1alpha1_ledger_proto_depIdxs,
+		MessageInfos:      file_ride_payments_v1alpha1_ledger_proto_msgTypes,
+	}.Build()
+	File_ride_payments_v1alpha1_ledger_proto = out.File
+	file_ride_payments_v1alpha1_ledger_proto_rawDesc = nil
+	file_ride_payments_v1alpha1_ledger_proto_goTypes = nil
+	file_ride_payments_v1alpha1_ledger_proto_depIdxs = nil
+}
@@ -23,6 +23,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Payout_Mode int32
+
+const (
+	Payout_MODE_UNSPECIFIED Payout_Mode = 0
+	// Instant transfer to a bank account, for amounts up to 5,00,000 INR.
+	Payout_MODE_IMPS Payout_Mode = 1
+	Payout_MODE_NEFT Payout_Mode = 2
+	// Transfer to a bank account, for amounts of at least 2,00,000 INR.
+	Payout_MODE_RTGS Payout_Mode = 3
+	Payout_MODE_UPI  Payout_Mode = 4
+	// Output only. The Payout is sent as a link that the user claims over SMS.
+	// Used when the PayoutAccount has no destination.
+	Payout_MODE_LINK Payout_Mode = 5
+)
+
+// Enum value maps for Payout_Mode.
+var (
+	Payout_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_IMPS",
+		2: "MODE_NEFT",
+		3: "MODE_RTGS",
+		4: "MODE_UPI",
+		5: "MODE_LINK",
+	}
+	Payout_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_IMPS":        1,
+		"MODE_NEFT":        2,
+		"MODE_RTGS":        3,
+		"MODE_UPI":         4,
+		"MODE_LINK":        5,
+	}
+)
+
+func (x Payout_Mode) Enum() *Payout_Mode {
+	p := new(Payout_Mode)
+	*p = x
+	return p
+}
+
+func (x Payout_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Payout_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_v1alpha1_payout_proto_enumTypes[0].Descriptor()
+}
+
+func (Payout_Mode) Type() protoreflect.EnumType {
+	return &file_ride_payments_v1alpha1_payout_proto_enumTypes[0]
+}
+
+func (x Payout_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Payout_Mode.Descriptor instead.
+func (Payout_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_payout_proto_rawDescGZIP(), []int{0, 0}
+}
+
 type Payout_Status int32
 
 const (
@@ -30,6 +92,8 @@ const (
 	Payout_STATUS_PENDING     Payout_Status = 1
 	Payout_STATUS_SUCCESS     Payout_Status = 2
 	Payout_STATUS_FAILED      Payout_Status = 3
+	// The Payout was cancelled by the user before it was processed.
+	Payout_STATUS_CANCELLED Payout_Status = 4
 )
 
 // Enum value maps for Payout_Status.
@@ -39,12 +103,14 @@ var (
 		1: "STATUS_PENDING",
 		2: "STATUS_SUCCESS",
 		3: "STATUS_FAILED",
+		4: "STATUS_CANCELLED",
 	}
 	Payout_Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED": 0,
 		"STATUS_PENDING":     1,
 		"STATUS_SUCCESS":     2,
 		"STATUS_FAILED":      3,
+		"STATUS_CANCELLED":   4,
 	}
 )
 
@@ -59,11 +125,11 @@ func (x Payout_Status) String() string {
 }
 
 func (Payout_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_ride_payments_v1alpha1_payout_proto_enumTypes[0].Descriptor()
+	return file_ride_payments_v1alpha1_payout_proto_enumTypes[1].Descriptor()
 }
 
 func (Payout_Status) Type() protoreflect.EnumType {
-	return &file_ride_payments_v1alpha1_payout_proto_enumTypes[0]
+	return &file_ride_payments_v1alpha1_payout_proto_enumTypes[1]
 }
 
 func (x Payout_Status) Number() protoreflect.EnumNumber {
@@ -72,7 +138,7 @@ func (x Payout_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Payout_Status.Descriptor instead.
 func (Payout_Status) EnumDescriptor() ([]byte, []int) {
-	return file_ride_payments_v1alpha1_payout_proto_rawDescGZIP(), []int{0, 0}
+	return file_ride_payments_v1alpha1_payout_proto_rawDescGZIP(), []int{0, 1}
 }
 
 type Payout struct {
@@ -83,7 +149,7 @@ type Payout struct {
 	// Relative resource name of Payout, for example: "users/user1/wallet/payouts/payout1"
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The amount of money to be charged in the smallest currency unit. **Must** be greater than 0. For example, $1.75 must be represented as 175.
-	Amount int32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// Output only. Current status of the Payout.
 	Status Payout_Status `protobuf:"varint,3,opt,name=status,proto3,enum=ride.payments.v1alpha1.Payout_Status" json:"status,omitempty"`
 	// Output only. Timestamp when the Payout was created.
@@ -97,6 +163,11 @@ type Payout struct {
 	//	*Payout_TransactionId
 	//	*Payout_FailureReason
 	Metadata isPayout_Metadata `protobuf_oneof:"metadata"`
+	// How the Payout is transferred. When unspecified, UPI is used for UPI destinations,
+	// and IMPS or NEFT for bank accounts depending on the amount.
+	Mode Payout_Mode `protobuf:"varint,8,opt,name=mode,proto3,enum=ride.payments.v1alpha1.Payout_Mode" json:"mode,omitempty"`
+	// The currency code of the amount. Defaults to "INR" if not set.
+	CurrencyCode string `protobuf:"bytes,9,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 }
 
 func (x *Payout) Reset() {
@@ -138,7 +209,7 @@ func (x *Payout) GetName() string {
 	return ""
 }
 
-func (x *Payout) GetAmount() int32 {
+func (x *Payout) GetAmount() int64 {
 	if x != nil {
 		return x.Amount
 	}
@@ -187,6 +258,20 @@ func (x *Payout) GetFailureReason() string {
 	return ""
 }
 
+func (x *Payout) GetMode() Payout_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Payout_MODE_UNSPECIFIED
+}
+
+func (x *Payout) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
 type isPayout_Metadata interface {
 	isPayout_Metadata()
 }
@@ -214,12 +299,18 @@ type PayoutAccount struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The currency code of the PayoutAccount. For example, "INR".
 	CurrencyCode string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Where Payouts are transferred to. Payouts are sent as links when no destination is set.
+	//
 	// Types that are assignable to Destination:
 	//
 	//	*PayoutAccount_BankAccount_
 	//	*PayoutAccount_UpiId
 	Destination       isPayoutAccount_Destination `protobuf_oneof:"destination"`
 	RazorpayContactId string                      `protobuf:"bytes,7,opt,name=razorpay_contact_id,json=razorpayContactId,proto3" json:"razorpay_contact_id,omitempty"`
+	// Output only. The Razorpay fund account of the destination.
+	RazorpayFundAccountId string `protobuf:"bytes,8,opt,name=razorpay_fund_account_id,json=razorpayFundAccountId,proto3" json:"razorpay_fund_account_id,omitempty"`
+	// Output only. Timestamp when the destination was last changed. Payouts are blocked for a cooling period after a change.
+	DestinationUpdateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=destination_update_time,json=destinationUpdateTime,proto3" json:"destination_update_time,omitempty"`
 	// Output only. Timestamp when the PayoutAccount was created.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. Timestamp when the PayoutAccount was last updated.
@@ -300,6 +391,20 @@ func (x *PayoutAccount) GetRazorpayContactId() string {
 	return ""
 }
 
+func (x *PayoutAccount) GetRazorpayFundAccountId() string {
+	if x != nil {
+		return x.RazorpayFundAccountId
+	}
+	return ""
+}
+
+func (x *PayoutAccount) GetDestinationUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DestinationUpdateTime
+	}
+	return nil
+}
+
 func (x *PayoutAccount) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
@@ -408,14 +513,14 @@ var file_ride_payments_v1alpha1_payout_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
 	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x03, 0x0a, 0x06,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x05, 0x0a, 0x06,
 	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x52, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0xba, 0x48, 0x3b, 0xd0, 0x01, 0x01, 0x72, 0x36, 0x32, 0x34,
 	0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
 	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79,
 	0x6f, 0x75, 0x74, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
 	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
 	0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x73,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x72, 0x69,
 	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
@@ -433,46 +538,78 @@ var file_ride_payments_v1alpha1_payout_proto_rawDesc = []byte{
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
 	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02,
-	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x03, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xa7, 0x04, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x33, 0xba, 0x48, 0x30, 0x72, 0x2e, 0x32, 0x2c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b,
-	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x52, 0x03, 0x49, 0x4e, 0x52, 0x52, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x62,
-	0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x06, 0x75, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x75, 0x70, 0x69, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13,
-	0x72, 0x61, 0x7a, 0x6f, 0x72, 0x70, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61, 0x7a, 0x6f, 0x72,
-	0x70, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x72, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x6b, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x66, 0x73, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xba, 0x48, 0x11, 0xd0, 0x01, 0x01, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x53, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x46, 0x54, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x54, 0x47, 0x53, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x50, 0x49, 0x10, 0x04, 0x12, 0x0d, 0x0a,
+	0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x05, 0x22, 0x71, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x42,
+	0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xab, 0x06, 0x0a, 0x0d,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xba, 0x48, 0x30,
+	0x72, 0x2e, 0x32, 0x2c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x24,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba,
+	0x48, 0x0e, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x56,
+	0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6e, 0x6b,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x75, 0x70, 0x69, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xba, 0x48, 0x2a, 0x72, 0x28, 0x32, 0x26, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x2e, 0x5f, 0x2d, 0x5d, 0x7b, 0x32,
+	0x2c, 0x32, 0x35, 0x36, 0x7d, 0x40, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32,
+	0x2c, 0x36, 0x34, 0x7d, 0x24, 0x48, 0x00, 0x52, 0x05, 0x75, 0x70, 0x69, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x72, 0x61, 0x7a, 0x6f, 0x72, 0x70, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61, 0x7a,
+	0x6f, 0x72, 0x70, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x37,
+	0x0a, 0x18, 0x72, 0x61, 0x7a, 0x6f, 0x72, 0x70, 0x61, 0x79, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x72, 0x61, 0x7a, 0x6f, 0x72, 0x70, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xb2, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x6e, 0x6b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x03, 0x18, 0x78, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x72, 0x0f,
+	0x32, 0x0d, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x39, 0x2c, 0x31, 0x38, 0x7d, 0x24, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a,
+	0x0a, 0x09, 0x69, 0x66, 0x73, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x32, 0x16, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d,
+	0x7b, 0x34, 0x7d, 0x30, 0x5b, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x7d, 0x24,
 	0x52, 0x08, 0x69, 0x66, 0x73, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70,
@@ -494,27 +631,30 @@ func file_ride_payments_v1alpha1_payout_proto_rawDescGZIP() []byte {
 	return file_ride_payments_v1alpha1_payout_proto_rawDescData
 }
 
-var file_ride_payments_v1alpha1_payout_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ride_payments_v1alpha1_payout_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_ride_payments_v1alpha1_payout_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ride_payments_v1alpha1_payout_proto_goTypes = []interface{}{
-	(Payout_Status)(0),                // 0: ride.payments.v1alpha1.Payout.Status
-	(*Payout)(nil),                    // 1: ride.payments.v1alpha1.Payout
-	(*PayoutAccount)(nil),             // 2: ride.payments.v1alpha1.PayoutAccount
-	(*PayoutAccount_BankAccount)(nil), // 3: ride.payments.v1alpha1.PayoutAccount.BankAccount
-	(*timestamppb.Timestamp)(nil),     // 4: google.protobuf.Timestamp
+	(Payout_Mode)(0),                  // 0: ride.payments.v1alpha1.Payout.Mode
+	(Payout_Status)(0),                // 1: ride.payments.v1alpha1.Payout.Status
+	(*Payout)(nil),                    // 2: ride.payments.v1alpha1.Payout
+	(*PayoutAccount)(nil),             // 3: ride.payments.v1alpha1.PayoutAccount
+	(*PayoutAccount_BankAccount)(nil), // 4: ride.payments.v1alpha1.PayoutAccount.BankAccount
+	(*timestamppb.Timestamp)(nil),     // 5: google.protobuf.Timestamp
 }
 var file_ride_payments_v1alpha1_payout_proto_depIdxs = []int32{
-	0, // 0: ride.payments.v1alpha1.Payout.status:type_name -> ride.payments.v1alpha1.Payout.Status
-	4, // 1: ride.payments.v1alpha1.Payout.create_time:type_name -> google.protobuf.Timestamp
-	4, // 2: ride.payments.v1alpha1.Payout.update_time:type_name -> google.protobuf.Timestamp
-	3, // 3: ride.payments.v1alpha1.PayoutAccount.bank_account:type_name -> ride.payments.v1alpha1.PayoutAccount.BankAccount
-	4, // 4: ride.payments.v1alpha1.PayoutAccount.create_time:type_name -> google.protobuf.Timestamp
-	4, // 5: ride.payments.v1alpha1.PayoutAccount.update_time:type_name -> google.protobuf.Timestamp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1, // 0: ride.payments.v1alpha1.Payout.status:type_name -> ride.payments.v1alpha1.Payout.Status
+	5, // 1: ride.payments.v1alpha1.Payout.create_time:type_name -> google.protobuf.Timestamp
+	5, // 2: ride.payments.v1alpha1.Payout.update_time:type_name -> google.protobuf.Timestamp
+	0, // 3: ride.payments.v1alpha1.Payout.mode:type_name -> ride.payments.v1alpha1.Payout.Mode
+	4, // 4: ride.payments.v1alpha1.PayoutAccount.bank_account:type_name -> ride.payments.v1alpha1.PayoutAccount.BankAccount
+	5, // 5: ride.payments.v1alpha1.PayoutAccount.destination_update_time:type_name -> google.protobuf.Timestamp
+	5, // 6: ride.payments.v1alpha1.PayoutAccount.create_time:type_name -> google.protobuf.Timestamp
+	5, // 7: ride.payments.v1alpha1.PayoutAccount.update_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_ride_payments_v1alpha1_payout_proto_init() }
@@ -573,7 +713,7 @@ func file_ride_payments_v1alpha1_payout_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ride_payments_v1alpha1_payout_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -25,6 +25,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListTransfersRequest_Direction int32
+
+const (
+	ListTransfersRequest_DIRECTION_UNSPECIFIED ListTransfersRequest_Direction = 0
+	// Transfers where the wallet is the source.
+	ListTransfersRequest_DIRECTION_OUTGOING ListTransfersRequest_Direction = 1
+	// Transfers where the wallet is the destination.
+	ListTransfersRequest_DIRECTION_INCOMING ListTransfersRequest_Direction = 2
+)
+
+// Enum value maps for ListTransfersRequest_Direction.
+var (
+	ListTransfersRequest_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "DIRECTION_OUTGOING",
+		2: "DIRECTION_INCOMING",
+	}
+	ListTransfersRequest_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"DIRECTION_OUTGOING":    1,
+		"DIRECTION_INCOMING":    2,
+	}
+)
+
+func (x ListTransfersRequest_Direction) Enum() *ListTransfersRequest_Direction {
+	p := new(ListTransfersRequest_Direction)
+	*p = x
+	return p
+}
+
+func (x ListTransfersRequest_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListTransfersRequest_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_v1alpha1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ListTransfersRequest_Direction) Type() protoreflect.EnumType {
+	return &file_ride_payments_v1alpha1_service_proto_enumTypes[0]
+}
+
+func (x ListTransfersRequest_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListTransfersRequest_Direction.Descriptor instead.
+func (ListTransfersRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type ExportStatementRequest_Format int32
+
+const (
+	ExportStatementRequest_FORMAT_UNSPECIFIED ExportStatementRequest_Format = 0
+	ExportStatementRequest_FORMAT_CSV         ExportStatementRequest_Format = 1
+	ExportStatementRequest_FORMAT_PDF         ExportStatementRequest_Format = 2
+)
+
+// Enum value maps for ExportStatementRequest_Format.
+var (
+	ExportStatementRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_CSV",
+		2: "FORMAT_PDF",
+	}
+	ExportStatementRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_CSV":         1,
+		"FORMAT_PDF":         2,
+	}
+)
+
+func (x ExportStatementRequest_Format) Enum() *ExportStatementRequest_Format {
+	p := new(ExportStatementRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportStatementRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatementRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_v1alpha1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportStatementRequest_Format) Type() protoreflect.EnumType {
+	return &file_ride_payments_v1alpha1_service_proto_enumTypes[1]
+}
+
+func (x ExportStatementRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatementRequest_Format.Descriptor instead.
+func (ExportStatementRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{44, 0}
+}
+
 type GetWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -129,19 +229,19 @@ func (x *GetWalletResponse) GetWallet() *Wallet {
 	return nil
 }
 
-type CreateTransfersRequest struct {
+type WatchWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique request ID for server to detect duplicated requests for idempotency.
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// All the Transactions to be created in a single atomic batch. Client **must** not set the Transaction.name field.
-	Transfers []*Transfer `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// Relative Resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resume_token of the last response received from a previous watch, if any.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *CreateTransfersRequest) Reset() {
-	*x = CreateTransfersRequest{}
+func (x *WatchWalletRequest) Reset() {
+	*x = WatchWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,13 +249,13 @@ func (x *CreateTransfersRequest) Reset() {
 	}
 }
 
-func (x *CreateTransfersRequest) String() string {
+func (x *WatchWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransfersRequest) ProtoMessage() {}
+func (*WatchWalletRequest) ProtoMessage() {}
 
-func (x *CreateTransfersRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,38 +267,41 @@ func (x *CreateTransfersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransfersRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransfersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchWalletRequest.ProtoReflect.Descriptor instead.
+func (*WatchWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateTransfersRequest) GetRequestId() string {
+func (x *WatchWalletRequest) GetName() string {
 	if x != nil {
-		return x.RequestId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateTransfersRequest) GetTransfers() []*Transfer {
+func (x *WatchWalletRequest) GetResumeToken() string {
 	if x != nil {
-		return x.Transfers
+		return x.ResumeToken
 	}
-	return nil
+	return ""
 }
 
-type CreateTransfersResponse struct {
+type WatchWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output only. The batch id of the created transactions.
-	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
-	// The created transactions.
-	Transfers []*Transfer `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*WatchWalletResponse_Wallet
+	//	*WatchWalletResponse_Transaction
+	Event isWatchWalletResponse_Event `protobuf_oneof:"event"`
+	// Token to resume the watch after the last Transaction sent, or empty if no Transaction was sent yet.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *CreateTransfersResponse) Reset() {
-	*x = CreateTransfersResponse{}
+func (x *WatchWalletResponse) Reset() {
+	*x = WatchWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,13 +309,13 @@ func (x *CreateTransfersResponse) Reset() {
 	}
 }
 
-func (x *CreateTransfersResponse) String() string {
+func (x *WatchWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransfersResponse) ProtoMessage() {}
+func (*WatchWalletResponse) ProtoMessage() {}
 
-func (x *CreateTransfersResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -224,38 +327,72 @@ func (x *CreateTransfersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransfersResponse.ProtoReflect.Descriptor instead.
-func (*CreateTransfersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchWalletResponse.ProtoReflect.Descriptor instead.
+func (*WatchWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateTransfersResponse) GetBatchId() string {
-	if x != nil {
-		return x.BatchId
+func (m *WatchWalletResponse) GetEvent() isWatchWalletResponse_Event {
+	if m != nil {
+		return m.Event
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTransfersResponse) GetTransfers() []*Transfer {
-	if x != nil {
-		return x.Transfers
+func (x *WatchWalletResponse) GetWallet() *Wallet {
+	if x, ok := x.GetEvent().(*WatchWalletResponse_Wallet); ok {
+		return x.Wallet
 	}
 	return nil
 }
 
-type CreateTransactionsRequest struct {
+func (x *WatchWalletResponse) GetTransaction() *Transaction {
+	if x, ok := x.GetEvent().(*WatchWalletResponse_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *WatchWalletResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type isWatchWalletResponse_Event interface {
+	isWatchWalletResponse_Event()
+}
+
+type WatchWalletResponse_Wallet struct {
+	// The Wallet, sent first and every time it changes.
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3,oneof"`
+}
+
+type WatchWalletResponse_Transaction struct {
+	// A Transaction committed on the Wallet.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*WatchWalletResponse_Wallet) isWatchWalletResponse_Event() {}
+
+func (*WatchWalletResponse_Transaction) isWatchWalletResponse_Event() {}
+
+type CreateWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// A unique request ID for server to detect duplicated requests for idempotency.
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// All the Transactions to be created in a single atomic batch. Client **must** not set the Transaction.name field.
-	Entries []*CreateTransactionsRequest_Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Relative resource name of the user owning the Wallet, for example, "users/user1"
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The primary currency of the Wallet. Defaults to "INR" if not set.
+	CurrencyCode string `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
 }
 
-func (x *CreateTransactionsRequest) Reset() {
-	*x = CreateTransactionsRequest{}
+func (x *CreateWalletRequest) Reset() {
+	*x = CreateWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -263,13 +400,13 @@ func (x *CreateTransactionsRequest) Reset() {
 	}
 }
 
-func (x *CreateTransactionsRequest) String() string {
+func (x *CreateWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionsRequest) ProtoMessage() {}
+func (*CreateWalletRequest) ProtoMessage() {}
 
-func (x *CreateTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,38 +418,43 @@ func (x *CreateTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateTransactionsRequest) GetRequestId() string {
+func (x *CreateWalletRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CreateTransactionsRequest) GetEntries() []*CreateTransactionsRequest_Entry {
+func (x *CreateWalletRequest) GetParent() string {
 	if x != nil {
-		return x.Entries
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-type CreateTransactionsResponse struct {
+func (x *CreateWalletRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type CreateWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Output only. The batch id of the created transactions.
-	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
-	// The created transactions.
-	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// The created Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *CreateTransactionsResponse) Reset() {
-	*x = CreateTransactionsResponse{}
+func (x *CreateWalletResponse) Reset() {
+	*x = CreateWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,13 +462,13 @@ func (x *CreateTransactionsResponse) Reset() {
 	}
 }
 
-func (x *CreateTransactionsResponse) String() string {
+func (x *CreateWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionsResponse) ProtoMessage() {}
+func (*CreateWalletResponse) ProtoMessage() {}
 
-func (x *CreateTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -338,37 +480,33 @@ func (x *CreateTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*CreateTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWalletResponse.ProtoReflect.Descriptor instead.
+func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateTransactionsResponse) GetBatchId() string {
-	if x != nil {
-		return x.BatchId
-	}
-	return ""
-}
-
-func (x *CreateTransactionsResponse) GetTransactions() []*Transaction {
+func (x *CreateWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Transactions
+		return x.Wallet
 	}
 	return nil
 }
 
-type GetTransactionRequest struct {
+type FreezeWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative resource name of the Transaction, for example, "users/user1/wallet/transactions/transaction1"
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Why the Wallet is frozen, for example the case id of an investigation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
+func (x *FreezeWalletRequest) Reset() {
+	*x = FreezeWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +514,13 @@ func (x *GetTransactionRequest) Reset() {
 	}
 }
 
-func (x *GetTransactionRequest) String() string {
+func (x *FreezeWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionRequest) ProtoMessage() {}
+func (*FreezeWalletRequest) ProtoMessage() {}
 
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *FreezeWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,36 +532,43 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeWalletRequest.ProtoReflect.Descriptor instead.
+func (*FreezeWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTransactionRequest) GetName() string {
+func (x *FreezeWalletRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *FreezeWalletRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetTransactionRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *FreezeWalletRequest) GetReason() string {
 	if x != nil {
-		return x.Fields
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type GetTransactionResponse struct {
+type FreezeWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The requested Transaction.
-	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The frozen Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *GetTransactionResponse) Reset() {
-	*x = GetTransactionResponse{}
+func (x *FreezeWalletResponse) Reset() {
+	*x = FreezeWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,13 +576,13 @@ func (x *GetTransactionResponse) Reset() {
 	}
 }
 
-func (x *GetTransactionResponse) String() string {
+func (x *FreezeWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionResponse) ProtoMessage() {}
+func (*FreezeWalletResponse) ProtoMessage() {}
 
-func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *FreezeWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,34 +594,34 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeWalletResponse.ProtoReflect.Descriptor instead.
+func (*FreezeWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTransactionResponse) GetTransaction() *Transaction {
+func (x *FreezeWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Transaction
+		return x.Wallet
 	}
 	return nil
 }
 
-type ListTransactionsRequest struct {
+type SetBalanceFloorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative resource name of the Wallet, for example, "users/user1/wallet".
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The maximum number of items to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// The next_page_token value returned from a previous List request, if any.
-	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Fields    *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The lowest the balance can be taken by debits, in smallest currency unit of the primary currency of the Wallet.
+	// Unset to use the default balance floor again.
+	BalanceFloor *int64 `protobuf:"varint,3,opt,name=balance_floor,json=balanceFloor,proto3,oneof" json:"balance_floor,omitempty"`
 }
 
-func (x *ListTransactionsRequest) Reset() {
-	*x = ListTransactionsRequest{}
+func (x *SetBalanceFloorRequest) Reset() {
+	*x = SetBalanceFloorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +629,13 @@ func (x *ListTransactionsRequest) Reset() {
 	}
 }
 
-func (x *ListTransactionsRequest) String() string {
+func (x *SetBalanceFloorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsRequest) ProtoMessage() {}
+func (*SetBalanceFloorRequest) ProtoMessage() {}
 
-func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceFloorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,52 +647,43 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceFloorRequest.ProtoReflect.Descriptor instead.
+func (*SetBalanceFloorRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListTransactionsRequest) GetParent() string {
+func (x *SetBalanceFloorRequest) GetRequestId() string {
 	if x != nil {
-		return x.Parent
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListTransactionsRequest) GetPageToken() string {
+func (x *SetBalanceFloorRequest) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListTransactionsRequest) GetFields() *fieldmaskpb.FieldMask {
-	if x != nil {
-		return x.Fields
+func (x *SetBalanceFloorRequest) GetBalanceFloor() int64 {
+	if x != nil && x.BalanceFloor != nil {
+		return *x.BalanceFloor
 	}
-	return nil
+	return 0
 }
 
-type ListTransactionsResponse struct {
+type SetBalanceFloorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of Transactions blonging to the parent Wallet.
-	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	// Token to retrieve the next page of results, or empty if there are no more results in the list.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The updated Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *ListTransactionsResponse) Reset() {
-	*x = ListTransactionsResponse{}
+func (x *SetBalanceFloorResponse) Reset() {
+	*x = SetBalanceFloorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,13 +691,13 @@ func (x *ListTransactionsResponse) Reset() {
 	}
 }
 
-func (x *ListTransactionsResponse) String() string {
+func (x *SetBalanceFloorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTransactionsResponse) ProtoMessage() {}
+func (*SetBalanceFloorResponse) ProtoMessage() {}
 
-func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceFloorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,40 +709,33 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceFloorResponse.ProtoReflect.Descriptor instead.
+func (*SetBalanceFloorResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+func (x *SetBalanceFloorResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Transactions
+		return x.Wallet
 	}
 	return nil
 }
 
-func (x *ListTransactionsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type CreateRechargeRequest struct {
+type SuspendWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// A unique request ID for server to detect duplicated requests for idempotency.
 	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// The parent wallet name where the Recharge is to be created. example: "users/user1/wallet"
-	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The Recharge to be created. Client **must** not set Recharge.name.
-	Recharge *Recharge `protobuf:"bytes,3,opt,name=recharge,proto3" json:"recharge,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Why the Wallet is suspended, for example the case id of an investigation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *CreateRechargeRequest) Reset() {
-	*x = CreateRechargeRequest{}
+func (x *SuspendWalletRequest) Reset() {
+	*x = SuspendWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,13 +743,13 @@ func (x *CreateRechargeRequest) Reset() {
 	}
 }
 
-func (x *CreateRechargeRequest) String() string {
+func (x *SuspendWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRechargeRequest) ProtoMessage() {}
+func (*SuspendWalletRequest) ProtoMessage() {}
 
-func (x *CreateRechargeRequest) ProtoReflect() protoreflect.Message {
+func (x *SuspendWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,45 +761,43 @@ func (x *CreateRechargeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRechargeRequest.ProtoReflect.Descriptor instead.
-func (*CreateRechargeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuspendWalletRequest.ProtoReflect.Descriptor instead.
+func (*SuspendWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateRechargeRequest) GetRequestId() string {
+func (x *SuspendWalletRequest) GetRequestId() string {
 	if x != nil {
 		return x.RequestId
 	}
 	return ""
 }
 
-func (x *CreateRechargeRequest) GetParent() string {
+func (x *SuspendWalletRequest) GetName() string {
 	if x != nil {
-		return x.Parent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateRechargeRequest) GetRecharge() *Recharge {
+func (x *SuspendWalletRequest) GetReason() string {
 	if x != nil {
-		return x.Recharge
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type CreateRechargeResponse struct {
+type SuspendWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The created Recharge
-	Recharge *Recharge `protobuf:"bytes,1,opt,name=recharge,proto3" json:"recharge,omitempty"`
-	// Information required for the checkout payment gateway.
-	CheckoutInfo map[string]string `protobuf:"bytes,2,rep,name=checkout_info,json=checkoutInfo,proto3" json:"checkout_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The suspended Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *CreateRechargeResponse) Reset() {
-	*x = CreateRechargeResponse{}
+func (x *SuspendWalletResponse) Reset() {
+	*x = SuspendWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,13 +805,13 @@ func (x *CreateRechargeResponse) Reset() {
 	}
 }
 
-func (x *CreateRechargeResponse) String() string {
+func (x *SuspendWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRechargeResponse) ProtoMessage() {}
+func (*SuspendWalletResponse) ProtoMessage() {}
 
-func (x *CreateRechargeResponse) ProtoReflect() protoreflect.Message {
+func (x *SuspendWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,37 +823,33 @@ func (x *CreateRechargeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRechargeResponse.ProtoReflect.Descriptor instead.
-func (*CreateRechargeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuspendWalletResponse.ProtoReflect.Descriptor instead.
+func (*SuspendWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateRechargeResponse) GetRecharge() *Recharge {
-	if x != nil {
-		return x.Recharge
-	}
-	return nil
-}
-
-func (x *CreateRechargeResponse) GetCheckoutInfo() map[string]string {
+func (x *SuspendWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.CheckoutInfo
+		return x.Wallet
 	}
 	return nil
 }
 
-type GetRechargeRequest struct {
+type UnfreezeWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative Resource name of the Recharge, for example, "users/user1/wallet/recharges/recharge1"
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Why the Wallet is unfrozen, for example the case id of an investigation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *GetRechargeRequest) Reset() {
-	*x = GetRechargeRequest{}
+func (x *UnfreezeWalletRequest) Reset() {
+	*x = UnfreezeWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,13 +857,13 @@ func (x *GetRechargeRequest) Reset() {
 	}
 }
 
-func (x *GetRechargeRequest) String() string {
+func (x *UnfreezeWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRechargeRequest) ProtoMessage() {}
+func (*UnfreezeWalletRequest) ProtoMessage() {}
 
-func (x *GetRechargeRequest) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,36 +875,43 @@ func (x *GetRechargeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRechargeRequest.ProtoReflect.Descriptor instead.
-func (*GetRechargeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeWalletRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetRechargeRequest) GetName() string {
+func (x *UnfreezeWalletRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *UnfreezeWalletRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetRechargeRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *UnfreezeWalletRequest) GetReason() string {
 	if x != nil {
-		return x.Fields
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type GetRechargeResponse struct {
+type UnfreezeWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The requested recharge.
-	Recharge *Recharge `protobuf:"bytes,1,opt,name=recharge,proto3" json:"recharge,omitempty"`
+	// The unfrozen Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *GetRechargeResponse) Reset() {
-	*x = GetRechargeResponse{}
+func (x *UnfreezeWalletResponse) Reset() {
+	*x = UnfreezeWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,13 +919,13 @@ func (x *GetRechargeResponse) Reset() {
 	}
 }
 
-func (x *GetRechargeResponse) String() string {
+func (x *UnfreezeWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRechargeResponse) ProtoMessage() {}
+func (*UnfreezeWalletResponse) ProtoMessage() {}
 
-func (x *GetRechargeResponse) ProtoReflect() protoreflect.Message {
+func (x *UnfreezeWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,35 +937,33 @@ func (x *GetRechargeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRechargeResponse.ProtoReflect.Descriptor instead.
-func (*GetRechargeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnfreezeWalletResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetRechargeResponse) GetRecharge() *Recharge {
+func (x *UnfreezeWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Recharge
+		return x.Wallet
 	}
 	return nil
 }
 
-type ListRechargesRequest struct {
+type CloseWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent wallet name, for example, "users/user1/wallet"
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The maximum number of items to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// The next_page_token value returned from a previous List request, if any.
-	PageToken string                       `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter    *ListRechargesRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
-	Fields    *fieldmaskpb.FieldMask       `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Why the Wallet is closed, for example the case id of an investigation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *ListRechargesRequest) Reset() {
-	*x = ListRechargesRequest{}
+func (x *CloseWalletRequest) Reset() {
+	*x = CloseWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -843,13 +971,13 @@ func (x *ListRechargesRequest) Reset() {
 	}
 }
 
-func (x *ListRechargesRequest) String() string {
+func (x *CloseWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRechargesRequest) ProtoMessage() {}
+func (*CloseWalletRequest) ProtoMessage() {}
 
-func (x *ListRechargesRequest) ProtoReflect() protoreflect.Message {
+func (x *CloseWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,59 +989,43 @@ func (x *ListRechargesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRechargesRequest.ProtoReflect.Descriptor instead.
-func (*ListRechargesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseWalletRequest.ProtoReflect.Descriptor instead.
+func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListRechargesRequest) GetParent() string {
+func (x *CloseWalletRequest) GetRequestId() string {
 	if x != nil {
-		return x.Parent
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ListRechargesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListRechargesRequest) GetPageToken() string {
+func (x *CloseWalletRequest) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListRechargesRequest) GetFilter() *ListRechargesRequest_Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-func (x *ListRechargesRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *CloseWalletRequest) GetReason() string {
 	if x != nil {
-		return x.Fields
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type ListRechargesResponse struct {
+type CloseWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// There will be a maximum number of items returned based on the page_size field in the request.
-	Recharges []*Recharge `protobuf:"bytes,1,rep,name=recharges,proto3" json:"recharges,omitempty"`
-	// Token to retrieve the next page of results, or empty if there are no more results in the list.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The closed Wallet
+	Wallet *Wallet `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *ListRechargesResponse) Reset() {
-	*x = ListRechargesResponse{}
+func (x *CloseWalletResponse) Reset() {
+	*x = CloseWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,13 +1033,13 @@ func (x *ListRechargesResponse) Reset() {
 	}
 }
 
-func (x *ListRechargesResponse) String() string {
+func (x *CloseWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRechargesResponse) ProtoMessage() {}
+func (*CloseWalletResponse) ProtoMessage() {}
 
-func (x *ListRechargesResponse) ProtoReflect() protoreflect.Message {
+func (x *CloseWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -939,40 +1051,33 @@ func (x *ListRechargesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRechargesResponse.ProtoReflect.Descriptor instead.
-func (*ListRechargesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseWalletResponse.ProtoReflect.Descriptor instead.
+func (*CloseWalletResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListRechargesResponse) GetRecharges() []*Recharge {
+func (x *CloseWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Recharges
+		return x.Wallet
 	}
 	return nil
 }
 
-func (x *ListRechargesResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type CreatePayoutRequest struct {
+type VerifyLedgerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique request ID for server to detect duplicated requests for idempotency.
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// The parent wallet name where the Payout is to be created. example: "users/user1/wallet"
-	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The Payout to be created. Client **must** not set Payout.name.
-	Payout *Payout `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+	// The maximum number of Wallets to verify. Defaults to 50 and values above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous VerifyLedger request, if any.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Overwrite mismatched balances with the balances computed from the Transactions.
+	Repair bool `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
 }
 
-func (x *CreatePayoutRequest) Reset() {
-	*x = CreatePayoutRequest{}
+func (x *VerifyLedgerRequest) Reset() {
+	*x = VerifyLedgerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,13 +1085,13 @@ func (x *CreatePayoutRequest) Reset() {
 	}
 }
 
-func (x *CreatePayoutRequest) String() string {
+func (x *VerifyLedgerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePayoutRequest) ProtoMessage() {}
+func (*VerifyLedgerRequest) ProtoMessage() {}
 
-func (x *CreatePayoutRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyLedgerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,43 +1103,47 @@ func (x *CreatePayoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePayoutRequest.ProtoReflect.Descriptor instead.
-func (*CreatePayoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreatePayoutRequest) GetRequestId() string {
+func (x *VerifyLedgerRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.RequestId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePayoutRequest) GetParent() string {
+func (x *VerifyLedgerRequest) GetPageToken() string {
 	if x != nil {
-		return x.Parent
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *CreatePayoutRequest) GetPayout() *Payout {
+func (x *VerifyLedgerRequest) GetRepair() bool {
 	if x != nil {
-		return x.Payout
+		return x.Repair
 	}
-	return nil
+	return false
 }
 
-type CreatePayoutResponse struct {
+type VerifyLedgerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The created Payout
-	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	// The Wallets of the page whose balance does not match their Transactions.
+	Discrepancies []*LedgerDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	// The number of Wallets verified.
+	VerifiedCount int32 `protobuf:"varint,2,opt,name=verified_count,json=verifiedCount,proto3" json:"verified_count,omitempty"`
+	// Token to verify the next page of Wallets, or empty if all Wallets were verified.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *CreatePayoutResponse) Reset() {
-	*x = CreatePayoutResponse{}
+func (x *VerifyLedgerResponse) Reset() {
+	*x = VerifyLedgerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1042,13 +1151,13 @@ func (x *CreatePayoutResponse) Reset() {
 	}
 }
 
-func (x *CreatePayoutResponse) String() string {
+func (x *VerifyLedgerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePayoutResponse) ProtoMessage() {}
+func (*VerifyLedgerResponse) ProtoMessage() {}
 
-func (x *CreatePayoutResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyLedgerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1060,30 +1169,45 @@ func (x *CreatePayoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePayoutResponse.ProtoReflect.Descriptor instead.
-func (*CreatePayoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLedgerResponse.ProtoReflect.Descriptor instead.
+func (*VerifyLedgerResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreatePayoutResponse) GetPayout() *Payout {
+func (x *VerifyLedgerResponse) GetDiscrepancies() []*LedgerDiscrepancy {
 	if x != nil {
-		return x.Payout
+		return x.Discrepancies
 	}
 	return nil
 }
 
-type GetPayoutRequest struct {
+func (x *VerifyLedgerResponse) GetVerifiedCount() int32 {
+	if x != nil {
+		return x.VerifiedCount
+	}
+	return 0
+}
+
+func (x *VerifyLedgerResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateTransfersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative Resource name of the Payout, for example, "users/user1/wallet/payouts/payout1"
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// All the Transactions to be created in a single atomic batch. Client **must** not set the Transaction.name field.
+	Transfers []*Transfer `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *GetPayoutRequest) Reset() {
-	*x = GetPayoutRequest{}
+func (x *CreateTransfersRequest) Reset() {
+	*x = CreateTransfersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1091,13 +1215,13 @@ func (x *GetPayoutRequest) Reset() {
 	}
 }
 
-func (x *GetPayoutRequest) String() string {
+func (x *CreateTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutRequest) ProtoMessage() {}
+func (*CreateTransfersRequest) ProtoMessage() {}
 
-func (x *GetPayoutRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTransfersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,36 +1233,38 @@ func (x *GetPayoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutRequest.ProtoReflect.Descriptor instead.
-func (*GetPayoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTransfersRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransfersRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetPayoutRequest) GetName() string {
+func (x *CreateTransfersRequest) GetRequestId() string {
 	if x != nil {
-		return x.Name
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetPayoutRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *CreateTransfersRequest) GetTransfers() []*Transfer {
 	if x != nil {
-		return x.Fields
+		return x.Transfers
 	}
 	return nil
 }
 
-type GetPayoutResponse struct {
+type CreateTransfersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The requested payout.
-	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+	// Output only. The batch id of the created transactions.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The created transactions.
+	Transfers []*Transfer `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *GetPayoutResponse) Reset() {
-	*x = GetPayoutResponse{}
+func (x *CreateTransfersResponse) Reset() {
+	*x = CreateTransfersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1146,13 +1272,13 @@ func (x *GetPayoutResponse) Reset() {
 	}
 }
 
-func (x *GetPayoutResponse) String() string {
+func (x *CreateTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutResponse) ProtoMessage() {}
+func (*CreateTransfersResponse) ProtoMessage() {}
 
-func (x *GetPayoutResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTransfersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1164,37 +1290,37 @@ func (x *GetPayoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutResponse.ProtoReflect.Descriptor instead.
-func (*GetPayoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTransfersResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransfersResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetPayoutResponse) GetPayout() *Payout {
+func (x *CreateTransfersResponse) GetBatchId() string {
 	if x != nil {
-		return x.Payout
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CreateTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
 	}
 	return nil
 }
 
-type ListPayoutsRequest struct {
+type GetTransferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The parent wallet name, for example, "users/user1/wallet"
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The maximum number of items to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// The next_page_token value returned from a previous List request, if any.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Filter to list Payouts.
-	Filter *ListPayoutsRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
-	// The fields to return. If empty or omitted, all fields are returned.
+	// Relative resource name of the Transfer, for example, "users/user1/wallet/transfers/transfer1"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
 }
 
-func (x *ListPayoutsRequest) Reset() {
-	*x = ListPayoutsRequest{}
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1202,13 +1328,13 @@ func (x *ListPayoutsRequest) Reset() {
 	}
 }
 
-func (x *ListPayoutsRequest) String() string {
+func (x *GetTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPayoutsRequest) ProtoMessage() {}
+func (*GetTransferRequest) ProtoMessage() {}
 
-func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1220,59 +1346,36 @@ func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
-func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListPayoutsRequest) GetParent() string {
-	if x != nil {
-		return x.Parent
-	}
-	return ""
-}
-
-func (x *ListPayoutsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListPayoutsRequest) GetPageToken() string {
+func (x *GetTransferRequest) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListPayoutsRequest) GetFilter() *ListPayoutsRequest_Filter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-func (x *ListPayoutsRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *GetTransferRequest) GetFields() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-type ListPayoutsResponse struct {
+type GetTransferResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// There will be a maximum number of items returned based on the page_size field in the request.
-	Payouts []*Payout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
-	// Token to retrieve the next page of results, or empty if there are no more results in the list.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The requested Transfer.
+	Transfer *Transfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 }
 
-func (x *ListPayoutsResponse) Reset() {
-	*x = ListPayoutsResponse{}
+func (x *GetTransferResponse) Reset() {
+	*x = GetTransferResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1280,13 +1383,13 @@ func (x *ListPayoutsResponse) Reset() {
 	}
 }
 
-func (x *ListPayoutsResponse) String() string {
+func (x *GetTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPayoutsResponse) ProtoMessage() {}
+func (*GetTransferResponse) ProtoMessage() {}
 
-func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTransferResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1298,38 +1401,35 @@ func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPayoutsResponse.ProtoReflect.Descriptor instead.
-func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListPayoutsResponse) GetPayouts() []*Payout {
+func (x *GetTransferResponse) GetTransfer() *Transfer {
 	if x != nil {
-		return x.Payouts
+		return x.Transfer
 	}
 	return nil
 }
 
-func (x *ListPayoutsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type CreatePayoutAccountRequest struct {
+type ListTransfersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique request ID for server to detect duplicated requests for idempotency.
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// The PayoutAccount to be created. Client **must** set PayoutAccount.name.
-	PayoutAccount *PayoutAccount `protobuf:"bytes,2,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+	// Relative resource name of the Wallet, for example, "users/user1/wallet".
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of items to return. Defaults to 50 and values above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous List request, if any.
+	PageToken string                       `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *ListTransfersRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Fields    *fieldmaskpb.FieldMask       `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
 }
 
-func (x *CreatePayoutAccountRequest) Reset() {
-	*x = CreatePayoutAccountRequest{}
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,13 +1437,13 @@ func (x *CreatePayoutAccountRequest) Reset() {
 	}
 }
 
-func (x *CreatePayoutAccountRequest) String() string {
+func (x *ListTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePayoutAccountRequest) ProtoMessage() {}
+func (*ListTransfersRequest) ProtoMessage() {}
 
-func (x *CreatePayoutAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1355,36 +1455,59 @@ func (x *CreatePayoutAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePayoutAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreatePayoutAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreatePayoutAccountRequest) GetRequestId() string {
+func (x *ListTransfersRequest) GetParent() string {
 	if x != nil {
-		return x.RequestId
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *CreatePayoutAccountRequest) GetPayoutAccount() *PayoutAccount {
+func (x *ListTransfersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.PayoutAccount
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetFilter() *ListTransfersRequest_Filter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type CreatePayoutAccountResponse struct {
+func (x *ListTransfersRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListTransfersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The created PayoutAccount
-	PayoutAccount *PayoutAccount `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+	// Transfers sent from or received by the parent Wallet.
+	Transfers []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results in the list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *CreatePayoutAccountResponse) Reset() {
-	*x = CreatePayoutAccountResponse{}
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1392,13 +1515,13 @@ func (x *CreatePayoutAccountResponse) Reset() {
 	}
 }
 
-func (x *CreatePayoutAccountResponse) String() string {
+func (x *ListTransfersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePayoutAccountResponse) ProtoMessage() {}
+func (*ListTransfersResponse) ProtoMessage() {}
 
-func (x *CreatePayoutAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,30 +1533,42 @@ func (x *CreatePayoutAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePayoutAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreatePayoutAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreatePayoutAccountResponse) GetPayoutAccount() *PayoutAccount {
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
 	if x != nil {
-		return x.PayoutAccount
+		return x.Transfers
 	}
 	return nil
 }
 
-type GetPayoutAccountRequest struct {
+func (x *ListTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative Resource name of the PayoutAccount, for example, "users/user1/wallet/payout-account"
-	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// All the Transactions to be created in a single atomic batch. Client **must** not set the Transaction.name field.
+	// The sum of debits **must** equal the sum of credits in every currency of the batch.
+	Entries []*CreateTransactionsRequest_Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// The FX leg of the batch. **Must** be set when the entries use two currencies, and **must** name those currencies.
+	// Batches in more than two currencies are rejected.
+	Fx *FxConversion `protobuf:"bytes,3,opt,name=fx,proto3" json:"fx,omitempty"`
 }
 
-func (x *GetPayoutAccountRequest) Reset() {
-	*x = GetPayoutAccountRequest{}
+func (x *CreateTransactionsRequest) Reset() {
+	*x = CreateTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,13 +1576,13 @@ func (x *GetPayoutAccountRequest) Reset() {
 	}
 }
 
-func (x *GetPayoutAccountRequest) String() string {
+func (x *CreateTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutAccountRequest) ProtoMessage() {}
+func (*CreateTransactionsRequest) ProtoMessage() {}
 
-func (x *GetPayoutAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,36 +1594,45 @@ func (x *GetPayoutAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetPayoutAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetPayoutAccountRequest) GetName() string {
+func (x *CreateTransactionsRequest) GetRequestId() string {
 	if x != nil {
-		return x.Name
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *GetPayoutAccountRequest) GetFields() *fieldmaskpb.FieldMask {
+func (x *CreateTransactionsRequest) GetEntries() []*CreateTransactionsRequest_Entry {
 	if x != nil {
-		return x.Fields
+		return x.Entries
 	}
 	return nil
 }
 
-type GetPayoutAccountResponse struct {
+func (x *CreateTransactionsRequest) GetFx() *FxConversion {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+type CreateTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The requested payout account.
-	PayoutAccount *PayoutAccount `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+	// Output only. The batch id of the created transactions.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The created transactions.
+	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *GetPayoutAccountResponse) Reset() {
-	*x = GetPayoutAccountResponse{}
+func (x *CreateTransactionsResponse) Reset() {
+	*x = CreateTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1496,13 +1640,13 @@ func (x *GetPayoutAccountResponse) Reset() {
 	}
 }
 
-func (x *GetPayoutAccountResponse) String() string {
+func (x *CreateTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPayoutAccountResponse) ProtoMessage() {}
+func (*CreateTransactionsResponse) ProtoMessage() {}
 
-func (x *GetPayoutAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1514,31 +1658,40 @@ func (x *GetPayoutAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPayoutAccountResponse.ProtoReflect.Descriptor instead.
-func (*GetPayoutAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetPayoutAccountResponse) GetPayoutAccount() *PayoutAccount {
+func (x *CreateTransactionsResponse) GetBatchId() string {
 	if x != nil {
-		return x.PayoutAccount
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *CreateTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
 	}
 	return nil
 }
 
-type CreateTransactionsRequest_Entry struct {
+type CreateHoldRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Relative resource name of the Wallet, for example, "users/user1/wallet"
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The Transaction to be created. Client **must** not set the Transaction.name field.
-	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The parent wallet name where the Hold is to be created. example: "users/user1/wallet"
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The Hold to be created. Client **must** not set Hold.name.
+	Hold *Hold `protobuf:"bytes,3,opt,name=hold,proto3" json:"hold,omitempty"`
 }
 
-func (x *CreateTransactionsRequest_Entry) Reset() {
-	*x = CreateTransactionsRequest_Entry{}
+func (x *CreateHoldRequest) Reset() {
+	*x = CreateHoldRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1546,13 +1699,13 @@ func (x *CreateTransactionsRequest_Entry) Reset() {
 	}
 }
 
-func (x *CreateTransactionsRequest_Entry) String() string {
+func (x *CreateHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionsRequest_Entry) ProtoMessage() {}
+func (*CreateHoldRequest) ProtoMessage() {}
 
-func (x *CreateTransactionsRequest_Entry) ProtoReflect() protoreflect.Message {
+func (x *CreateHoldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1564,52 +1717,58 @@ func (x *CreateTransactionsRequest_Entry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionsRequest_Entry.ProtoReflect.Descriptor instead.
-func (*CreateTransactionsRequest_Entry) Descriptor() ([]byte, []int) {
-	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use CreateHoldRequest.ProtoReflect.Descriptor instead.
+func (*CreateHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateTransactionsRequest_Entry) GetParent() string {
+func (x *CreateHoldRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateHoldRequest) GetParent() string {
 	if x != nil {
 		return x.Parent
 	}
 	return ""
 }
 
-func (x *CreateTransactionsRequest_Entry) GetTransaction() *Transaction {
+func (x *CreateHoldRequest) GetHold() *Hold {
 	if x != nil {
-		return x.Transaction
+		return x.Hold
 	}
 	return nil
 }
 
-type ListRechargesRequest_Filter struct {
+type CreateHoldResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status        *Recharge_Status   `protobuf:"varint,1,opt,name=status,proto3,enum=ride.payments.v1alpha1.Recharge_Status,oneof" json:"status,omitempty"`
-	CreateTime    *interval.Interval `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
-	TransactionId *string            `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// The created Hold.
+	Hold *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
 }
 
-func (x *ListRechargesRequest_Filter) Reset() {
-	*x = ListRechargesRequest_Filter{}
+func (x *CreateHoldResponse) Reset() {
+	*x = CreateHoldResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[28]
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListRechargesRequest_Filter) String() string {
+func (x *CreateHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRechargesRequest_Filter) ProtoMessage() {}
+func (*CreateHoldResponse) ProtoMessage() {}
 
-func (x *ListRechargesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[28]
+func (x *CreateHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,60 +1779,45 @@ func (x *ListRechargesRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRechargesRequest_Filter.ProtoReflect.Descriptor instead.
-func (*ListRechargesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{14, 0}
-}
-
-func (x *ListRechargesRequest_Filter) GetStatus() Recharge_Status {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return Recharge_STATUS_UNSPECIFIED
+// Deprecated: Use CreateHoldResponse.ProtoReflect.Descriptor instead.
+func (*CreateHoldResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListRechargesRequest_Filter) GetCreateTime() *interval.Interval {
+func (x *CreateHoldResponse) GetHold() *Hold {
 	if x != nil {
-		return x.CreateTime
+		return x.Hold
 	}
 	return nil
 }
 
-func (x *ListRechargesRequest_Filter) GetTransactionId() string {
-	if x != nil && x.TransactionId != nil {
-		return *x.TransactionId
-	}
-	return ""
-}
-
-// Filter to list Payouts.
-type ListPayoutsRequest_Filter struct {
+type GetHoldRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status        *Payout_Status     `protobuf:"varint,1,opt,name=status,proto3,enum=ride.payments.v1alpha1.Payout_Status,oneof" json:"status,omitempty"`
-	CreateTime    *interval.Interval `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
-	TransactionId *string            `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// Relative resource name of the Hold, for example, "users/user1/wallet/holds/hold1"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
 }
 
-func (x *ListPayoutsRequest_Filter) Reset() {
-	*x = ListPayoutsRequest_Filter{}
+func (x *GetHoldRequest) Reset() {
+	*x = GetHoldRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[29]
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListPayoutsRequest_Filter) String() string {
+func (x *GetHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPayoutsRequest_Filter) ProtoMessage() {}
+func (*GetHoldRequest) ProtoMessage() {}
 
-func (x *ListPayoutsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[29]
+func (x *GetHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,12 +1828,2556 @@ func (x *ListPayoutsRequest_Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPayoutsRequest_Filter.ProtoReflect.Descriptor instead.
-func (*ListPayoutsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use GetHoldRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListPayoutsRequest_Filter) GetStatus() Payout_Status {
+func (x *GetHoldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetHoldRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested Hold.
+	Hold *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *GetHoldResponse) Reset() {
+	*x = GetHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldResponse) ProtoMessage() {}
+
+func (x *GetHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type CaptureHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Hold, for example, "users/user1/wallet/holds/hold1"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The wallets the captured funds are credited to. The captured amount is the sum of the split amounts
+	// and **must** not exceed the held amount.
+	Splits []*CaptureHoldRequest_Split `protobuf:"bytes,3,rep,name=splits,proto3" json:"splits,omitempty"`
+	// Details of the created transactions. The reference defaults to the name of the Hold.
+	Details *Transaction_Details `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CaptureHoldRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetSplits() []*CaptureHoldRequest_Split {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *CaptureHoldRequest) GetDetails() *Transaction_Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type CaptureHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The captured Hold.
+	Hold *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	// The transactions created for the capture.
+	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CaptureHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Hold, for example, "users/user1/wallet/holds/hold1"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReleaseHoldRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The released Hold.
+	Hold *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReleaseHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReverseTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Relative resource name of the Transaction to reverse, for example, "users/user1/wallet/transactions/transaction1"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The amount to reverse. Defaults to the part of the Transaction that has not been reversed yet.
+	Amount *int64 `protobuf:"varint,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// Why the Transaction is reversed. Stored as the description of the reversal.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReverseTransactionRequest) Reset() {
+	*x = ReverseTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransactionRequest) ProtoMessage() {}
+
+func (x *ReverseTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReverseTransactionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetAmount() int64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *ReverseTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReverseTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The batch id of the reversal.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The reversal Transaction.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *ReverseTransactionResponse) Reset() {
+	*x = ReverseTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransactionResponse) ProtoMessage() {}
+
+func (x *ReverseTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ReverseTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReverseTransactionResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type ReverseBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The batch id of the Transactions to reverse.
+	BatchId string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Why the Transactions are reversed. Stored as the description of the reversals.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReverseBatchRequest) Reset() {
+	*x = ReverseBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseBatchRequest) ProtoMessage() {}
+
+func (x *ReverseBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReverseBatchRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReverseBatchRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReverseBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ReverseBatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReverseBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The batch id of the reversals.
+	BatchId string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The reversal Transactions.
+	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *ReverseBatchResponse) Reset() {
+	*x = ReverseBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseBatchResponse) ProtoMessage() {}
+
+func (x *ReverseBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseBatchResponse.ProtoReflect.Descriptor instead.
+func (*ReverseBatchResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReverseBatchResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ReverseBatchResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Transaction, for example, "users/user1/wallet/transactions/transaction1"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTransactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested Transaction.
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet, for example, "users/user1/wallet".
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of items to return. Defaults to 50 and values above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous List request, if any.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An AIP-160 filter of restrictions joined by AND, for example
+	// `type = DEBIT AND create_time >= "2024-01-01T00:00:00Z"`.
+	// Supported fields are type, batch_id, currency_code and details.reference with `=`,
+	// and create_time with `=`, `<`, `<=`, `>` and `>=`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Either "create_time desc" (the default) or "create_time asc".
+	OrderBy string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Fields  *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTransactionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of Transactions blonging to the parent Wallet.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results in the list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Wallet string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// The period of the Statement.
+	//
+	// Types that are assignable to Period:
+	//
+	//	*GetStatementRequest_Month
+	//	*GetStatementRequest_Interval
+	Period isGetStatementRequest_Period `protobuf_oneof:"period"`
+	// The currency of the Statement. Defaults to the primary currency of the Wallet if not set.
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+}
+
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementRequest) ProtoMessage() {}
+
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetStatementRequest) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (m *GetStatementRequest) GetPeriod() isGetStatementRequest_Period {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (x *GetStatementRequest) GetMonth() string {
+	if x, ok := x.GetPeriod().(*GetStatementRequest_Month); ok {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetStatementRequest) GetInterval() *interval.Interval {
+	if x, ok := x.GetPeriod().(*GetStatementRequest_Interval); ok {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *GetStatementRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type isGetStatementRequest_Period interface {
+	isGetStatementRequest_Period()
+}
+
+type GetStatementRequest_Month struct {
+	// A calendar month in Indian Standard Time, for example, "2024-05".
+	Month string `protobuf:"bytes,2,opt,name=month,proto3,oneof"`
+}
+
+type GetStatementRequest_Interval struct {
+	// An interval with both a start and an end time.
+	Interval *interval.Interval `protobuf:"bytes,3,opt,name=interval,proto3,oneof"`
+}
+
+func (*GetStatementRequest_Month) isGetStatementRequest_Period() {}
+
+func (*GetStatementRequest_Interval) isGetStatementRequest_Period() {}
+
+type GetStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested Statement.
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+}
+
+func (x *GetStatementResponse) Reset() {
+	*x = GetStatementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatementResponse) ProtoMessage() {}
+
+func (x *GetStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetStatementResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetStatementResponse) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+type ExportStatementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Wallet string `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// The period of the Statement.
+	//
+	// Types that are assignable to Period:
+	//
+	//	*ExportStatementRequest_Month
+	//	*ExportStatementRequest_Interval
+	Period isExportStatementRequest_Period `protobuf_oneof:"period"`
+	// The currency of the Statement. Defaults to the primary currency of the Wallet if not set.
+	CurrencyCode string `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The file format to render the Statement in.
+	Format ExportStatementRequest_Format `protobuf:"varint,5,opt,name=format,proto3,enum=ride.payments.v1alpha1.ExportStatementRequest_Format" json:"format,omitempty"`
+}
+
+func (x *ExportStatementRequest) Reset() {
+	*x = ExportStatementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatementRequest) ProtoMessage() {}
+
+func (x *ExportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ExportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportStatementRequest) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (m *ExportStatementRequest) GetPeriod() isExportStatementRequest_Period {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (x *ExportStatementRequest) GetMonth() string {
+	if x, ok := x.GetPeriod().(*ExportStatementRequest_Month); ok {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ExportStatementRequest) GetInterval() *interval.Interval {
+	if x, ok := x.GetPeriod().(*ExportStatementRequest_Interval); ok {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ExportStatementRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ExportStatementRequest) GetFormat() ExportStatementRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ExportStatementRequest_FORMAT_UNSPECIFIED
+}
+
+type isExportStatementRequest_Period interface {
+	isExportStatementRequest_Period()
+}
+
+type ExportStatementRequest_Month struct {
+	// A calendar month in Indian Standard Time, for example, "2024-05".
+	Month string `protobuf:"bytes,2,opt,name=month,proto3,oneof"`
+}
+
+type ExportStatementRequest_Interval struct {
+	// An interval with both a start and an end time.
+	Interval *interval.Interval `protobuf:"bytes,3,opt,name=interval,proto3,oneof"`
+}
+
+func (*ExportStatementRequest_Month) isExportStatementRequest_Period() {}
+
+func (*ExportStatementRequest_Interval) isExportStatementRequest_Period() {}
+
+type ExportStatementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The media type of the file, for example, "text/csv". Set on every chunk.
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The next chunk of the file.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportStatementResponse) Reset() {
+	*x = ExportStatementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStatementResponse) ProtoMessage() {}
+
+func (x *ExportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStatementResponse.ProtoReflect.Descriptor instead.
+func (*ExportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportStatementResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportStatementResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateRechargeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The parent wallet name where the Recharge is to be created. example: "users/user1/wallet"
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The Recharge to be created. Client **must** not set Recharge.name.
+	Recharge *Recharge `protobuf:"bytes,3,opt,name=recharge,proto3" json:"recharge,omitempty"`
+}
+
+func (x *CreateRechargeRequest) Reset() {
+	*x = CreateRechargeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRechargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRechargeRequest) ProtoMessage() {}
+
+func (x *CreateRechargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRechargeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRechargeRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateRechargeRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateRechargeRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateRechargeRequest) GetRecharge() *Recharge {
+	if x != nil {
+		return x.Recharge
+	}
+	return nil
+}
+
+type CreateRechargeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The created Recharge
+	Recharge *Recharge `protobuf:"bytes,1,opt,name=recharge,proto3" json:"recharge,omitempty"`
+	// Information required for the checkout payment gateway.
+	CheckoutInfo map[string]string `protobuf:"bytes,2,rep,name=checkout_info,json=checkoutInfo,proto3" json:"checkout_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CreateRechargeResponse) Reset() {
+	*x = CreateRechargeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRechargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRechargeResponse) ProtoMessage() {}
+
+func (x *CreateRechargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRechargeResponse.ProtoReflect.Descriptor instead.
+func (*CreateRechargeResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateRechargeResponse) GetRecharge() *Recharge {
+	if x != nil {
+		return x.Recharge
+	}
+	return nil
+}
+
+func (x *CreateRechargeResponse) GetCheckoutInfo() map[string]string {
+	if x != nil {
+		return x.CheckoutInfo
+	}
+	return nil
+}
+
+type GetRechargeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative Resource name of the Recharge, for example, "users/user1/wallet/recharges/recharge1"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *GetRechargeRequest) Reset() {
+	*x = GetRechargeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRechargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRechargeRequest) ProtoMessage() {}
+
+func (x *GetRechargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRechargeRequest.ProtoReflect.Descriptor instead.
+func (*GetRechargeRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetRechargeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetRechargeRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetRechargeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested recharge.
+	Recharge *Recharge `protobuf:"bytes,1,opt,name=recharge,proto3" json:"recharge,omitempty"`
+}
+
+func (x *GetRechargeResponse) Reset() {
+	*x = GetRechargeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRechargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRechargeResponse) ProtoMessage() {}
+
+func (x *GetRechargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRechargeResponse.ProtoReflect.Descriptor instead.
+func (*GetRechargeResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetRechargeResponse) GetRecharge() *Recharge {
+	if x != nil {
+		return x.Recharge
+	}
+	return nil
+}
+
+type ListRechargesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent wallet name, for example, "users/user1/wallet"
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of items to return. Defaults to 50 and values above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous List request, if any.
+	PageToken string                       `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter    *ListRechargesRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Fields    *fieldmaskpb.FieldMask       `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *ListRechargesRequest) Reset() {
+	*x = ListRechargesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRechargesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRechargesRequest) ProtoMessage() {}
+
+func (x *ListRechargesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRechargesRequest.ProtoReflect.Descriptor instead.
+func (*ListRechargesRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListRechargesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListRechargesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRechargesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRechargesRequest) GetFilter() *ListRechargesRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRechargesRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListRechargesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// There will be a maximum number of items returned based on the page_size field in the request.
+	Recharges []*Recharge `protobuf:"bytes,1,rep,name=recharges,proto3" json:"recharges,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results in the list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListRechargesResponse) Reset() {
+	*x = ListRechargesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRechargesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRechargesResponse) ProtoMessage() {}
+
+func (x *ListRechargesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRechargesResponse.ProtoReflect.Descriptor instead.
+func (*ListRechargesResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListRechargesResponse) GetRecharges() []*Recharge {
+	if x != nil {
+		return x.Recharges
+	}
+	return nil
+}
+
+func (x *ListRechargesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreatePayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The parent wallet name where the Payout is to be created. example: "users/user1/wallet"
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The Payout to be created. Client **must** not set Payout.name.
+	Payout *Payout `protobuf:"bytes,3,opt,name=payout,proto3" json:"payout,omitempty"`
+}
+
+func (x *CreatePayoutRequest) Reset() {
+	*x = CreatePayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutRequest) ProtoMessage() {}
+
+func (x *CreatePayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayoutRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreatePayoutRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreatePayoutRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreatePayoutRequest) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+type CreatePayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The created Payout
+	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+}
+
+func (x *CreatePayoutResponse) Reset() {
+	*x = CreatePayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutResponse) ProtoMessage() {}
+
+func (x *CreatePayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutResponse.ProtoReflect.Descriptor instead.
+func (*CreatePayoutResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreatePayoutResponse) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+type GetPayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative Resource name of the Payout, for example, "users/user1/wallet/payouts/payout1"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *GetPayoutRequest) Reset() {
+	*x = GetPayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutRequest) ProtoMessage() {}
+
+func (x *GetPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPayoutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPayoutRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetPayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested payout.
+	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+}
+
+func (x *GetPayoutResponse) Reset() {
+	*x = GetPayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutResponse) ProtoMessage() {}
+
+func (x *GetPayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetPayoutResponse) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+type ListPayoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The parent wallet name, for example, "users/user1/wallet"
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of items to return. Defaults to 50 and values above 100 are coerced to 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous List request, if any.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Filter to list Payouts.
+	Filter *ListPayoutsRequest_Filter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The fields to return. If empty or omitted, all fields are returned.
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *ListPayoutsRequest) Reset() {
+	*x = ListPayoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest) ProtoMessage() {}
+
+func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListPayoutsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListPayoutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPayoutsRequest) GetFilter() *ListPayoutsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListPayoutsRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ListPayoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// There will be a maximum number of items returned based on the page_size field in the request.
+	Payouts []*Payout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results in the list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPayoutsResponse) Reset() {
+	*x = ListPayoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsResponse) ProtoMessage() {}
+
+func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListPayoutsResponse) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ListPayoutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelPayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative Resource name of the Payout, for example, "users/user1/wallet/payouts/payout1"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CancelPayoutRequest) Reset() {
+	*x = CancelPayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPayoutRequest) ProtoMessage() {}
+
+func (x *CancelPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPayoutRequest.ProtoReflect.Descriptor instead.
+func (*CancelPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CancelPayoutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CancelPayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cancelled Payout.
+	Payout *Payout `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout,omitempty"`
+}
+
+func (x *CancelPayoutResponse) Reset() {
+	*x = CancelPayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPayoutResponse) ProtoMessage() {}
+
+func (x *CancelPayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPayoutResponse.ProtoReflect.Descriptor instead.
+func (*CancelPayoutResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CancelPayoutResponse) GetPayout() *Payout {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+type CreatePayoutAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The PayoutAccount to be created. Client **must** set PayoutAccount.name.
+	PayoutAccount *PayoutAccount `protobuf:"bytes,2,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+}
+
+func (x *CreatePayoutAccountRequest) Reset() {
+	*x = CreatePayoutAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePayoutAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutAccountRequest) ProtoMessage() {}
+
+func (x *CreatePayoutAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayoutAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreatePayoutAccountRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreatePayoutAccountRequest) GetPayoutAccount() *PayoutAccount {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return nil
+}
+
+type CreatePayoutAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The created PayoutAccount
+	PayoutAccount *PayoutAccount `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+}
+
+func (x *CreatePayoutAccountResponse) Reset() {
+	*x = CreatePayoutAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePayoutAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutAccountResponse) ProtoMessage() {}
+
+func (x *CreatePayoutAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreatePayoutAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreatePayoutAccountResponse) GetPayoutAccount() *PayoutAccount {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return nil
+}
+
+type GetPayoutAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative Resource name of the PayoutAccount, for example, "users/user1/wallet/payout-account"
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields *fieldmaskpb.FieldMask `protobuf:"bytes,99,opt,name=fields,proto3,oneof" json:"fields,omitempty"`
+}
+
+func (x *GetPayoutAccountRequest) Reset() {
+	*x = GetPayoutAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoutAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutAccountRequest) ProtoMessage() {}
+
+func (x *GetPayoutAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetPayoutAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetPayoutAccountRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type GetPayoutAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The requested payout account.
+	PayoutAccount *PayoutAccount `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+}
+
+func (x *GetPayoutAccountResponse) Reset() {
+	*x = GetPayoutAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoutAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutAccountResponse) ProtoMessage() {}
+
+func (x *GetPayoutAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoutAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPayoutAccountResponse) GetPayoutAccount() *PayoutAccount {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return nil
+}
+
+type UpdatePayoutAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique request ID for server to detect duplicated requests for idempotency.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The PayoutAccount to be updated. Client **must** set PayoutAccount.name.
+	PayoutAccount *PayoutAccount `protobuf:"bytes,2,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+	// The fields to update. Only the destination, "bank_account" or "upi_id", can be updated.
+	// When unset, the destination set on payout_account is used.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdatePayoutAccountRequest) Reset() {
+	*x = UpdatePayoutAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePayoutAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePayoutAccountRequest) ProtoMessage() {}
+
+func (x *UpdatePayoutAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePayoutAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePayoutAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdatePayoutAccountRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *UpdatePayoutAccountRequest) GetPayoutAccount() *PayoutAccount {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return nil
+}
+
+func (x *UpdatePayoutAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePayoutAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated PayoutAccount
+	PayoutAccount *PayoutAccount `protobuf:"bytes,1,opt,name=payout_account,json=payoutAccount,proto3" json:"payout_account,omitempty"`
+}
+
+func (x *UpdatePayoutAccountResponse) Reset() {
+	*x = UpdatePayoutAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePayoutAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePayoutAccountResponse) ProtoMessage() {}
+
+func (x *UpdatePayoutAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePayoutAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePayoutAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdatePayoutAccountResponse) GetPayoutAccount() *PayoutAccount {
+	if x != nil {
+		return x.PayoutAccount
+	}
+	return nil
+}
+
+type ListTransfersRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list transfers in this direction. Both directions are listed when unspecified.
+	Direction ListTransfersRequest_Direction `protobuf:"varint,1,opt,name=direction,proto3,enum=ride.payments.v1alpha1.ListTransfersRequest_Direction" json:"direction,omitempty"`
+}
+
+func (x *ListTransfersRequest_Filter) Reset() {
+	*x = ListTransfersRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTransfersRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest_Filter) ProtoMessage() {}
+
+func (x *ListTransfersRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ListTransfersRequest_Filter) GetDirection() ListTransfersRequest_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ListTransfersRequest_DIRECTION_UNSPECIFIED
+}
+
+type CreateTransactionsRequest_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet, for example, "users/user1/wallet"
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The Transaction to be created. Client **must** not set the Transaction.name field.
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *CreateTransactionsRequest_Entry) Reset() {
+	*x = CreateTransactionsRequest_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTransactionsRequest_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionsRequest_Entry) ProtoMessage() {}
+
+func (x *CreateTransactionsRequest_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionsRequest_Entry.ProtoReflect.Descriptor instead.
+func (*CreateTransactionsRequest_Entry) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *CreateTransactionsRequest_Entry) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTransactionsRequest_Entry) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type CaptureHoldRequest_Split struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Relative resource name of the Wallet to credit, for example, "users/user2/wallet"
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The amount to credit in the smallest currency unit.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *CaptureHoldRequest_Split) Reset() {
+	*x = CaptureHoldRequest_Split{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureHoldRequest_Split) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest_Split) ProtoMessage() {}
+
+func (x *CaptureHoldRequest_Split) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest_Split.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest_Split) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *CaptureHoldRequest_Split) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest_Split) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ListRechargesRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        *Recharge_Status   `protobuf:"varint,1,opt,name=status,proto3,enum=ride.payments.v1alpha1.Recharge_Status,oneof" json:"status,omitempty"`
+	CreateTime    *interval.Interval `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
+	TransactionId *string            `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+}
+
+func (x *ListRechargesRequest_Filter) Reset() {
+	*x = ListRechargesRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRechargesRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRechargesRequest_Filter) ProtoMessage() {}
+
+func (x *ListRechargesRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRechargesRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListRechargesRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *ListRechargesRequest_Filter) GetStatus() Recharge_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return Recharge_STATUS_UNSPECIFIED
+}
+
+func (x *ListRechargesRequest_Filter) GetCreateTime() *interval.Interval {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ListRechargesRequest_Filter) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+// Filter to list Payouts.
+type ListPayoutsRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        *Payout_Status     `protobuf:"varint,1,opt,name=status,proto3,enum=ride.payments.v1alpha1.Payout_Status,oneof" json:"status,omitempty"`
+	CreateTime    *interval.Interval `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3,oneof" json:"create_time,omitempty"`
+	TransactionId *string            `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+}
+
+func (x *ListPayoutsRequest_Filter) Reset() {
+	*x = ListPayoutsRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPayoutsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest_Filter) ProtoMessage() {}
+
+func (x *ListPayoutsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_service_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *ListPayoutsRequest_Filter) GetStatus() Payout_Status {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
@@ -1736,7 +4424,14 @@ var file_ride_payments_v1alpha1_service_proto_rawDesc = []byte{
 	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
 	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x72, 0x69, 0x64, 0x65, 0x2f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x72, 0x69,
+	0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x26, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65,
 	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48,
 	0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
@@ -1750,230 +4445,527 @@ var file_ride_payments_v1alpha1_service_proto_rawDesc = []byte{
 	0x65, 0x12, 0x36, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
 	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a,
-	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xae, 0x02,
-	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x71, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba,
+	0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc4, 0x01, 0x0a,
+	0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x48, 0x00, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x47,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x5b, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x72, 0x69,
-	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x4d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8a,
-	0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0c, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x40, 0xba, 0x48, 0x3d, 0x72, 0x3b, 0x32, 0x39, 0x5e, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72,
+	0x18, 0x32, 0x16, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0xd0, 0x01, 0x01,
+	0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x56, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f,
+	0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x28, 0x80,
+	0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x14, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x22, 0xb6, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f,
+	0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x18, 0x00, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21,
+	0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x28, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x15, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21,
+	0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x28, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x16, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f,
+	0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x28, 0x80,
+	0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x22, 0x72, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x81, 0x01,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x22, 0x7e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xba, 0x48, 0x3a, 0x72, 0x38, 0x32, 0x36, 0x5e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
 	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66,
 	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
-	0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xd7, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48,
-	0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
-	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x5b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xec, 0x03, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x68,
+	0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
+	0x54, 0x47, 0x4f, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x02, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x5b, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x02, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x02, 0x66, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x78, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x66, 0x78, 0x1a, 0x94, 0x01, 0x0a,
+	0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x51, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x02, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xaa, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x4e, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xa3, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39,
+	0xba, 0x48, 0x36, 0x72, 0x34, 0x32, 0x32, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64,
+	0x22, 0x97, 0x03, 0x0a, 0x12, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xba, 0x48, 0x36, 0x72, 0x34, 0x32, 0x32, 0x5e, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x2f,
+	0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x70, 0x0a, 0x05, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x43,
+	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x39, 0xba, 0x48, 0x36, 0x72, 0x34,
+	0x32, 0x32, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x13, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x19,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xba, 0x48, 0x3d, 0x72, 0x3b, 0x32, 0x39, 0x5e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x28, 0x80, 0x01,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x4d, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xba, 0x48, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x07,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x28, 0x80, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
+	0x51, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xba, 0x48, 0x3d, 0x72,
+	0x3b, 0x32, 0x39, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70,
 	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
-	0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x44, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61,
-	0x72, 0x67, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x65,
-	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
-	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e,
-	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3f, 0x0a,
-	0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab,
-	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x3d, 0xba, 0x48, 0x3a, 0x72, 0x38, 0x32, 0x36, 0x5e, 0x75, 0x73, 0x65,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x8b, 0x01,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8f, 0x02, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65,
 	0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
-	0x65, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
-	0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x22, 0x88, 0x04, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
-	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x69,
+	0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x39, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xba, 0x48, 0x1e, 0x72, 0x1c, 0x32, 0x1a, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x34, 0x7d, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d, 0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32,
+	0x5d, 0x29, 0x24, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0xd0, 0x01, 0x01,
+	0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0f, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x5f, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xae,
+	0x03, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72, 0x1f,
+	0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24, 0x52,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xba, 0x48, 0x1e, 0x72, 0x1c, 0x32, 0x1a, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x28, 0x30, 0x5b, 0x31, 0x2d, 0x39, 0x5d,
+	0x7c, 0x31, 0x5b, 0x30, 0x2d, 0x32, 0x5d, 0x29, 0x24, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x12, 0x33, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0xba, 0x48, 0x11, 0xd0, 0x01, 0x01, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d,
+	0x7b, 0x33, 0x7d, 0x24, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x58, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x09, 0xba, 0x48, 0x06, 0x82, 0x01,
+	0x03, 0x22, 0x01, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x40, 0x0a, 0x06,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x02, 0x42, 0x0f,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22,
+	0x50, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48, 0x21, 0x72,
+	0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x24,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x22, 0x86,
+	0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69,
 	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x1a, 0xe5, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x72,
-	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x01,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x09, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a,
-	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xba, 0x48,
-	0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12,
+	0x65, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xba, 0x48,
+	0x3a, 0x72, 0x38, 0x32, 0x36, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
 	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61,
-	0x79, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64,
-	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0xba, 0x48, 0x38, 0x72, 0x36, 0x32, 0x34, 0x5e, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
-	0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x22, 0x82, 0x04, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72, 0x1e, 0x32,
-	0x1c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30,
-	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x49, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x88, 0x01, 0x01, 0x1a, 0xe3, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x74, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x22, 0x91, 0x04, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20,
+	0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x1a, 0xe5, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
 	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
 	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
@@ -1985,177 +4977,503 @@ var file_ride_payments_v1alpha1_service_proto_rawDesc = []byte{
 	0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61,
 	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x77, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
-	0x79, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x91, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x54, 0x0a,
-	0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x64,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x09, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3c,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24,
+	0xba, 0x48, 0x21, 0x72, 0x1f, 0x32, 0x1d, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x24, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0xba, 0x48, 0x38, 0x72, 0x36, 0x32, 0x34,
+	0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x53,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x22, 0x8b, 0x04, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72,
+	0x1e, 0x32, 0x1c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x49, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01,
+	0x1a, 0xe3, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x01, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x77, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x13, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3b, 0xba, 0x48, 0x38, 0x72, 0x36, 0x32, 0x34, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x64,
 	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x33, 0xba, 0x48, 0x30, 0x72, 0x2e, 0x32, 0x2c, 0x5e, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
-	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a,
-	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x22, 0x70, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63,
+	0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x1a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73,
+	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
 	0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
 	0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48,
 	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x32, 0xba, 0x0f, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x75, 0x6e, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x47, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xba,
+	0x48, 0x30, 0x72, 0x2e, 0x32, 0x2c, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xce,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0e,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22,
+	0x73, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x32, 0xf2, 0x2a, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x97,
+	0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
+	0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a,
+	0x7d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x0c, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22,
+	0x26, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d,
+	0x3a, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
 	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
-	0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x72, 0x69,
+	0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01,
+	0x2a, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x7d, 0x3a, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0xa4, 0x01, 0x0a, 0x0e, 0x55,
+	0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2d, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a,
+	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x3a, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x12, 0x98, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x3a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0xae, 0x01, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
+	0x12, 0x2e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x3a, 0x73, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x9b, 0x01,
+	0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2b,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69,
 	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x69, 0x64,
-	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x2a, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2d, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x3a, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x92, 0x01, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x2e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xa1,
+	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a,
+	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0xb9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2d, 0x2f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x97,
+	0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x29, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
 	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0xa7, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x2d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22,
-	0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22,
+	0x27, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
 	0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x72,
+	0x74, 0x7d, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x48, 0x6f, 0x6c, 0x64, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72,
 	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f,
 	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x72, 0x65,
-	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x72,
-	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x72, 0x69, 0x64,
+	0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x2f, 0x2a, 0x7d, 0x3a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x12, 0xa2, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x2a, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22,
+	0x2f, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f,
+	0x68, 0x6f, 0x6c, 0x64, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0xbe, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x72, 0x69, 0x64,
 	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x9f,
-	0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
-	0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2d, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0xa7, 0x01, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72,
+	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
+	0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x3d, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0xb0, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f,
+	0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0xa7, 0x01, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x2d, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72,
 	0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a,
-	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73,
-	0x12, 0x93, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x28,
-	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f,
-	0x75, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x2e, 0x72, 0x69, 0x64,
-	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x2f,
+	0x2a, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d, 0x2f, 0x72, 0x65, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x9f, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x7d,
+	0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x28, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x99,
+	0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2a,
 	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30,
-	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x7d, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0xad, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x64,
+	0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x7d, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0c, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01,
+	0x2a, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0xbb, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x2e, 0x72, 0x69,
+	0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22,
+	0x30, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x7d, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0xad, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61,
 	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
-	0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d,
-	0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
-	0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x69, 0x64,
-	0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x30, 0x12, 0x2e, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x7d, 0x12, 0xd5, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x2e, 0x72, 0x69, 0x64, 0x65,
+	0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4f, 0x3a, 0x0e, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x3d, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x2a, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70,
+	0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2170,113 +5488,240 @@ func file_ride_payments_v1alpha1_service_proto_rawDescGZIP() []byte {
 	return file_ride_payments_v1alpha1_service_proto_rawDescData
 }
 
-var file_ride_payments_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_ride_payments_v1alpha1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ride_payments_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_ride_payments_v1alpha1_service_proto_goTypes = []interface{}{
-	(*GetWalletRequest)(nil),                // 0: ride.payments.v1alpha1.GetWalletRequest
-	(*GetWalletResponse)(nil),               // 1: ride.payments.v1alpha1.GetWalletResponse
-	(*CreateTransfersRequest)(nil),          // 2: ride.payments.v1alpha1.CreateTransfersRequest
-	(*CreateTransfersResponse)(nil),         // 3: ride.payments.v1alpha1.CreateTransfersResponse
-	(*CreateTransactionsRequest)(nil),       // 4: ride.payments.v1alpha1.CreateTransactionsRequest
-	(*CreateTransactionsResponse)(nil),      // 5: ride.payments.v1alpha1.CreateTransactionsResponse
-	(*GetTransactionRequest)(nil),           // 6: ride.payments.v1alpha1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),          // 7: ride.payments.v1alpha1.GetTransactionResponse
-	(*ListTransactionsRequest)(nil),         // 8: ride.payments.v1alpha1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),        // 9: ride.payments.v1alpha1.ListTransactionsResponse
-	(*CreateRechargeRequest)(nil),           // 10: ride.payments.v1alpha1.CreateRechargeRequest
-	(*CreateRechargeResponse)(nil),          // 11: ride.payments.v1alpha1.CreateRechargeResponse
-	(*GetRechargeRequest)(nil),              // 12: ride.payments.v1alpha1.GetRechargeRequest
-	(*GetRechargeResponse)(nil),             // 13: ride.payments.v1alpha1.GetRechargeResponse
-	(*ListRechargesRequest)(nil),            // 14: ride.payments.v1alpha1.ListRechargesRequest
-	(*ListRechargesResponse)(nil),           // 15: ride.payments.v1alpha1.ListRechargesResponse
-	(*CreatePayoutRequest)(nil),             // 16: ride.payments.v1alpha1.CreatePayoutRequest
-	(*CreatePayoutResponse)(nil),            // 17: ride.payments.v1alpha1.CreatePayoutResponse
-	(*GetPayoutRequest)(nil),                // 18: ride.payments.v1alpha1.GetPayoutRequest
-	(*GetPayoutResponse)(nil),               // 19: ride.payments.v1alpha1.GetPayoutResponse
-	(*ListPayoutsRequest)(nil),              // 20: ride.payments.v1alpha1.ListPayoutsRequest
-	(*ListPayoutsResponse)(nil),             // 21: ride.payments.v1alpha1.ListPayoutsResponse
-	(*CreatePayoutAccountRequest)(nil),      // 22: ride.payments.v1alpha1.CreatePayoutAccountRequest
-	(*CreatePayoutAccountResponse)(nil),     // 23: ride.payments.v1alpha1.CreatePayoutAccountResponse
-	(*GetPayoutAccountRequest)(nil),         // 24: ride.payments.v1alpha1.GetPayoutAccountRequest
-	(*GetPayoutAccountResponse)(nil),        // 25: ride.payments.v1alpha1.GetPayoutAccountResponse
-	(*CreateTransactionsRequest_Entry)(nil), // 26: ride.payments.v1alpha1.CreateTransactionsRequest.Entry
-	nil,                                     // 27: ride.payments.v1alpha1.CreateRechargeResponse.CheckoutInfoEntry
-	(*ListRechargesRequest_Filter)(nil),     // 28: ride.payments.v1alpha1.ListRechargesRequest.Filter
-	(*ListPayoutsRequest_Filter)(nil),       // 29: ride.payments.v1alpha1.ListPayoutsRequest.Filter
-	(*fieldmaskpb.FieldMask)(nil),           // 30: google.protobuf.FieldMask
-	(*Wallet)(nil),                          // 31: ride.payments.v1alpha1.Wallet
-	(*Transfer)(nil),                        // 32: ride.payments.v1alpha1.Transfer
-	(*Transaction)(nil),                     // 33: ride.payments.v1alpha1.Transaction
-	(*Recharge)(nil),                        // 34: ride.payments.v1alpha1.Recharge
-	(*Payout)(nil),                          // 35: ride.payments.v1alpha1.Payout
-	(*PayoutAccount)(nil),                   // 36: ride.payments.v1alpha1.PayoutAccount
-	(Recharge_Status)(0),                    // 37: ride.payments.v1alpha1.Recharge.Status
-	(*interval.Interval)(nil),               // 38: google.type.Interval
-	(Payout_Status)(0),                      // 39: ride.payments.v1alpha1.Payout.Status
+	(ListTransfersRequest_Direction)(0),     // 0: ride.payments.v1alpha1.ListTransfersRequest.Direction
+	(ExportStatementRequest_Format)(0),      // 1: ride.payments.v1alpha1.ExportStatementRequest.Format
+	(*GetWalletRequest)(nil),                // 2: ride.payments.v1alpha1.GetWalletRequest
+	(*GetWalletResponse)(nil),               // 3: ride.payments.v1alpha1.GetWalletResponse
+	(*WatchWalletRequest)(nil),              // 4: ride.payments.v1alpha1.WatchWalletRequest
+	(*WatchWalletResponse)(nil),             // 5: ride.payments.v1alpha1.WatchWalletResponse
+	(*CreateWalletRequest)(nil),             // 6: ride.payments.v1alpha1.CreateWalletRequest
+	(*CreateWalletResponse)(nil),            // 7: ride.payments.v1alpha1.CreateWalletResponse
+	(*FreezeWalletRequest)(nil),             // 8: ride.payments.v1alpha1.FreezeWalletRequest
+	(*FreezeWalletResponse)(nil),            // 9: ride.payments.v1alpha1.FreezeWalletResponse
+	(*SetBalanceFloorRequest)(nil),          // 10: ride.payments.v1alpha1.SetBalanceFloorRequest
+	(*SetBalanceFloorResponse)(nil),         // 11: ride.payments.v1alpha1.SetBalanceFloorResponse
+	(*SuspendWalletRequest)(nil),            // 12: ride.payments.v1alpha1.SuspendWalletRequest
+	(*SuspendWalletResponse)(nil),           // 13: ride.payments.v1alpha1.SuspendWalletResponse
+	(*UnfreezeWalletRequest)(nil),           // 14: ride.payments.v1alpha1.UnfreezeWalletRequest
+	(*UnfreezeWalletResponse)(nil),          // 15: ride.payments.v1alpha1.UnfreezeWalletResponse
+	(*CloseWalletRequest)(nil),              // 16: ride.payments.v1alpha1.CloseWalletRequest
+	(*CloseWalletResponse)(nil),             // 17: ride.payments.v1alpha1.CloseWalletResponse
+	(*VerifyLedgerRequest)(nil),             // 18: ride.payments.v1alpha1.VerifyLedgerRequest
+	(*VerifyLedgerResponse)(nil),            // 19: ride.payments.v1alpha1.VerifyLedgerResponse
+	(*CreateTransfersRequest)(nil),          // 20: ride.payments.v1alpha1.CreateTransfersRequest
+	(*CreateTransfersResponse)(nil),         // 21: ride.payments.v1alpha1.CreateTransfersResponse
+	(*GetTransferRequest)(nil),              // 22: ride.payments.v1alpha1.GetTransferRequest
+	(*GetTransferResponse)(nil),             // 23: ride.payments.v1alpha1.GetTransferResponse
+	(*ListTransfersRequest)(nil),            // 24: ride.payments.v1alpha1.ListTransfersRequest
+	(*ListTransfersResponse)(nil),           // 25: ride.payments.v1alpha1.ListTransfersResponse
+	(*CreateTransactionsRequest)(nil),       // 26: ride.payments.v1alpha1.CreateTransactionsRequest
+	(*CreateTransactionsResponse)(nil),      // 27: ride.payments.v1alpha1.CreateTransactionsResponse
+	(*CreateHoldRequest)(nil),               // 28: ride.payments.v1alpha1.CreateHoldRequest
+	(*CreateHoldResponse)(nil),              // 29: ride.payments.v1alpha1.CreateHoldResponse
+	(*GetHoldRequest)(nil),                  // 30: ride.payments.v1alpha1.GetHoldRequest
+	(*GetHoldResponse)(nil),                 // 31: ride.payments.v1alpha1.GetHoldResponse
+	(*CaptureHoldRequest)(nil),              // 32: ride.payments.v1alpha1.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),             // 33: ride.payments.v1alpha1.CaptureHoldResponse
+	(*ReleaseHoldRequest)(nil),              // 34: ride.payments.v1alpha1.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),             // 35: ride.payments.v1alpha1.ReleaseHoldResponse
+	(*ReverseTransactionRequest)(nil),       // 36: ride.payments.v1alpha1.ReverseTransactionRequest
+	(*ReverseTransactionResponse)(nil),      // 37: ride.payments.v1alpha1.ReverseTransactionResponse
+	(*ReverseBatchRequest)(nil),             // 38: ride.payments.v1alpha1.ReverseBatchRequest
+	(*ReverseBatchResponse)(nil),            // 39: ride.payments.v1alpha1.ReverseBatchResponse
+	(*GetTransactionRequest)(nil),           // 40: ride.payments.v1alpha1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),          // 41: ride.payments.v1alpha1.GetTransactionResponse
+	(*ListTransactionsRequest)(nil),         // 42: ride.payments.v1alpha1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),        // 43: ride.payments.v1alpha1.ListTransactionsResponse
+	(*GetStatementRequest)(nil),             // 44: ride.payments.v1alpha1.GetStatementRequest
+	(*GetStatementResponse)(nil),            // 45: ride.payments.v1alpha1.GetStatementResponse
+	(*ExportStatementRequest)(nil),          // 46: ride.payments.v1alpha1.ExportStatementRequest
+	(*ExportStatementResponse)(nil),         // 47: ride.payments.v1alpha1.ExportStatementResponse
+	(*CreateRechargeRequest)(nil),           // 48: ride.payments.v1alpha1.CreateRechargeRequest
+	(*CreateRechargeResponse)(nil),          // 49: ride.payments.v1alpha1.CreateRechargeResponse
+	(*GetRechargeRequest)(nil),              // 50: ride.payments.v1alpha1.GetRechargeRequest
+	(*GetRechargeResponse)(nil),             // 51: ride.payments.v1alpha1.GetRechargeResponse
+	(*ListRechargesRequest)(nil),            // 52: ride.payments.v1alpha1.ListRechargesRequest
+	(*ListRechargesResponse)(nil),           // 53: ride.payments.v1alpha1.ListRechargesResponse
+	(*CreatePayoutRequest)(nil),             // 54: ride.payments.v1alpha1.CreatePayoutRequest
+	(*CreatePayoutResponse)(nil),            // 55: ride.payments.v1alpha1.CreatePayoutResponse
+	(*GetPayoutRequest)(nil),                // 56: ride.payments.v1alpha1.GetPayoutRequest
+	(*GetPayoutResponse)(nil),               // 57: ride.payments.v1alpha1.GetPayoutResponse
+	(*ListPayoutsRequest)(nil),              // 58: ride.payments.v1alpha1.ListPayoutsRequest
+	(*ListPayoutsResponse)(nil),             // 59: ride.payments.v1alpha1.ListPayoutsResponse
+	(*CancelPayoutRequest)(nil),             // 60: ride.payments.v1alpha1.CancelPayoutRequest
+	(*CancelPayoutResponse)(nil),            // 61: ride.payments.v1alpha1.CancelPayoutResponse
+	(*CreatePayoutAccountRequest)(nil),      // 62: ride.payments.v1alpha1.CreatePayoutAccountRequest
+	(*CreatePayoutAccountResponse)(nil),     // 63: ride.payments.v1alpha1.CreatePayoutAccountResponse
+	(*GetPayoutAccountRequest)(nil),         // 64: ride.payments.v1alpha1.GetPayoutAccountRequest
+	(*GetPayoutAccountResponse)(nil),        // 65: ride.payments.v1alpha1.GetPayoutAccountResponse
+	(*UpdatePayoutAccountRequest)(nil),      // 66: ride.payments.v1alpha1.UpdatePayoutAccountRequest
+	(*UpdatePayoutAccountResponse)(nil),     // 67: ride.payments.v1alpha1.UpdatePayoutAccountResponse
+	(*ListTransfersRequest_Filter)(nil),     // 68: ride.payments.v1alpha1.ListTransfersRequest.Filter
+	(*CreateTransactionsRequest_Entry)(nil), // 69: ride.payments.v1alpha1.CreateTransactionsRequest.Entry
+	(*CaptureHoldRequest_Split)(nil),        // 70: ride.payments.v1alpha1.CaptureHoldRequest.Split
+	nil,                                     // 71: ride.payments.v1alpha1.CreateRechargeResponse.CheckoutInfoEntry
+	(*ListRechargesRequest_Filter)(nil),     // 72: ride.payments.v1alpha1.ListRechargesRequest.Filter
+	(*ListPayoutsRequest_Filter)(nil),       // 73: ride.payments.v1alpha1.ListPayoutsRequest.Filter
+	(*fieldmaskpb.FieldMask)(nil),           // 74: google.protobuf.FieldMask
+	(*Wallet)(nil),                          // 75: ride.payments.v1alpha1.Wallet
+	(*Transaction)(nil),                     // 76: ride.payments.v1alpha1.Transaction
+	(*LedgerDiscrepancy)(nil),               // 77: ride.payments.v1alpha1.LedgerDiscrepancy
+	(*Transfer)(nil),                        // 78: ride.payments.v1alpha1.Transfer
+	(*FxConversion)(nil),                    // 79: ride.payments.v1alpha1.FxConversion
+	(*Hold)(nil),                            // 80: ride.payments.v1alpha1.Hold
+	(*Transaction_Details)(nil),             // 81: ride.payments.v1alpha1.Transaction.Details
+	(*interval.Interval)(nil),               // 82: google.type.Interval
+	(*Statement)(nil),                       // 83: ride.payments.v1alpha1.Statement
+	(*Recharge)(nil),                        // 84: ride.payments.v1alpha1.Recharge
+	(*Payout)(nil),                          // 85: ride.payments.v1alpha1.Payout
+	(*PayoutAccount)(nil),                   // 86: ride.payments.v1alpha1.PayoutAccount
+	(Recharge_Status)(0),                    // 87: ride.payments.v1alpha1.Recharge.Status
+	(Payout_Status)(0),                      // 88: ride.payments.v1alpha1.Payout.Status
 }
 var file_ride_payments_v1alpha1_service_proto_depIdxs = []int32{
-	30, // 0: ride.payments.v1alpha1.GetWalletRequest.fields:type_name -> google.protobuf.FieldMask
-	31, // 1: ride.payments.v1alpha1.GetWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
-	32, // 2: ride.payments.v1alpha1.CreateTransfersRequest.transfers:type_name -> ride.payments.v1alpha1.Transfer
-	32, // 3: ride.payments.v1alpha1.CreateTransfersResponse.transfers:type_name -> ride.payments.v1alpha1.Transfer
-	26, // 4: ride.payments.v1alpha1.CreateTransactionsRequest.entries:type_name -> ride.payments.v1alpha1.CreateTransactionsRequest.Entry
-	33, // 5: ride.payments.v1alpha1.CreateTransactionsResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
-	30, // 6: ride.payments.v1alpha1.GetTransactionRequest.fields:type_name -> google.protobuf.FieldMask
-	33, // 7: ride.payments.v1alpha1.GetTransactionResponse.transaction:type_name -> ride.payments.v1alpha1.Transaction
-	30, // 8: ride.payments.v1alpha1.ListTransactionsRequest.fields:type_name -> google.protobuf.FieldMask
-	33, // 9: ride.payments.v1alpha1.ListTransactionsResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
-	34, // 10: ride.payments.v1alpha1.CreateRechargeRequest.recharge:type_name -> ride.payments.v1alpha1.Recharge
-	34, // 11: ride.payments.v1alpha1.CreateRechargeResponse.recharge:type_name -> ride.payments.v1alpha1.Recharge
-	27, // 12: ride.payments.v1alpha1.CreateRechargeResponse.checkout_info:type_name -> ride.payments.v1alpha1.CreateRechargeResponse.CheckoutInfoEntry
-	30, // 13: ride.payments.v1alpha1.GetRechargeRequest.fields:type_name -> google.protobuf.FieldMask
-	34, // 14: ride.payments.v1alpha1.GetRechargeResponse.recharge:type_name -> ride.payments.v1alpha1.Recharge
-	28, // 15: ride.payments.v1alpha1.ListRechargesRequest.filter:type_name -> ride.payments.v1alpha1.ListRechargesRequest.Filter
-	30, // 16: ride.payments.v1alpha1.ListRechargesRequest.fields:type_name -> google.protobuf.FieldMask
-	34, // 17: ride.payments.v1alpha1.ListRechargesResponse.recharges:type_name -> ride.payments.v1alpha1.Recharge
-	35, // 18: ride.payments.v1alpha1.CreatePayoutRequest.payout:type_name -> ride.payments.v1alpha1.Payout
-	35, // 19: ride.payments.v1alpha1.CreatePayoutResponse.payout:type_name -> ride.payments.v1alpha1.Payout
-	30, // 20: ride.payments.v1alpha1.GetPayoutRequest.fields:type_name -> google.protobuf.FieldMask
-	35, // 21: ride.payments.v1alpha1.GetPayoutResponse.payout:type_name -> ride.payments.v1alpha1.Payout
-	29, // 22: ride.payments.v1alpha1.ListPayoutsRequest.filter:type_name -> ride.payments.v1alpha1.ListPayoutsRequest.Filter
-	30, // 23: ride.payments.v1alpha1.ListPayoutsRequest.fields:type_name -> google.protobuf.FieldMask
-	35, // 24: ride.payments.v1alpha1.ListPayoutsResponse.payouts:type_name -> ride.payments.v1alpha1.Payout
-	36, // 25: ride.payments.v1alpha1.CreatePayoutAccountRequest.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
-	36, // 26: ride.payments.v1alpha1.CreatePayoutAccountResponse.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
-	30, // 27: ride.payments.v1alpha1.GetPayoutAccountRequest.fields:type_name -> google.protobuf.FieldMask
-	36, // 28: ride.payments.v1alpha1.GetPayoutAccountResponse.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
-	33, // 29: ride.payments.v1alpha1.CreateTransactionsRequest.Entry.transaction:type_name -> ride.payments.v1alpha1.Transaction
-	37, // 30: ride.payments.v1alpha1.ListRechargesRequest.Filter.status:type_name -> ride.payments.v1alpha1.Recharge.Status
-	38, // 31: ride.payments.v1alpha1.ListRechargesRequest.Filter.create_time:type_name -> google.type.Interval
-	39, // 32: ride.payments.v1alpha1.ListPayoutsRequest.Filter.status:type_name -> ride.payments.v1alpha1.Payout.Status
-	38, // 33: ride.payments.v1alpha1.ListPayoutsRequest.Filter.create_time:type_name -> google.type.Interval
-	0,  // 34: ride.payments.v1alpha1.PaymentsService.GetWallet:input_type -> ride.payments.v1alpha1.GetWalletRequest
-	2,  // 35: ride.payments.v1alpha1.PaymentsService.CreateTransfers:input_type -> ride.payments.v1alpha1.CreateTransfersRequest
-	6,  // 36: ride.payments.v1alpha1.PaymentsService.GetTransaction:input_type -> ride.payments.v1alpha1.GetTransactionRequest
-	8,  // 37: ride.payments.v1alpha1.PaymentsService.ListTransactions:input_type -> ride.payments.v1alpha1.ListTransactionsRequest
-	10, // 38: ride.payments.v1alpha1.PaymentsService.CreateRecharge:input_type -> ride.payments.v1alpha1.CreateRechargeRequest
-	12, // 39: ride.payments.v1alpha1.PaymentsService.GetRecharge:input_type -> ride.payments.v1alpha1.GetRechargeRequest
-	14, // 40: ride.payments.v1alpha1.PaymentsService.ListRecharges:input_type -> ride.payments.v1alpha1.ListRechargesRequest
-	16, // 41: ride.payments.v1alpha1.PaymentsService.CreatePayout:input_type -> ride.payments.v1alpha1.CreatePayoutRequest
-	18, // 42: ride.payments.v1alpha1.PaymentsService.GetPayout:input_type -> ride.payments.v1alpha1.GetPayoutRequest
-	20, // 43: ride.payments.v1alpha1.PaymentsService.ListPayouts:input_type -> ride.payments.v1alpha1.ListPayoutsRequest
-	22, // 44: ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount:input_type -> ride.payments.v1alpha1.CreatePayoutAccountRequest
-	24, // 45: ride.payments.v1alpha1.PaymentsService.GetPayoutAccount:input_type -> ride.payments.v1alpha1.GetPayoutAccountRequest
-	1,  // 46: ride.payments.v1alpha1.PaymentsService.GetWallet:output_type -> ride.payments.v1alpha1.GetWalletResponse
-	3,  // 47: ride.payments.v1alpha1.PaymentsService.CreateTransfers:output_type -> ride.payments.v1alpha1.CreateTransfersResponse
-	7,  // 48: ride.payments.v1alpha1.PaymentsService.GetTransaction:output_type -> ride.payments.v1alpha1.GetTransactionResponse
-	9,  // 49: ride.payments.v1alpha1.PaymentsService.ListTransactions:output_type -> ride.payments.v1alpha1.ListTransactionsResponse
-	11, // 50: ride.payments.v1alpha1.PaymentsService.CreateRecharge:output_type -> ride.payments.v1alpha1.CreateRechargeResponse
-	13, // 51: ride.payments.v1alpha1.PaymentsService.GetRecharge:output_type -> ride.payments.v1alpha1.GetRechargeResponse
-	15, // 52: ride.payments.v1alpha1.PaymentsService.ListRecharges:output_type -> ride.payments.v1alpha1.ListRechargesResponse
-	17, // 53: ride.payments.v1alpha1.PaymentsService.CreatePayout:output_type -> ride.payments.v1alpha1.CreatePayoutResponse
-	19, // 54: ride.payments.v1alpha1.PaymentsService.GetPayout:output_type -> ride.payments.v1alpha1.GetPayoutResponse
-	21, // 55: ride.payments.v1alpha1.PaymentsService.ListPayouts:output_type -> ride.payments.v1alpha1.ListPayoutsResponse
-	23, // 56: ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount:output_type -> ride.payments.v1alpha1.CreatePayoutAccountResponse
-	25, // 57: ride.payments.v1alpha1.PaymentsService.GetPayoutAccount:output_type -> ride.payments.v1alpha1.GetPayoutAccountResponse
-	46, // [46:58] is the sub-list for method output_type
-	34, // [34:46] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	74,  // 0: ride.payments.v1alpha1.GetWalletRequest.fields:type_name -> google.protobuf.FieldMask
+	75,  // 1: ride.payments.v1alpha1.GetWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 2: ride.payments.v1alpha1.WatchWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	76,  // 3: ride.payments.v1alpha1.WatchWalletResponse.transaction:type_name -> ride.payments.v1alpha1.Transaction
+	75,  // 4: ride.payments.v1alpha1.CreateWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 5: ride.payments.v1alpha1.FreezeWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 6: ride.payments.v1alpha1.SetBalanceFloorResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 7: ride.payments.v1alpha1.SuspendWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 8: ride.payments.v1alpha1.UnfreezeWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	75,  // 9: ride.payments.v1alpha1.CloseWalletResponse.wallet:type_name -> ride.payments.v1alpha1.Wallet
+	77,  // 10: ride.payments.v1alpha1.VerifyLedgerResponse.discrepancies:type_name -> ride.payments.v1alpha1.LedgerDiscrepancy
+	78,  // 11: ride.payments.v1alpha1.CreateTransfersRequest.transfers:type_name -> ride.payments.v1alpha1.Transfer
+	78,  // 12: ride.payments.v1alpha1.CreateTransfersResponse.transfers:type_name -> ride.payments.v1alpha1.Transfer
+	74,  // 13: ride.payments.v1alpha1.GetTransferRequest.fields:type_name -> google.protobuf.FieldMask
+	78,  // 14: ride.payments.v1alpha1.GetTransferResponse.transfer:type_name -> ride.payments.v1alpha1.Transfer
+	68,  // 15: ride.payments.v1alpha1.ListTransfersRequest.filter:type_name -> ride.payments.v1alpha1.ListTransfersRequest.Filter
+	74,  // 16: ride.payments.v1alpha1.ListTransfersRequest.fields:type_name -> google.protobuf.FieldMask
+	78,  // 17: ride.payments.v1alpha1.ListTransfersResponse.transfers:type_name -> ride.payments.v1alpha1.Transfer
+	69,  // 18: ride.payments.v1alpha1.CreateTransactionsRequest.entries:type_name -> ride.payments.v1alpha1.CreateTransactionsRequest.Entry
+	79,  // 19: ride.payments.v1alpha1.CreateTransactionsRequest.fx:type_name -> ride.payments.v1alpha1.FxConversion
+	76,  // 20: ride.payments.v1alpha1.CreateTransactionsResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
+	80,  // 21: ride.payments.v1alpha1.CreateHoldRequest.hold:type_name -> ride.payments.v1alpha1.Hold
+	80,  // 22: ride.payments.v1alpha1.CreateHoldResponse.hold:type_name -> ride.payments.v1alpha1.Hold
+	74,  // 23: ride.payments.v1alpha1.GetHoldRequest.fields:type_name -> google.protobuf.FieldMask
+	80,  // 24: ride.payments.v1alpha1.GetHoldResponse.hold:type_name -> ride.payments.v1alpha1.Hold
+	70,  // 25: ride.payments.v1alpha1.CaptureHoldRequest.splits:type_name -> ride.payments.v1alpha1.CaptureHoldRequest.Split
+	81,  // 26: ride.payments.v1alpha1.CaptureHoldRequest.details:type_name -> ride.payments.v1alpha1.Transaction.Details
+	80,  // 27: ride.payments.v1alpha1.CaptureHoldResponse.hold:type_name -> ride.payments.v1alpha1.Hold
+	76,  // 28: ride.payments.v1alpha1.CaptureHoldResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
+	80,  // 29: ride.payments.v1alpha1.ReleaseHoldResponse.hold:type_name -> ride.payments.v1alpha1.Hold
+	76,  // 30: ride.payments.v1alpha1.ReverseTransactionResponse.transaction:type_name -> ride.payments.v1alpha1.Transaction
+	76,  // 31: ride.payments.v1alpha1.ReverseBatchResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
+	74,  // 32: ride.payments.v1alpha1.GetTransactionRequest.fields:type_name -> google.protobuf.FieldMask
+	76,  // 33: ride.payments.v1alpha1.GetTransactionResponse.transaction:type_name -> ride.payments.v1alpha1.Transaction
+	74,  // 34: ride.payments.v1alpha1.ListTransactionsRequest.fields:type_name -> google.protobuf.FieldMask
+	76,  // 35: ride.payments.v1alpha1.ListTransactionsResponse.transactions:type_name -> ride.payments.v1alpha1.Transaction
+	82,  // 36: ride.payments.v1alpha1.GetStatementRequest.interval:type_name -> google.type.Interval
+	83,  // 37: ride.payments.v1alpha1.GetStatementResponse.statement:type_name -> ride.payments.v1alpha1.Statement
+	82,  // 38: ride.payments.v1alpha1.ExportStatementRequest.interval:type_name -> google.type.Interval
+	1,   // 39: ride.payments.v1alpha1.ExportStatementRequest.format:type_name -> ride.payments.v1alpha1.ExportStatementRequest.Format
+	84,  // 40: ride.payments.v1alpha1.CreateRechargeRequest.recharge:type_name -> ride.payments.v1alpha1.Recharge
+	84,  // 41: ride.payments.v1alpha1.CreateRechargeResponse.recharge:type_name -> ride.payments.v1alpha1.Recharge
+	71,  // 42: ride.payments.v1alpha1.CreateRechargeResponse.checkout_info:type_name -> ride.payments.v1alpha1.CreateRechargeResponse.CheckoutInfoEntry
+	74,  // 43: ride.payments.v1alpha1.GetRechargeRequest.fields:type_name -> google.protobuf.FieldMask
+	84,  // 44: ride.payments.v1alpha1.GetRechargeResponse.recharge:type_name -> ride.payments.v1alpha1.Recharge
+	72,  // 45: ride.payments.v1alpha1.ListRechargesRequest.filter:type_name -> ride.payments.v1alpha1.ListRechargesRequest.Filter
+	74,  // 46: ride.payments.v1alpha1.ListRechargesRequest.fields:type_name -> google.protobuf.FieldMask
+	84,  // 47: ride.payments.v1alpha1.ListRechargesResponse.recharges:type_name -> ride.payments.v1alpha1.Recharge
+	85,  // 48: ride.payments.v1alpha1.CreatePayoutRequest.payout:type_name -> ride.payments.v1alpha1.Payout
+	85,  // 49: ride.payments.v1alpha1.CreatePayoutResponse.payout:type_name -> ride.payments.v1alpha1.Payout
+	74,  // 50: ride.payments.v1alpha1.GetPayoutRequest.fields:type_name -> google.protobuf.FieldMask
+	85,  // 51: ride.payments.v1alpha1.GetPayoutResponse.payout:type_name -> ride.payments.v1alpha1.Payout
+	73,  // 52: ride.payments.v1alpha1.ListPayoutsRequest.filter:type_name -> ride.payments.v1alpha1.ListPayoutsRequest.Filter
+	74,  // 53: ride.payments.v1alpha1.ListPayoutsRequest.fields:type_name -> google.protobuf.FieldMask
+	85,  // 54: ride.payments.v1alpha1.ListPayoutsResponse.payouts:type_name -> ride.payments.v1alpha1.Payout
+	85,  // 55: ride.payments.v1alpha1.CancelPayoutResponse.payout:type_name -> ride.payments.v1alpha1.Payout
+	86,  // 56: ride.payments.v1alpha1.CreatePayoutAccountRequest.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
+	86,  // 57: ride.payments.v1alpha1.CreatePayoutAccountResponse.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
+	74,  // 58: ride.payments.v1alpha1.GetPayoutAccountRequest.fields:type_name -> google.protobuf.FieldMask
+	86,  // 59: ride.payments.v1alpha1.GetPayoutAccountResponse.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
+	86,  // 60: ride.payments.v1alpha1.UpdatePayoutAccountRequest.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
+	74,  // 61: ride.payments.v1alpha1.UpdatePayoutAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	86,  // 62: ride.payments.v1alpha1.UpdatePayoutAccountResponse.payout_account:type_name -> ride.payments.v1alpha1.PayoutAccount
+	0,   // 63: ride.payments.v1alpha1.ListTransfersRequest.Filter.direction:type_name -> ride.payments.v1alpha1.ListTransfersRequest.Direction
+	76,  // 64: ride.payments.v1alpha1.CreateTransactionsRequest.Entry.transaction:type_name -> ride.payments.v1alpha1.Transaction
+	87,  // 65: ride.payments.v1alpha1.ListRechargesRequest.Filter.status:type_name -> ride.payments.v1alpha1.Recharge.Status
+	82,  // 66: ride.payments.v1alpha1.ListRechargesRequest.Filter.create_time:type_name -> google.type.Interval
+	88,  // 67: ride.payments.v1alpha1.ListPayoutsRequest.Filter.status:type_name -> ride.payments.v1alpha1.Payout.Status
+	82,  // 68: ride.payments.v1alpha1.ListPayoutsRequest.Filter.create_time:type_name -> google.type.Interval
+	2,   // 69: ride.payments.v1alpha1.PaymentsService.GetWallet:input_type -> ride.payments.v1alpha1.GetWalletRequest
+	4,   // 70: ride.payments.v1alpha1.PaymentsService.WatchWallet:input_type -> ride.payments.v1alpha1.WatchWalletRequest
+	6,   // 71: ride.payments.v1alpha1.PaymentsService.CreateWallet:input_type -> ride.payments.v1alpha1.CreateWalletRequest
+	8,   // 72: ride.payments.v1alpha1.PaymentsService.FreezeWallet:input_type -> ride.payments.v1alpha1.FreezeWalletRequest
+	12,  // 73: ride.payments.v1alpha1.PaymentsService.SuspendWallet:input_type -> ride.payments.v1alpha1.SuspendWalletRequest
+	14,  // 74: ride.payments.v1alpha1.PaymentsService.UnfreezeWallet:input_type -> ride.payments.v1alpha1.UnfreezeWalletRequest
+	16,  // 75: ride.payments.v1alpha1.PaymentsService.CloseWallet:input_type -> ride.payments.v1alpha1.CloseWalletRequest
+	10,  // 76: ride.payments.v1alpha1.PaymentsService.SetBalanceFloor:input_type -> ride.payments.v1alpha1.SetBalanceFloorRequest
+	18,  // 77: ride.payments.v1alpha1.PaymentsService.VerifyLedger:input_type -> ride.payments.v1alpha1.VerifyLedgerRequest
+	20,  // 78: ride.payments.v1alpha1.PaymentsService.CreateTransfers:input_type -> ride.payments.v1alpha1.CreateTransfersRequest
+	22,  // 79: ride.payments.v1alpha1.PaymentsService.GetTransfer:input_type -> ride.payments.v1alpha1.GetTransferRequest
+	24,  // 80: ride.payments.v1alpha1.PaymentsService.ListTransfers:input_type -> ride.payments.v1alpha1.ListTransfersRequest
+	26,  // 81: ride.payments.v1alpha1.PaymentsService.CreateTransactions:input_type -> ride.payments.v1alpha1.CreateTransactionsRequest
+	28,  // 82: ride.payments.v1alpha1.PaymentsService.CreateHold:input_type -> ride.payments.v1alpha1.CreateHoldRequest
+	30,  // 83: ride.payments.v1alpha1.PaymentsService.GetHold:input_type -> ride.payments.v1alpha1.GetHoldRequest
+	32,  // 84: ride.payments.v1alpha1.PaymentsService.CaptureHold:input_type -> ride.payments.v1alpha1.CaptureHoldRequest
+	34,  // 85: ride.payments.v1alpha1.PaymentsService.ReleaseHold:input_type -> ride.payments.v1alpha1.ReleaseHoldRequest
+	36,  // 86: ride.payments.v1alpha1.PaymentsService.ReverseTransaction:input_type -> ride.payments.v1alpha1.ReverseTransactionRequest
+	38,  // 87: ride.payments.v1alpha1.PaymentsService.ReverseBatch:input_type -> ride.payments.v1alpha1.ReverseBatchRequest
+	40,  // 88: ride.payments.v1alpha1.PaymentsService.GetTransaction:input_type -> ride.payments.v1alpha1.GetTransactionRequest
+	42,  // 89: ride.payments.v1alpha1.PaymentsService.ListTransactions:input_type -> ride.payments.v1alpha1.ListTransactionsRequest
+	44,  // 90: ride.payments.v1alpha1.PaymentsService.GetStatement:input_type -> ride.payments.v1alpha1.GetStatementRequest
+	46,  // 91: ride.payments.v1alpha1.PaymentsService.ExportStatement:input_type -> ride.payments.v1alpha1.ExportStatementRequest
+	48,  // 92: ride.payments.v1alpha1.PaymentsService.CreateRecharge:input_type -> ride.payments.v1alpha1.CreateRechargeRequest
+	50,  // 93: ride.payments.v1alpha1.PaymentsService.GetRecharge:input_type -> ride.payments.v1alpha1.GetRechargeRequest
+	52,  // 94: ride.payments.v1alpha1.PaymentsService.ListRecharges:input_type -> ride.payments.v1alpha1.ListRechargesRequest
+	54,  // 95: ride.payments.v1alpha1.PaymentsService.CreatePayout:input_type -> ride.payments.v1alpha1.CreatePayoutRequest
+	56,  // 96: ride.payments.v1alpha1.PaymentsService.GetPayout:input_type -> ride.payments.v1alpha1.GetPayoutRequest
+	58,  // 97: ride.payments.v1alpha1.PaymentsService.ListPayouts:input_type -> ride.payments.v1alpha1.ListPayoutsRequest
+	60,  // 98: ride.payments.v1alpha1.PaymentsService.CancelPayout:input_type -> ride.payments.v1alpha1.CancelPayoutRequest
+	62,  // 99: ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount:input_type -> ride.payments.v1alpha1.CreatePayoutAccountRequest
+	64,  // 100: ride.payments.v1alpha1.PaymentsService.GetPayoutAccount:input_type -> ride.payments.v1alpha1.GetPayoutAccountRequest
+	66,  // 101: ride.payments.v1alpha1.PaymentsService.UpdatePayoutAccount:input_type -> ride.payments.v1alpha1.UpdatePayoutAccountRequest
+	3,   // 102: ride.payments.v1alpha1.PaymentsService.GetWallet:output_type -> ride.payments.v1alpha1.GetWalletResponse
+	5,   // 103: ride.payments.v1alpha1.PaymentsService.WatchWallet:output_type -> ride.payments.v1alpha1.WatchWalletResponse
+	7,   // 104: ride.payments.v1alpha1.PaymentsService.CreateWallet:output_type -> ride.payments.v1alpha1.CreateWalletResponse
+	9,   // 105: ride.payments.v1alpha1.PaymentsService.FreezeWallet:output_type -> ride.payments.v1alpha1.FreezeWalletResponse
+	13,  // 106: ride.payments.v1alpha1.PaymentsService.SuspendWallet:output_type -> ride.payments.v1alpha1.SuspendWalletResponse
+	15,  // 107: ride.payments.v1alpha1.PaymentsService.UnfreezeWallet:output_type -> ride.payments.v1alpha1.UnfreezeWalletResponse
+	17,  // 108: ride.payments.v1alpha1.PaymentsService.CloseWallet:output_type -> ride.payments.v1alpha1.CloseWalletResponse
+	11,  // 109: ride.payments.v1alpha1.PaymentsService.SetBalanceFloor:output_type -> ride.payments.v1alpha1.SetBalanceFloorResponse
+	19,  // 110: ride.payments.v1alpha1.PaymentsService.VerifyLedger:output_type -> ride.payments.v1alpha1.VerifyLedgerResponse
+	21,  // 111: ride.payments.v1alpha1.PaymentsService.CreateTransfers:output_type -> ride.payments.v1alpha1.CreateTransfersResponse
+	23,  // 112: ride.payments.v1alpha1.PaymentsService.GetTransfer:output_type -> ride.payments.v1alpha1.GetTransferResponse
+	25,  // 113: ride.payments.v1alpha1.PaymentsService.ListTransfers:output_type -> ride.payments.v1alpha1.ListTransfersResponse
+	27,  // 114: ride.payments.v1alpha1.PaymentsService.CreateTransactions:output_type -> ride.payments.v1alpha1.CreateTransactionsResponse
+	29,  // 115: ride.payments.v1alpha1.PaymentsService.CreateHold:output_type -> ride.payments.v1alpha1.CreateHoldResponse
+	31,  // 116: ride.payments.v1alpha1.PaymentsService.GetHold:output_type -> ride.payments.v1alpha1.GetHoldResponse
+	33,  // 117: ride.payments.v1alpha1.PaymentsService.CaptureHold:output_type -> ride.payments.v1alpha1.CaptureHoldResponse
+	35,  // 118: ride.payments.v1alpha1.PaymentsService.ReleaseHold:output_type -> ride.payments.v1alpha1.ReleaseHoldResponse
+	37,  // 119: ride.payments.v1alpha1.PaymentsService.ReverseTransaction:output_type -> ride.payments.v1alpha1.ReverseTransactionResponse
+	39,  // 120: ride.payments.v1alpha1.PaymentsService.ReverseBatch:output_type -> ride.payments.v1alpha1.ReverseBatchResponse
+	41,  // 121: ride.payments.v1alpha1.PaymentsService.GetTransaction:output_type -> ride.payments.v1alpha1.GetTransactionResponse
+	43,  // 122: ride.payments.v1alpha1.PaymentsService.ListTransactions:output_type -> ride.payments.v1alpha1.ListTransactionsResponse
+	45,  // 123: ride.payments.v1alpha1.PaymentsService.GetStatement:output_type -> ride.payments.v1alpha1.GetStatementResponse
+	47,  // 124: ride.payments.v1alpha1.PaymentsService.ExportStatement:output_type -> ride.payments.v1alpha1.ExportStatementResponse
+	49,  // 125: ride.payments.v1alpha1.PaymentsService.CreateRecharge:output_type -> ride.payments.v1alpha1.CreateRechargeResponse
+	51,  // 126: ride.payments.v1alpha1.PaymentsService.GetRecharge:output_type -> ride.payments.v1alpha1.GetRechargeResponse
+	53,  // 127: ride.payments.v1alpha1.PaymentsService.ListRecharges:output_type -> ride.payments.v1alpha1.ListRechargesResponse
+	55,  // 128: ride.payments.v1alpha1.PaymentsService.CreatePayout:output_type -> ride.payments.v1alpha1.CreatePayoutResponse
+	57,  // 129: ride.payments.v1alpha1.PaymentsService.GetPayout:output_type -> ride.payments.v1alpha1.GetPayoutResponse
+	59,  // 130: ride.payments.v1alpha1.PaymentsService.ListPayouts:output_type -> ride.payments.v1alpha1.ListPayoutsResponse
+	61,  // 131: ride.payments.v1alpha1.PaymentsService.CancelPayout:output_type -> ride.payments.v1alpha1.CancelPayoutResponse
+	63,  // 132: ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount:output_type -> ride.payments.v1alpha1.CreatePayoutAccountResponse
+	65,  // 133: ride.payments.v1alpha1.PaymentsService.GetPayoutAccount:output_type -> ride.payments.v1alpha1.GetPayoutAccountResponse
+	67,  // 134: ride.payments.v1alpha1.PaymentsService.UpdatePayoutAccount:output_type -> ride.payments.v1alpha1.UpdatePayoutAccountResponse
+	102, // [102:135] is the sub-list for method output_type
+	69,  // [69:102] is the sub-list for method input_type
+	69,  // [69:69] is the sub-list for extension type_name
+	69,  // [69:69] is the sub-list for extension extendee
+	0,   // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_ride_payments_v1alpha1_service_proto_init() }
@@ -2289,6 +5734,9 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 	file_ride_payments_v1alpha1_transaction_proto_init()
 	file_ride_payments_v1alpha1_recharge_proto_init()
 	file_ride_payments_v1alpha1_payout_proto_init()
+	file_ride_payments_v1alpha1_hold_proto_init()
+	file_ride_payments_v1alpha1_ledger_proto_init()
+	file_ride_payments_v1alpha1_statement_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ride_payments_v1alpha1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletRequest); i {
@@ -2302,8 +5750,260 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletResponse); i {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreezeWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceFloorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceFloorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspendWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuspendWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseWalletResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransfersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2314,8 +6014,8 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTransfersRequest); i {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2326,8 +6026,8 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTransfersResponse); i {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2338,8 +6038,8 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTransactionsRequest); i {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2350,8 +6050,8 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTransactionsResponse); i {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHoldRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2362,7 +6062,139 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTransactionRequest); i {
 			case 0:
 				return &v.state
@@ -2374,7 +6206,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTransactionResponse); i {
 			case 0:
 				return &v.state
@@ -2386,7 +6218,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListTransactionsRequest); i {
 			case 0:
 				return &v.state
@@ -2398,7 +6230,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListTransactionsResponse); i {
 			case 0:
 				return &v.state
@@ -2410,7 +6242,55 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRechargeRequest); i {
 			case 0:
 				return &v.state
@@ -2422,7 +6302,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRechargeResponse); i {
 			case 0:
 				return &v.state
@@ -2434,7 +6314,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRechargeRequest); i {
 			case 0:
 				return &v.state
@@ -2446,7 +6326,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRechargeResponse); i {
 			case 0:
 				return &v.state
@@ -2458,7 +6338,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRechargesRequest); i {
 			case 0:
 				return &v.state
@@ -2470,7 +6350,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRechargesResponse); i {
 			case 0:
 				return &v.state
@@ -2482,7 +6362,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePayoutRequest); i {
 			case 0:
 				return &v.state
@@ -2494,7 +6374,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePayoutResponse); i {
 			case 0:
 				return &v.state
@@ -2506,7 +6386,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPayoutRequest); i {
 			case 0:
 				return &v.state
@@ -2518,7 +6398,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPayoutResponse); i {
 			case 0:
 				return &v.state
@@ -2530,7 +6410,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPayoutsRequest); i {
 			case 0:
 				return &v.state
@@ -2542,7 +6422,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPayoutsResponse); i {
 			case 0:
 				return &v.state
@@ -2554,7 +6434,31 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPayoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPayoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePayoutAccountRequest); i {
 			case 0:
 				return &v.state
@@ -2566,7 +6470,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePayoutAccountResponse); i {
 			case 0:
 				return &v.state
@@ -2578,7 +6482,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPayoutAccountRequest); i {
 			case 0:
 				return &v.state
@@ -2590,7 +6494,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPayoutAccountResponse); i {
 			case 0:
 				return &v.state
@@ -2602,7 +6506,43 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePayoutAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePayoutAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransfersRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateTransactionsRequest_Entry); i {
 			case 0:
 				return &v.state
@@ -2614,7 +6554,19 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureHoldRequest_Split); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListRechargesRequest_Filter); i {
 			case 0:
 				return &v.state
@@ -2626,7 +6578,7 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_ride_payments_v1alpha1_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_ride_payments_v1alpha1_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPayoutsRequest_Filter); i {
 			case 0:
 				return &v.state
@@ -2640,27 +6592,45 @@ func file_ride_payments_v1alpha1_service_proto_init() {
 		}
 	}
 	file_ride_payments_v1alpha1_service_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*WatchWalletResponse_Wallet)(nil),
+		(*WatchWalletResponse_Transaction)(nil),
+	}
 	file_ride_payments_v1alpha1_service_proto_msgTypes[8].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[14].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	file_ride_payments_v1alpha1_service_proto_msgTypes[20].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[22].OneofWrappers = []interface{}{}
 	file_ride_payments_v1alpha1_service_proto_msgTypes[28].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_service_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[42].OneofWrappers = []interface{}{
+		(*GetStatementRequest_Month)(nil),
+		(*GetStatementRequest_Interval)(nil),
+	}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*ExportStatementRequest_Month)(nil),
+		(*ExportStatementRequest_Interval)(nil),
+	}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_service_proto_msgTypes[71].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ride_payments_v1alpha1_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   30,
+			NumEnums:      2,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ride_payments_v1alpha1_service_proto_goTypes,
 		DependencyIndexes: file_ride_payments_v1alpha1_service_proto_depIdxs,
+		EnumInfos:         file_ride_payments_v1alpha1_service_proto_enumTypes,
 		MessageInfos:      file_ride_payments_v1alpha1_service_proto_msgTypes,
 	}.Build()
 	File_ride_payments_v1alpha1_service_proto = out.File
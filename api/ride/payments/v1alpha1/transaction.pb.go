@@ -81,7 +81,7 @@ type Transaction struct {
 	// Relative resource name of the Transaction, for example, "users/user1/wallet/transactions/transaction1"
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The transaction amount in the smallest currency unit. **Must** be greater than 0. For example, $1.75 must be represented as 175.
-	Amount int32 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// The transaction type, **must** be either debit or credit
 	Type Transaction_Type `protobuf:"varint,3,opt,name=type,proto3,enum=ride.payments.v1alpha1.Transaction_Type" json:"type,omitempty"`
 	// Output only. The timestamp when the transaction was created
@@ -89,6 +89,20 @@ type Transaction struct {
 	// The transaction batch id if the transaction was created with BatchCreate.
 	BatchId *string              `protobuf:"bytes,5,opt,name=batch_id,json=batchId,proto3,oneof" json:"batch_id,omitempty"`
 	Details *Transaction_Details `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	// The currency code of the transaction amount. Defaults to "INR" if not set.
+	CurrencyCode string `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Output only. Relative resource name of the Transaction reversed by this Transaction, if it is a reversal.
+	ReversalOf *string `protobuf:"bytes,8,opt,name=reversal_of,json=reversalOf,proto3,oneof" json:"reversal_of,omitempty"`
+	// Output only. The part of the amount reversed by later reversal Transactions.
+	ReversedAmount int64 `protobuf:"varint,9,opt,name=reversed_amount,json=reversedAmount,proto3" json:"reversed_amount,omitempty"`
+	// Output only. The currency conversion of the batch, if its Transactions use two currencies.
+	Fx *FxConversion `protobuf:"bytes,10,opt,name=fx,proto3" json:"fx,omitempty"`
+	// Output only. The balance of the Wallet in the currency of the Transaction before it was applied, in smallest currency unit.
+	// Not set on Transactions written before running balances were recorded.
+	BalanceBefore *int64 `protobuf:"varint,11,opt,name=balance_before,json=balanceBefore,proto3,oneof" json:"balance_before,omitempty"`
+	// Output only. The balance of the Wallet in the currency of the Transaction after it was applied, in smallest currency unit.
+	// Not set on Transactions written before running balances were recorded.
+	BalanceAfter *int64 `protobuf:"varint,12,opt,name=balance_after,json=balanceAfter,proto3,oneof" json:"balance_after,omitempty"`
 }
 
 func (x *Transaction) Reset() {
@@ -130,7 +144,7 @@ func (x *Transaction) GetName() string {
 	return ""
 }
 
-func (x *Transaction) GetAmount() int32 {
+func (x *Transaction) GetAmount() int64 {
 	if x != nil {
 		return x.Amount
 	}
@@ -165,6 +179,125 @@ func (x *Transaction) GetDetails() *Transaction_Details {
 	return nil
 }
 
+func (x *Transaction) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Transaction) GetReversalOf() string {
+	if x != nil && x.ReversalOf != nil {
+		return *x.ReversalOf
+	}
+	return ""
+}
+
+func (x *Transaction) GetReversedAmount() int64 {
+	if x != nil {
+		return x.ReversedAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetFx() *FxConversion {
+	if x != nil {
+		return x.Fx
+	}
+	return nil
+}
+
+func (x *Transaction) GetBalanceBefore() int64 {
+	if x != nil && x.BalanceBefore != nil {
+		return *x.BalanceBefore
+	}
+	return 0
+}
+
+func (x *Transaction) GetBalanceAfter() int64 {
+	if x != nil && x.BalanceAfter != nil {
+		return *x.BalanceAfter
+	}
+	return 0
+}
+
+// An FxConversion is the explicit FX leg of a batch of Transactions in two currencies. The source amount is exchanged
+// for the target amount, for example by an FX desk Wallet that is credited in the source and debited in the target currency.
+type FxConversion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The currency exchanged from. For example, "INR".
+	SourceCurrencyCode string `protobuf:"bytes,1,opt,name=source_currency_code,json=sourceCurrencyCode,proto3" json:"source_currency_code,omitempty"`
+	// The amount exchanged from in smallest currency unit.
+	SourceAmount int64 `protobuf:"varint,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// The currency exchanged to. For example, "USD".
+	TargetCurrencyCode string `protobuf:"bytes,3,opt,name=target_currency_code,json=targetCurrencyCode,proto3" json:"target_currency_code,omitempty"`
+	// The amount exchanged to in smallest currency unit.
+	TargetAmount int64 `protobuf:"varint,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+}
+
+func (x *FxConversion) Reset() {
+	*x = FxConversion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FxConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FxConversion) ProtoMessage() {}
+
+func (x *FxConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FxConversion.ProtoReflect.Descriptor instead.
+func (*FxConversion) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FxConversion) GetSourceCurrencyCode() string {
+	if x != nil {
+		return x.SourceCurrencyCode
+	}
+	return ""
+}
+
+func (x *FxConversion) GetSourceAmount() int64 {
+	if x != nil {
+		return x.SourceAmount
+	}
+	return 0
+}
+
+func (x *FxConversion) GetTargetCurrencyCode() string {
+	if x != nil {
+		return x.TargetCurrencyCode
+	}
+	return ""
+}
+
+func (x *FxConversion) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
 type Transaction_Details struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -181,7 +314,7 @@ type Transaction_Details struct {
 func (x *Transaction_Details) Reset() {
 	*x = Transaction_Details{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[1]
+		mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -194,7 +327,7 @@ func (x *Transaction_Details) String() string {
 func (*Transaction_Details) ProtoMessage() {}
 
 func (x *Transaction_Details) ProtoReflect() protoreflect.Message {
-	mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[1]
+	mi := &file_ride_payments_v1alpha1_transaction_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,14 +375,14 @@ var file_ride_payments_v1alpha1_transaction_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x82, 0x05, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x92, 0x08, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x57, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43,
 	0xba, 0x48, 0x40, 0xd0, 0x01, 0x01, 0x72, 0x3b, 0x32, 0x39, 0x5e, 0x75, 0x73, 0x65, 0x72, 0x73,
 	0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x2f,
 	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
 	0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
 	0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
 	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
@@ -267,26 +400,67 @@ var file_ride_payments_v1alpha1_transaction_proto_rawDesc = []byte{
 	0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x1a, 0xa3, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x2c, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x20,
-	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xd0, 0x01, 0x01, 0x72, 0x03, 0x28, 0x80, 0x01, 0x48,
-	0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x26, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x28, 0x80, 0x01, 0x52, 0x09,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x44, 0x45, 0x42, 0x49, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x69, 0x64, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0xd0,
+	0x01, 0x01, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x69,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x6f, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x43, 0xba, 0x48, 0x40, 0xd0, 0x01, 0x01, 0x72, 0x3b, 0x32, 0x39, 0x5e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x6c, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x66, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x78, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x66, 0x78, 0x12, 0x2a, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0c, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x1a, 0xa3,
+	0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x0b, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0xd0, 0x01, 0x01, 0x72, 0x03, 0x28, 0x80, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x28, 0x80, 0x01, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49,
+	0x54, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x6f, 0x66,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x46, 0x78, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x0e, 0x72, 0x0c, 0x32, 0x0a, 0x5e, 0x5b, 0x41,
+	0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x14, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x0e, 0x72, 0x0c, 0x32, 0x0a,
+	0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0c,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x41, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d,
+	0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -302,22 +476,24 @@ func file_ride_payments_v1alpha1_transaction_proto_rawDescGZIP() []byte {
 }
 
 var file_ride_payments_v1alpha1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ride_payments_v1alpha1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ride_payments_v1alpha1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ride_payments_v1alpha1_transaction_proto_goTypes = []interface{}{
 	(Transaction_Type)(0),         // 0: ride.payments.v1alpha1.Transaction.Type
 	(*Transaction)(nil),           // 1: ride.payments.v1alpha1.Transaction
-	(*Transaction_Details)(nil),   // 2: ride.payments.v1alpha1.Transaction.Details
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*FxConversion)(nil),          // 2: ride.payments.v1alpha1.FxConversion
+	(*Transaction_Details)(nil),   // 3: ride.payments.v1alpha1.Transaction.Details
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_ride_payments_v1alpha1_transaction_proto_depIdxs = []int32{
 	0, // 0: ride.payments.v1alpha1.Transaction.type:type_name -> ride.payments.v1alpha1.Transaction.Type
-	3, // 1: ride.payments.v1alpha1.Transaction.create_time:type_name -> google.protobuf.Timestamp
-	2, // 2: ride.payments.v1alpha1.Transaction.details:type_name -> ride.payments.v1alpha1.Transaction.Details
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 1: ride.payments.v1alpha1.Transaction.create_time:type_name -> google.protobuf.Timestamp
+	3, // 2: ride.payments.v1alpha1.Transaction.details:type_name -> ride.payments.v1alpha1.Transaction.Details
+	2, // 3: ride.payments.v1alpha1.Transaction.fx:type_name -> ride.payments.v1alpha1.FxConversion
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_ride_payments_v1alpha1_transaction_proto_init() }
@@ -339,6 +515,18 @@ func file_ride_payments_v1alpha1_transaction_proto_init() {
 			}
 		}
 		file_ride_payments_v1alpha1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FxConversion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ride_payments_v1alpha1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction_Details); i {
 			case 0:
 				return &v.state
@@ -352,14 +540,14 @@ func file_ride_payments_v1alpha1_transaction_proto_init() {
 		}
 	}
 	file_ride_payments_v1alpha1_transaction_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_ride_payments_v1alpha1_transaction_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_ride_payments_v1alpha1_transaction_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ride_payments_v1alpha1_transaction_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -37,15 +37,71 @@ const (
 	// PaymentsServiceGetWalletProcedure is the fully-qualified name of the PaymentsService's GetWallet
 	// RPC.
 	PaymentsServiceGetWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/GetWallet"
+	// PaymentsServiceWatchWalletProcedure is the fully-qualified name of the PaymentsService's
+	// WatchWallet RPC.
+	PaymentsServiceWatchWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/WatchWallet"
+	// PaymentsServiceCreateWalletProcedure is the fully-qualified name of the PaymentsService's
+	// CreateWallet RPC.
+	PaymentsServiceCreateWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/CreateWallet"
+	// PaymentsServiceFreezeWalletProcedure is the fully-qualified name of the PaymentsService's
+	// FreezeWallet RPC.
+	PaymentsServiceFreezeWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/FreezeWallet"
+	// PaymentsServiceSuspendWalletProcedure is the fully-qualified name of the PaymentsService's
+	// SuspendWallet RPC.
+	PaymentsServiceSuspendWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/SuspendWallet"
+	// PaymentsServiceUnfreezeWalletProcedure is the fully-qualified name of the PaymentsService's
+	// UnfreezeWallet RPC.
+	PaymentsServiceUnfreezeWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/UnfreezeWallet"
+	// PaymentsServiceCloseWalletProcedure is the fully-qualified name of the PaymentsService's
+	// CloseWallet RPC.
+	PaymentsServiceCloseWalletProcedure = "/ride.payments.v1alpha1.PaymentsService/CloseWallet"
+	// PaymentsServiceSetBalanceFloorProcedure is the fully-qualified name of the PaymentsService's
+	// SetBalanceFloor RPC.
+	PaymentsServiceSetBalanceFloorProcedure = "/ride.payments.v1alpha1.PaymentsService/SetBalanceFloor"
+	// PaymentsServiceVerifyLedgerProcedure is the fully-qualified name of the PaymentsService's
+	// VerifyLedger RPC.
+	PaymentsServiceVerifyLedgerProcedure = "/ride.payments.v1alpha1.PaymentsService/VerifyLedger"
 	// PaymentsServiceCreateTransfersProcedure is the fully-qualified name of the PaymentsService's
 	// CreateTransfers RPC.
 	PaymentsServiceCreateTransfersProcedure = "/ride.payments.v1alpha1.PaymentsService/CreateTransfers"
+	// PaymentsServiceGetTransferProcedure is the fully-qualified name of the PaymentsService's
+	// GetTransfer RPC.
+	PaymentsServiceGetTransferProcedure = "/ride.payments.v1alpha1.PaymentsService/GetTransfer"
+	// PaymentsServiceListTransfersProcedure is the fully-qualified name of the PaymentsService's
+	// ListTransfers RPC.
+	PaymentsServiceListTransfersProcedure = "/ride.payments.v1alpha1.PaymentsService/ListTransfers"
+	// PaymentsServiceCreateTransactionsProcedure is the fully-qualified name of the PaymentsService's
+	// CreateTransactions RPC.
+	PaymentsServiceCreateTransactionsProcedure = "/ride.payments.v1alpha1.PaymentsService/CreateTransactions"
+	// PaymentsServiceCreateHoldProcedure is the fully-qualified name of the PaymentsService's
+	// CreateHold RPC.
+	PaymentsServiceCreateHoldProcedure = "/ride.payments.v1alpha1.PaymentsService/CreateHold"
+	// PaymentsServiceGetHoldProcedure is the fully-qualified name of the PaymentsService's GetHold RPC.
+	PaymentsServiceGetHoldProcedure = "/ride.payments.v1alpha1.PaymentsService/GetHold"
+	// PaymentsServiceCaptureHoldProcedure is the fully-qualified name of the PaymentsService's
+	// CaptureHold RPC.
+	PaymentsServiceCaptureHoldProcedure = "/ride.payments.v1alpha1.PaymentsService/CaptureHold"
+	// PaymentsServiceReleaseHoldProcedure is the fully-qualified name of the PaymentsService's
+	// ReleaseHold RPC.
+	PaymentsServiceReleaseHoldProcedure = "/ride.payments.v1alpha1.PaymentsService/ReleaseHold"
+	// PaymentsServiceReverseTransactionProcedure is the fully-qualified name of the PaymentsService's
+	// ReverseTransaction RPC.
+	PaymentsServiceReverseTransactionProcedure = "/ride.payments.v1alpha1.PaymentsService/ReverseTransaction"
+	// PaymentsServiceReverseBatchProcedure is the fully-qualified name of the PaymentsService's
+	// ReverseBatch RPC.
+	PaymentsServiceReverseBatchProcedure = "/ride.payments.v1alpha1.PaymentsService/ReverseBatch"
 	// PaymentsServiceGetTransactionProcedure is the fully-qualified name of the PaymentsService's
 	// GetTransaction RPC.
 	PaymentsServiceGetTransactionProcedure = "/ride.payments.v1alpha1.PaymentsService/GetTransaction"
 	// PaymentsServiceListTransactionsProcedure is the fully-qualified name of the PaymentsService's
 	// ListTransactions RPC.
 	PaymentsServiceListTransactionsProcedure = "/ride.payments.v1alpha1.PaymentsService/ListTransactions"
+	// PaymentsServiceGetStatementProcedure is the fully-qualified name of the PaymentsService's
+	// GetStatement RPC.
+	PaymentsServiceGetStatementProcedure = "/ride.payments.v1alpha1.PaymentsService/GetStatement"
+	// PaymentsServiceExportStatementProcedure is the fully-qualified name of the PaymentsService's
+	// ExportStatement RPC.
+	PaymentsServiceExportStatementProcedure = "/ride.payments.v1alpha1.PaymentsService/ExportStatement"
 	// PaymentsServiceCreateRechargeProcedure is the fully-qualified name of the PaymentsService's
 	// CreateRecharge RPC.
 	PaymentsServiceCreateRechargeProcedure = "/ride.payments.v1alpha1.PaymentsService/CreateRecharge"
@@ -64,46 +120,120 @@ const (
 	// PaymentsServiceListPayoutsProcedure is the fully-qualified name of the PaymentsService's
 	// ListPayouts RPC.
 	PaymentsServiceListPayoutsProcedure = "/ride.payments.v1alpha1.PaymentsService/ListPayouts"
+	// PaymentsServiceCancelPayoutProcedure is the fully-qualified name of the PaymentsService's
+	// CancelPayout RPC.
+	PaymentsServiceCancelPayoutProcedure = "/ride.payments.v1alpha1.PaymentsService/CancelPayout"
 	// PaymentsServiceCreatePayoutAccountProcedure is the fully-qualified name of the PaymentsService's
 	// CreatePayoutAccount RPC.
 	PaymentsServiceCreatePayoutAccountProcedure = "/ride.payments.v1alpha1.PaymentsService/CreatePayoutAccount"
 	// PaymentsServiceGetPayoutAccountProcedure is the fully-qualified name of the PaymentsService's
 	// GetPayoutAccount RPC.
 	PaymentsServiceGetPayoutAccountProcedure = "/ride.payments.v1alpha1.PaymentsService/GetPayoutAccount"
+	// PaymentsServiceUpdatePayoutAccountProcedure is the fully-qualified name of the PaymentsService's
+	// UpdatePayoutAccount RPC.
+	PaymentsServiceUpdatePayoutAccountProcedure = "/ride.payments.v1alpha1.PaymentsService/UpdatePayoutAccount"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
 	paymentsServiceServiceDescriptor                   = v1alpha1.File_ride_payments_v1alpha1_service_proto.Services().ByName("PaymentsService")
 	paymentsServiceGetWalletMethodDescriptor           = paymentsServiceServiceDescriptor.Methods().ByName("GetWallet")
+	paymentsServiceWatchWalletMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("WatchWallet")
+	paymentsServiceCreateWalletMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("CreateWallet")
+	paymentsServiceFreezeWalletMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("FreezeWallet")
+	paymentsServiceSuspendWalletMethodDescriptor       = paymentsServiceServiceDescriptor.Methods().ByName("SuspendWallet")
+	paymentsServiceUnfreezeWalletMethodDescriptor      = paymentsServiceServiceDescriptor.Methods().ByName("UnfreezeWallet")
+	paymentsServiceCloseWalletMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("CloseWallet")
+	paymentsServiceSetBalanceFloorMethodDescriptor     = paymentsServiceServiceDescriptor.Methods().ByName("SetBalanceFloor")
+	paymentsServiceVerifyLedgerMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("VerifyLedger")
 	paymentsServiceCreateTransfersMethodDescriptor     = paymentsServiceServiceDescriptor.Methods().ByName("CreateTransfers")
+	paymentsServiceGetTransferMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("GetTransfer")
+	paymentsServiceListTransfersMethodDescriptor       = paymentsServiceServiceDescriptor.Methods().ByName("ListTransfers")
+	paymentsServiceCreateTransactionsMethodDescriptor  = paymentsServiceServiceDescriptor.Methods().ByName("CreateTransactions")
+	paymentsServiceCreateHoldMethodDescriptor          = paymentsServiceServiceDescriptor.Methods().ByName("CreateHold")
+	paymentsServiceGetHoldMethodDescriptor             = paymentsServiceServiceDescriptor.Methods().ByName("GetHold")
+	paymentsServiceCaptureHoldMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("CaptureHold")
+	paymentsServiceReleaseHoldMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("ReleaseHold")
+	paymentsServiceReverseTransactionMethodDescriptor  = paymentsServiceServiceDescriptor.Methods().ByName("ReverseTransaction")
+	paymentsServiceReverseBatchMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("ReverseBatch")
 	paymentsServiceGetTransactionMethodDescriptor      = paymentsServiceServiceDescriptor.Methods().ByName("GetTransaction")
 	paymentsServiceListTransactionsMethodDescriptor    = paymentsServiceServiceDescriptor.Methods().ByName("ListTransactions")
+	paymentsServiceGetStatementMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("GetStatement")
+	paymentsServiceExportStatementMethodDescriptor     = paymentsServiceServiceDescriptor.Methods().ByName("ExportStatement")
 	paymentsServiceCreateRechargeMethodDescriptor      = paymentsServiceServiceDescriptor.Methods().ByName("CreateRecharge")
 	paymentsServiceGetRechargeMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("GetRecharge")
 	paymentsServiceListRechargesMethodDescriptor       = paymentsServiceServiceDescriptor.Methods().ByName("ListRecharges")
 	paymentsServiceCreatePayoutMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("CreatePayout")
 	paymentsServiceGetPayoutMethodDescriptor           = paymentsServiceServiceDescriptor.Methods().ByName("GetPayout")
 	paymentsServiceListPayoutsMethodDescriptor         = paymentsServiceServiceDescriptor.Methods().ByName("ListPayouts")
+	paymentsServiceCancelPayoutMethodDescriptor        = paymentsServiceServiceDescriptor.Methods().ByName("CancelPayout")
 	paymentsServiceCreatePayoutAccountMethodDescriptor = paymentsServiceServiceDescriptor.Methods().ByName("CreatePayoutAccount")
 	paymentsServiceGetPayoutAccountMethodDescriptor    = paymentsServiceServiceDescriptor.Methods().ByName("GetPayoutAccount")
+	paymentsServiceUpdatePayoutAccountMethodDescriptor = paymentsServiceServiceDescriptor.Methods().ByName("UpdatePayoutAccount")
 )
 
 // PaymentsServiceClient is a client for the ride.payments.v1alpha1.PaymentsService service.
 type PaymentsServiceClient interface {
 	GetWallet(context.Context, *connect.Request[v1alpha1.GetWalletRequest]) (*connect.Response[v1alpha1.GetWalletResponse], error)
+	// Stream the current Wallet, then the Wallet every time it changes and every Transaction committed on it.
+	// Clients that reconnect pass the last resume token received to get the Transactions they missed.
+	WatchWallet(context.Context, *connect.Request[v1alpha1.WatchWalletRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchWalletResponse], error)
+	// Create the Wallet of a user. Creating a Wallet that exists already returns the existing Wallet.
+	CreateWallet(context.Context, *connect.Request[v1alpha1.CreateWalletRequest]) (*connect.Response[v1alpha1.CreateWalletResponse], error)
+	// Block debits from an active Wallet. Only available to trusted internal callers.
+	FreezeWallet(context.Context, *connect.Request[v1alpha1.FreezeWalletRequest]) (*connect.Response[v1alpha1.FreezeWalletResponse], error)
+	// Block all money movement in an active or frozen Wallet. Only available to trusted internal callers.
+	SuspendWallet(context.Context, *connect.Request[v1alpha1.SuspendWalletRequest]) (*connect.Response[v1alpha1.SuspendWalletResponse], error)
+	// Return a frozen Wallet to the active state. Suspended Wallets stay suspended. Only available to trusted internal callers.
+	UnfreezeWallet(context.Context, *connect.Request[v1alpha1.UnfreezeWalletRequest]) (*connect.Response[v1alpha1.UnfreezeWalletResponse], error)
+	// Close a Wallet with a zero balance and no active Holds for good. Only available to trusted internal callers.
+	CloseWallet(context.Context, *connect.Request[v1alpha1.CloseWalletRequest]) (*connect.Response[v1alpha1.CloseWalletResponse], error)
+	// Set the balance floor of a Wallet, for example to give a driver who collects cash fares a credit limit.
+	// Only available to trusted internal callers.
+	SetBalanceFloor(context.Context, *connect.Request[v1alpha1.SetBalanceFloorRequest]) (*connect.Response[v1alpha1.SetBalanceFloorResponse], error)
+	// Recompute the balances of a page of Wallets from their Transactions and report, and optionally repair, mismatches.
+	// Only available to trusted internal callers.
+	VerifyLedger(context.Context, *connect.Request[v1alpha1.VerifyLedgerRequest]) (*connect.Response[v1alpha1.VerifyLedgerResponse], error)
 	// Create multiple transactions at once in a single atomic reuqest.
 	CreateTransfers(context.Context, *connect.Request[v1alpha1.CreateTransfersRequest]) (*connect.Response[v1alpha1.CreateTransfersResponse], error)
+	GetTransfer(context.Context, *connect.Request[v1alpha1.GetTransferRequest]) (*connect.Response[v1alpha1.GetTransferResponse], error)
+	// List the transfers sent from and received by a wallet, newest first.
+	ListTransfers(context.Context, *connect.Request[v1alpha1.ListTransfersRequest]) (*connect.Response[v1alpha1.ListTransfersResponse], error)
+	// Create balanced double-entry transactions across wallets in a single atomic request.
+	// Only available to trusted internal callers.
+	CreateTransactions(context.Context, *connect.Request[v1alpha1.CreateTransactionsRequest]) (*connect.Response[v1alpha1.CreateTransactionsResponse], error)
+	// Reserve funds in a wallet. Only available to trusted internal callers.
+	CreateHold(context.Context, *connect.Request[v1alpha1.CreateHoldRequest]) (*connect.Response[v1alpha1.CreateHoldResponse], error)
+	GetHold(context.Context, *connect.Request[v1alpha1.GetHoldRequest]) (*connect.Response[v1alpha1.GetHoldResponse], error)
+	// Move all or part of the held funds to one or more wallets and release the rest.
+	// Only available to trusted internal callers.
+	CaptureHold(context.Context, *connect.Request[v1alpha1.CaptureHoldRequest]) (*connect.Response[v1alpha1.CaptureHoldResponse], error)
+	// Release the held funds back to the available balance. Only available to trusted internal callers.
+	ReleaseHold(context.Context, *connect.Request[v1alpha1.ReleaseHoldRequest]) (*connect.Response[v1alpha1.ReleaseHoldResponse], error)
+	// Post a mirror-image Transaction for all or part of a Transaction that has not been reversed yet.
+	// Only available to trusted internal callers.
+	ReverseTransaction(context.Context, *connect.Request[v1alpha1.ReverseTransactionRequest]) (*connect.Response[v1alpha1.ReverseTransactionResponse], error)
+	// Reverse the unreversed amount of every Transaction in a batch in a single atomic request.
+	// Only available to trusted internal callers.
+	ReverseBatch(context.Context, *connect.Request[v1alpha1.ReverseBatchRequest]) (*connect.Response[v1alpha1.ReverseBatchResponse], error)
 	GetTransaction(context.Context, *connect.Request[v1alpha1.GetTransactionRequest]) (*connect.Response[v1alpha1.GetTransactionResponse], error)
 	ListTransactions(context.Context, *connect.Request[v1alpha1.ListTransactionsRequest]) (*connect.Response[v1alpha1.ListTransactionsResponse], error)
+	// Get the opening and closing balance, totals and Transactions of a Wallet over a month or an interval.
+	GetStatement(context.Context, *connect.Request[v1alpha1.GetStatementRequest]) (*connect.Response[v1alpha1.GetStatementResponse], error)
+	// Render the Statement of a Wallet as a CSV or PDF file, streamed in chunks.
+	ExportStatement(context.Context, *connect.Request[v1alpha1.ExportStatementRequest]) (*connect.ServerStreamForClient[v1alpha1.ExportStatementResponse], error)
 	CreateRecharge(context.Context, *connect.Request[v1alpha1.CreateRechargeRequest]) (*connect.Response[v1alpha1.CreateRechargeResponse], error)
 	GetRecharge(context.Context, *connect.Request[v1alpha1.GetRechargeRequest]) (*connect.Response[v1alpha1.GetRechargeResponse], error)
 	ListRecharges(context.Context, *connect.Request[v1alpha1.ListRechargesRequest]) (*connect.Response[v1alpha1.ListRechargesResponse], error)
 	CreatePayout(context.Context, *connect.Request[v1alpha1.CreatePayoutRequest]) (*connect.Response[v1alpha1.CreatePayoutResponse], error)
 	GetPayout(context.Context, *connect.Request[v1alpha1.GetPayoutRequest]) (*connect.Response[v1alpha1.GetPayoutResponse], error)
 	ListPayouts(context.Context, *connect.Request[v1alpha1.ListPayoutsRequest]) (*connect.Response[v1alpha1.ListPayoutsResponse], error)
+	// Cancel a payout that is still pending and credit its amount back to the wallet.
+	CancelPayout(context.Context, *connect.Request[v1alpha1.CancelPayoutRequest]) (*connect.Response[v1alpha1.CancelPayoutResponse], error)
 	CreatePayoutAccount(context.Context, *connect.Request[v1alpha1.CreatePayoutAccountRequest]) (*connect.Response[v1alpha1.CreatePayoutAccountResponse], error)
 	GetPayoutAccount(context.Context, *connect.Request[v1alpha1.GetPayoutAccountRequest]) (*connect.Response[v1alpha1.GetPayoutAccountResponse], error)
+	// Change the destination of a PayoutAccount. Payouts to the new destination are blocked for a cooling period.
+	UpdatePayoutAccount(context.Context, *connect.Request[v1alpha1.UpdatePayoutAccountRequest]) (*connect.Response[v1alpha1.UpdatePayoutAccountResponse], error)
 }
 
 // NewPaymentsServiceClient constructs a client for the ride.payments.v1alpha1.PaymentsService
@@ -122,12 +252,114 @@ func NewPaymentsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(paymentsServiceGetWalletMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		watchWallet: connect.NewClient[v1alpha1.WatchWalletRequest, v1alpha1.WatchWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceWatchWalletProcedure,
+			connect.WithSchema(paymentsServiceWatchWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWallet: connect.NewClient[v1alpha1.CreateWalletRequest, v1alpha1.CreateWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceCreateWalletProcedure,
+			connect.WithSchema(paymentsServiceCreateWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		freezeWallet: connect.NewClient[v1alpha1.FreezeWalletRequest, v1alpha1.FreezeWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceFreezeWalletProcedure,
+			connect.WithSchema(paymentsServiceFreezeWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		suspendWallet: connect.NewClient[v1alpha1.SuspendWalletRequest, v1alpha1.SuspendWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceSuspendWalletProcedure,
+			connect.WithSchema(paymentsServiceSuspendWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unfreezeWallet: connect.NewClient[v1alpha1.UnfreezeWalletRequest, v1alpha1.UnfreezeWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceUnfreezeWalletProcedure,
+			connect.WithSchema(paymentsServiceUnfreezeWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		closeWallet: connect.NewClient[v1alpha1.CloseWalletRequest, v1alpha1.CloseWalletResponse](
+			httpClient,
+			baseURL+PaymentsServiceCloseWalletProcedure,
+			connect.WithSchema(paymentsServiceCloseWalletMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setBalanceFloor: connect.NewClient[v1alpha1.SetBalanceFloorRequest, v1alpha1.SetBalanceFloorResponse](
+			httpClient,
+			baseURL+PaymentsServiceSetBalanceFloorProcedure,
+			connect.WithSchema(paymentsServiceSetBalanceFloorMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyLedger: connect.NewClient[v1alpha1.VerifyLedgerRequest, v1alpha1.VerifyLedgerResponse](
+			httpClient,
+			baseURL+PaymentsServiceVerifyLedgerProcedure,
+			connect.WithSchema(paymentsServiceVerifyLedgerMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createTransfers: connect.NewClient[v1alpha1.CreateTransfersRequest, v1alpha1.CreateTransfersResponse](
 			httpClient,
 			baseURL+PaymentsServiceCreateTransfersProcedure,
 			connect.WithSchema(paymentsServiceCreateTransfersMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getTransfer: connect.NewClient[v1alpha1.GetTransferRequest, v1alpha1.GetTransferResponse](
+			httpClient,
+			baseURL+PaymentsServiceGetTransferProcedure,
+			connect.WithSchema(paymentsServiceGetTransferMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTransfers: connect.NewClient[v1alpha1.ListTransfersRequest, v1alpha1.ListTransfersResponse](
+			httpClient,
+			baseURL+PaymentsServiceListTransfersProcedure,
+			connect.WithSchema(paymentsServiceListTransfersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createTransactions: connect.NewClient[v1alpha1.CreateTransactionsRequest, v1alpha1.CreateTransactionsResponse](
+			httpClient,
+			baseURL+PaymentsServiceCreateTransactionsProcedure,
+			connect.WithSchema(paymentsServiceCreateTransactionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createHold: connect.NewClient[v1alpha1.CreateHoldRequest, v1alpha1.CreateHoldResponse](
+			httpClient,
+			baseURL+PaymentsServiceCreateHoldProcedure,
+			connect.WithSchema(paymentsServiceCreateHoldMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getHold: connect.NewClient[v1alpha1.GetHoldRequest, v1alpha1.GetHoldResponse](
+			httpClient,
+			baseURL+PaymentsServiceGetHoldProcedure,
+			connect.WithSchema(paymentsServiceGetHoldMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		captureHold: connect.NewClient[v1alpha1.CaptureHoldRequest, v1alpha1.CaptureHoldResponse](
+			httpClient,
+			baseURL+PaymentsServiceCaptureHoldProcedure,
+			connect.WithSchema(paymentsServiceCaptureHoldMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		releaseHold: connect.NewClient[v1alpha1.ReleaseHoldRequest, v1alpha1.ReleaseHoldResponse](
+			httpClient,
+			baseURL+PaymentsServiceReleaseHoldProcedure,
+			connect.WithSchema(paymentsServiceReleaseHoldMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reverseTransaction: connect.NewClient[v1alpha1.ReverseTransactionRequest, v1alpha1.ReverseTransactionResponse](
+			httpClient,
+			baseURL+PaymentsServiceReverseTransactionProcedure,
+			connect.WithSchema(paymentsServiceReverseTransactionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reverseBatch: connect.NewClient[v1alpha1.ReverseBatchRequest, v1alpha1.ReverseBatchResponse](
+			httpClient,
+			baseURL+PaymentsServiceReverseBatchProcedure,
+			connect.WithSchema(paymentsServiceReverseBatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getTransaction: connect.NewClient[v1alpha1.GetTransactionRequest, v1alpha1.GetTransactionResponse](
 			httpClient,
 			baseURL+PaymentsServiceGetTransactionProcedure,
@@ -140,6 +372,18 @@ func NewPaymentsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(paymentsServiceListTransactionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getStatement: connect.NewClient[v1alpha1.GetStatementRequest, v1alpha1.GetStatementResponse](
+			httpClient,
+			baseURL+PaymentsServiceGetStatementProcedure,
+			connect.WithSchema(paymentsServiceGetStatementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportStatement: connect.NewClient[v1alpha1.ExportStatementRequest, v1alpha1.ExportStatementResponse](
+			httpClient,
+			baseURL+PaymentsServiceExportStatementProcedure,
+			connect.WithSchema(paymentsServiceExportStatementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createRecharge: connect.NewClient[v1alpha1.CreateRechargeRequest, v1alpha1.CreateRechargeResponse](
 			httpClient,
 			baseURL+PaymentsServiceCreateRechargeProcedure,
@@ -176,6 +420,12 @@ func NewPaymentsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(paymentsServiceListPayoutsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		cancelPayout: connect.NewClient[v1alpha1.CancelPayoutRequest, v1alpha1.CancelPayoutResponse](
+			httpClient,
+			baseURL+PaymentsServiceCancelPayoutProcedure,
+			connect.WithSchema(paymentsServiceCancelPayoutMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createPayoutAccount: connect.NewClient[v1alpha1.CreatePayoutAccountRequest, v1alpha1.CreatePayoutAccountResponse](
 			httpClient,
 			baseURL+PaymentsServiceCreatePayoutAccountProcedure,
@@ -188,23 +438,50 @@ func NewPaymentsServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(paymentsServiceGetPayoutAccountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		updatePayoutAccount: connect.NewClient[v1alpha1.UpdatePayoutAccountRequest, v1alpha1.UpdatePayoutAccountResponse](
+			httpClient,
+			baseURL+PaymentsServiceUpdatePayoutAccountProcedure,
+			connect.WithSchema(paymentsServiceUpdatePayoutAccountMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // paymentsServiceClient implements PaymentsServiceClient.
 type paymentsServiceClient struct {
 	getWallet           *connect.Client[v1alpha1.GetWalletRequest, v1alpha1.GetWalletResponse]
+	watchWallet         *connect.Client[v1alpha1.WatchWalletRequest, v1alpha1.WatchWalletResponse]
+	createWallet        *connect.Client[v1alpha1.CreateWalletRequest, v1alpha1.CreateWalletResponse]
+	freezeWallet        *connect.Client[v1alpha1.FreezeWalletRequest, v1alpha1.FreezeWalletResponse]
+	suspendWallet       *connect.Client[v1alpha1.SuspendWalletRequest, v1alpha1.SuspendWalletResponse]
+	unfreezeWallet      *connect.Client[v1alpha1.UnfreezeWalletRequest, v1alpha1.UnfreezeWalletResponse]
+	closeWallet         *connect.Client[v1alpha1.CloseWalletRequest, v1alpha1.CloseWalletResponse]
+	setBalanceFloor     *connect.Client[v1alpha1.SetBalanceFloorRequest, v1alpha1.SetBalanceFloorResponse]
+	verifyLedger        *connect.Client[v1alpha1.VerifyLedgerRequest, v1alpha1.VerifyLedgerResponse]
 	createTransfers     *connect.Client[v1alpha1.CreateTransfersRequest, v1alpha1.CreateTransfersResponse]
+	getTransfer         *connect.Client[v1alpha1.GetTransferRequest, v1alpha1.GetTransferResponse]
+	listTransfers       *connect.Client[v1alpha1.ListTransfersRequest, v1alpha1.ListTransfersResponse]
+	createTransactions  *connect.Client[v1alpha1.CreateTransactionsRequest, v1alpha1.CreateTransactionsResponse]
+	createHold          *connect.Client[v1alpha1.CreateHoldRequest, v1alpha1.CreateHoldResponse]
+	getHold             *connect.Client[v1alpha1.GetHoldRequest, v1alpha1.GetHoldResponse]
+	captureHold         *connect.Client[v1alpha1.CaptureHoldRequest, v1alpha1.CaptureHoldResponse]
+	releaseHold         *connect.Client[v1alpha1.ReleaseHoldRequest, v1alpha1.ReleaseHoldResponse]
+	reverseTransaction  *connect.Client[v1alpha1.ReverseTransactionRequest, v1alpha1.ReverseTransactionResponse]
+	reverseBatch        *connect.Client[v1alpha1.ReverseBatchRequest, v1alpha1.ReverseBatchResponse]
 	getTransaction      *connect.Client[v1alpha1.GetTransactionRequest, v1alpha1.GetTransactionResponse]
 	listTransactions    *connect.Client[v1alpha1.ListTransactionsRequest, v1alpha1.ListTransactionsResponse]
+	getStatement        *connect.Client[v1alpha1.GetStatementRequest, v1alpha1.GetStatementResponse]
+	exportStatement     *connect.Client[v1alpha1.ExportStatementRequest, v1alpha1.ExportStatementResponse]
 	createRecharge      *connect.Client[v1alpha1.CreateRechargeRequest, v1alpha1.CreateRechargeResponse]
 	getRecharge         *connect.Client[v1alpha1.GetRechargeRequest, v1alpha1.GetRechargeResponse]
 	listRecharges       *connect.Client[v1alpha1.ListRechargesRequest, v1alpha1.ListRechargesResponse]
 	createPayout        *connect.Client[v1alpha1.CreatePayoutRequest, v1alpha1.CreatePayoutResponse]
 	getPayout           *connect.Client[v1alpha1.GetPayoutRequest, v1alpha1.GetPayoutResponse]
 	listPayouts         *connect.Client[v1alpha1.ListPayoutsRequest, v1alpha1.ListPayoutsResponse]
+	cancelPayout        *connect.Client[v1alpha1.CancelPayoutRequest, v1alpha1.CancelPayoutResponse]
 	createPayoutAccount *connect.Client[v1alpha1.CreatePayoutAccountRequest, v1alpha1.CreatePayoutAccountResponse]
 	getPayoutAccount    *connect.Client[v1alpha1.GetPayoutAccountRequest, v1alpha1.GetPayoutAccountResponse]
+	updatePayoutAccount *connect.Client[v1alpha1.UpdatePayoutAccountRequest, v1alpha1.UpdatePayoutAccountResponse]
 }
 
 // GetWallet calls ride.payments.v1alpha1.PaymentsService.GetWallet.
@@ -212,11 +489,96 @@ func (c *paymentsServiceClient) GetWallet(ctx context.Context, req *connect.Requ
 	return c.getWallet.CallUnary(ctx, req)
 }
 
+// WatchWallet calls ride.payments.v1alpha1.PaymentsService.WatchWallet.
+func (c *paymentsServiceClient) WatchWallet(ctx context.Context, req *connect.Request[v1alpha1.WatchWalletRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchWalletResponse], error) {
+	return c.watchWallet.CallServerStream(ctx, req)
+}
+
+// CreateWallet calls ride.payments.v1alpha1.PaymentsService.CreateWallet.
+func (c *paymentsServiceClient) CreateWallet(ctx context.Context, req *connect.Request[v1alpha1.CreateWalletRequest]) (*connect.Response[v1alpha1.CreateWalletResponse], error) {
+	return c.createWallet.CallUnary(ctx, req)
+}
+
+// FreezeWallet calls ride.payments.v1alpha1.PaymentsService.FreezeWallet.
+func (c *paymentsServiceClient) FreezeWallet(ctx context.Context, req *connect.Request[v1alpha1.FreezeWalletRequest]) (*connect.Response[v1alpha1.FreezeWalletResponse], error) {
+	return c.freezeWallet.CallUnary(ctx, req)
+}
+
+// SuspendWallet calls ride.payments.v1alpha1.PaymentsService.SuspendWallet.
+func (c *paymentsServiceClient) SuspendWallet(ctx context.Context, req *connect.Request[v1alpha1.SuspendWalletRequest]) (*connect.Response[v1alpha1.SuspendWalletResponse], error) {
+	return c.suspendWallet.CallUnary(ctx, req)
+}
+
+// UnfreezeWallet calls ride.payments.v1alpha1.PaymentsService.UnfreezeWallet.
+func (c *paymentsServiceClient) UnfreezeWallet(ctx context.Context, req *connect.Request[v1alpha1.UnfreezeWalletRequest]) (*connect.Response[v1alpha1.UnfreezeWalletResponse], error) {
+	return c.unfreezeWallet.CallUnary(ctx, req)
+}
+
+// CloseWallet calls ride.payments.v1alpha1.PaymentsService.CloseWallet.
+func (c *paymentsServiceClient) CloseWallet(ctx context.Context, req *connect.Request[v1alpha1.CloseWalletRequest]) (*connect.Response[v1alpha1.CloseWalletResponse], error) {
+	return c.closeWallet.CallUnary(ctx, req)
+}
+
+// SetBalanceFloor calls ride.payments.v1alpha1.PaymentsService.SetBalanceFloor.
+func (c *paymentsServiceClient) SetBalanceFloor(ctx context.Context, req *connect.Request[v1alpha1.SetBalanceFloorRequest]) (*connect.Response[v1alpha1.SetBalanceFloorResponse], error) {
+	return c.setBalanceFloor.CallUnary(ctx, req)
+}
+
+// VerifyLedger calls ride.payments.v1alpha1.PaymentsService.VerifyLedger.
+func (c *paymentsServiceClient) VerifyLedger(ctx context.Context, req *connect.Request[v1alpha1.VerifyLedgerRequest]) (*connect.Response[v1alpha1.VerifyLedgerResponse], error) {
+	return c.verifyLedger.CallUnary(ctx, req)
+}
+
 // CreateTransfers calls ride.payments.v1alpha1.PaymentsService.CreateTransfers.
 func (c *paymentsServiceClient) CreateTransfers(ctx context.Context, req *connect.Request[v1alpha1.CreateTransfersRequest]) (*connect.Response[v1alpha1.CreateTransfersResponse], error) {
 	return c.createTransfers.CallUnary(ctx, req)
 }
 
+// GetTransfer calls ride.payments.v1alpha1.PaymentsService.GetTransfer.
+func (c *paymentsServiceClient) GetTransfer(ctx context.Context, req *connect.Request[v1alpha1.GetTransferRequest]) (*connect.Response[v1alpha1.GetTransferResponse], error) {
+	return c.getTransfer.CallUnary(ctx, req)
+}
+
+// ListTransfers calls ride.payments.v1alpha1.PaymentsService.ListTransfers.
+func (c *paymentsServiceClient) ListTransfers(ctx context.Context, req *connect.Request[v1alpha1.ListTransfersRequest]) (*connect.Response[v1alpha1.ListTransfersResponse], error) {
+	return c.listTransfers.CallUnary(ctx, req)
+}
+
+// CreateTransactions calls ride.payments.v1alpha1.PaymentsService.CreateTransactions.
+func (c *paymentsServiceClient) CreateTransactions(ctx context.Context, req *connect.Request[v1alpha1.CreateTransactionsRequest]) (*connect.Response[v1alpha1.CreateTransactionsResponse], error) {
+	return c.createTransactions.CallUnary(ctx, req)
+}
+
+// CreateHold calls ride.payments.v1alpha1.PaymentsService.CreateHold.
+func (c *paymentsServiceClient) CreateHold(ctx context.Context, req *connect.Request[v1alpha1.CreateHoldRequest]) (*connect.Response[v1alpha1.CreateHoldResponse], error) {
+	return c.createHold.CallUnary(ctx, req)
+}
+
+// GetHold calls ride.payments.v1alpha1.PaymentsService.GetHold.
+func (c *paymentsServiceClient) GetHold(ctx context.Context, req *connect.Request[v1alpha1.GetHoldRequest]) (*connect.Response[v1alpha1.GetHoldResponse], error) {
+	return c.getHold.CallUnary(ctx, req)
+}
+
+// CaptureHold calls ride.payments.v1alpha1.PaymentsService.CaptureHold.
+func (c *paymentsServiceClient) CaptureHold(ctx context.Context, req *connect.Request[v1alpha1.CaptureHoldRequest]) (*connect.Response[v1alpha1.CaptureHoldResponse], error) {
+	return c.captureHold.CallUnary(ctx, req)
+}
+
+// ReleaseHold calls ride.payments.v1alpha1.PaymentsService.ReleaseHold.
+func (c *paymentsServiceClient) ReleaseHold(ctx context.Context, req *connect.Request[v1alpha1.ReleaseHoldRequest]) (*connect.Response[v1alpha1.ReleaseHoldResponse], error) {
+	return c.releaseHold.CallUnary(ctx, req)
+}
+
+// ReverseTransaction calls ride.payments.v1alpha1.PaymentsService.ReverseTransaction.
+func (c *paymentsServiceClient) ReverseTransaction(ctx context.Context, req *connect.Request[v1alpha1.ReverseTransactionRequest]) (*connect.Response[v1alpha1.ReverseTransactionResponse], error) {
+	return c.reverseTransaction.CallUnary(ctx, req)
+}
+
+// ReverseBatch calls ride.payments.v1alpha1.PaymentsService.ReverseBatch.
+func (c *paymentsServiceClient) ReverseBatch(ctx context.Context, req *connect.Request[v1alpha1.ReverseBatchRequest]) (*connect.Response[v1alpha1.ReverseBatchResponse], error) {
+	return c.reverseBatch.CallUnary(ctx, req)
+}
+
 // GetTransaction calls ride.payments.v1alpha1.PaymentsService.GetTransaction.
 func (c *paymentsServiceClient) GetTransaction(ctx context.Context, req *connect.Request[v1alpha1.GetTransactionRequest]) (*connect.Response[v1alpha1.GetTransactionResponse], error) {
 	return c.getTransaction.CallUnary(ctx, req)
@@ -227,6 +589,16 @@ func (c *paymentsServiceClient) ListTransactions(ctx context.Context, req *conne
 	return c.listTransactions.CallUnary(ctx, req)
 }
 
+// GetStatement calls ride.payments.v1alpha1.PaymentsService.GetStatement.
+func (c *paymentsServiceClient) GetStatement(ctx context.Context, req *connect.Request[v1alpha1.GetStatementRequest]) (*connect.Response[v1alpha1.GetStatementResponse], error) {
+	return c.getStatement.CallUnary(ctx, req)
+}
+
+// ExportStatement calls ride.payments.v1alpha1.PaymentsService.ExportStatement.
+func (c *paymentsServiceClient) ExportStatement(ctx context.Context, req *connect.Request[v1alpha1.ExportStatementRequest]) (*connect.ServerStreamForClient[v1alpha1.ExportStatementResponse], error) {
+	return c.exportStatement.CallServerStream(ctx, req)
+}
+
 // CreateRecharge calls ride.payments.v1alpha1.PaymentsService.CreateRecharge.
 func (c *paymentsServiceClient) CreateRecharge(ctx context.Context, req *connect.Request[v1alpha1.CreateRechargeRequest]) (*connect.Response[v1alpha1.CreateRechargeResponse], error) {
 	return c.createRecharge.CallUnary(ctx, req)
@@ -257,6 +629,11 @@ func (c *paymentsServiceClient) ListPayouts(ctx context.Context, req *connect.Re
 	return c.listPayouts.CallUnary(ctx, req)
 }
 
+// CancelPayout calls ride.payments.v1alpha1.PaymentsService.CancelPayout.
+func (c *paymentsServiceClient) CancelPayout(ctx context.Context, req *connect.Request[v1alpha1.CancelPayoutRequest]) (*connect.Response[v1alpha1.CancelPayoutResponse], error) {
+	return c.cancelPayout.CallUnary(ctx, req)
+}
+
 // CreatePayoutAccount calls ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount.
 func (c *paymentsServiceClient) CreatePayoutAccount(ctx context.Context, req *connect.Request[v1alpha1.CreatePayoutAccountRequest]) (*connect.Response[v1alpha1.CreatePayoutAccountResponse], error) {
 	return c.createPayoutAccount.CallUnary(ctx, req)
@@ -267,22 +644,74 @@ func (c *paymentsServiceClient) GetPayoutAccount(ctx context.Context, req *conne
 	return c.getPayoutAccount.CallUnary(ctx, req)
 }
 
+// UpdatePayoutAccount calls ride.payments.v1alpha1.PaymentsService.UpdatePayoutAccount.
+func (c *paymentsServiceClient) UpdatePayoutAccount(ctx context.Context, req *connect.Request[v1alpha1.UpdatePayoutAccountRequest]) (*connect.Response[v1alpha1.UpdatePayoutAccountResponse], error) {
+	return c.updatePayoutAccount.CallUnary(ctx, req)
+}
+
 // PaymentsServiceHandler is an implementation of the ride.payments.v1alpha1.PaymentsService
 // service.
 type PaymentsServiceHandler interface {
 	GetWallet(context.Context, *connect.Request[v1alpha1.GetWalletRequest]) (*connect.Response[v1alpha1.GetWalletResponse], error)
+	// Stream the current Wallet, then the Wallet every time it changes and every Transaction committed on it.
+	// Clients that reconnect pass the last resume token received to get the Transactions they missed.
+	WatchWallet(context.Context, *connect.Request[v1alpha1.WatchWalletRequest], *connect.ServerStream[v1alpha1.WatchWalletResponse]) error
+	// Create the Wallet of a user. Creating a Wallet that exists already returns the existing Wallet.
+	CreateWallet(context.Context, *connect.Request[v1alpha1.CreateWalletRequest]) (*connect.Response[v1alpha1.CreateWalletResponse], error)
+	// Block debits from an active Wallet. Only available to trusted internal callers.
+	FreezeWallet(context.Context, *connect.Request[v1alpha1.FreezeWalletRequest]) (*connect.Response[v1alpha1.FreezeWalletResponse], error)
+	// Block all money movement in an active or frozen Wallet. Only available to trusted internal callers.
+	SuspendWallet(context.Context, *connect.Request[v1alpha1.SuspendWalletRequest]) (*connect.Response[v1alpha1.SuspendWalletResponse], error)
+	// Return a frozen Wallet to the active state. Suspended Wallets stay suspended. Only available to trusted internal callers.
+	UnfreezeWallet(context.Context, *connect.Request[v1alpha1.UnfreezeWalletRequest]) (*connect.Response[v1alpha1.UnfreezeWalletResponse], error)
+	// Close a Wallet with a zero balance and no active Holds for good. Only available to trusted internal callers.
+	CloseWallet(context.Context, *connect.Request[v1alpha1.CloseWalletRequest]) (*connect.Response[v1alpha1.CloseWalletResponse], error)
+	// Set the balance floor of a Wallet, for example to give a driver who collects cash fares a credit limit.
+	// Only available to trusted internal callers.
+	SetBalanceFloor(context.Context, *connect.Request[v1alpha1.SetBalanceFloorRequest]) (*connect.Response[v1alpha1.SetBalanceFloorResponse], error)
+	// Recompute the balances of a page of Wallets from their Transactions and report, and optionally repair, mismatches.
+	// Only available to trusted internal callers.
+	VerifyLedger(context.Context, *connect.Request[v1alpha1.VerifyLedgerRequest]) (*connect.Response[v1alpha1.VerifyLedgerResponse], error)
 	// Create multiple transactions at once in a single atomic reuqest.
 	CreateTransfers(context.Context, *connect.Request[v1alpha1.CreateTransfersRequest]) (*connect.Response[v1alpha1.CreateTransfersResponse], error)
+	GetTransfer(context.Context, *connect.Request[v1alpha1.GetTransferRequest]) (*connect.Response[v1alpha1.GetTransferResponse], error)
+	// List the transfers sent from and received by a wallet, newest first.
+	ListTransfers(context.Context, *connect.Request[v1alpha1.ListTransfersRequest]) (*connect.Response[v1alpha1.ListTransfersResponse], error)
+	// Create balanced double-entry transactions across wallets in a single atomic request.
+	// Only available to trusted internal callers.
+	CreateTransactions(context.Context, *connect.Request[v1alpha1.CreateTransactionsRequest]) (*connect.Response[v1alpha1.CreateTransactionsResponse], error)
+	// Reserve funds in a wallet. Only available to trusted internal callers.
+	CreateHold(context.Context, *connect.Request[v1alpha1.CreateHoldRequest]) (*connect.Response[v1alpha1.CreateHoldResponse], error)
+	GetHold(context.Context, *connect.Request[v1alpha1.GetHoldRequest]) (*connect.Response[v1alpha1.GetHoldResponse], error)
+	// Move all or part of the held funds to one or more wallets and release the rest.
+	// Only available to trusted internal callers.
+	CaptureHold(context.Context, *connect.Request[v1alpha1.CaptureHoldRequest]) (*connect.Response[v1alpha1.CaptureHoldResponse], error)
+	// Release the held funds back to the available balance. Only available to trusted internal callers.
+	ReleaseHold(context.Context, *connect.Request[v1alpha1.ReleaseHoldRequest]) (*connect.Response[v1alpha1.ReleaseHoldResponse], error)
+	// Post a mirror-image Transaction for all or part of a Transaction that has not been reversed yet.
+	// Only available to trusted internal callers.
+	ReverseTransaction(context.Context, *connect.Request[v1alpha1.ReverseTransactionRequest]) (*connect.Response[v1alpha1.ReverseTransactionResponse], error)
+	// Reverse the unreversed amount of every Transaction in a batch in a single atomic request.
+	// Only available to trusted internal callers.
+	ReverseBatch(context.Context, *connect.Request[v1alpha1.ReverseBatchRequest]) (*connect.Response[v1alpha1.ReverseBatchResponse], error)
 	GetTransaction(context.Context, *connect.Request[v1alpha1.GetTransactionRequest]) (*connect.Response[v1alpha1.GetTransactionResponse], error)
 	ListTransactions(context.Context, *connect.Request[v1alpha1.ListTransactionsRequest]) (*connect.Response[v1alpha1.ListTransactionsResponse], error)
+	// Get the opening and closing balance, totals and Transactions of a Wallet over a month or an interval.
+	GetStatement(context.Context, *connect.Request[v1alpha1.GetStatementRequest]) (*connect.Response[v1alpha1.GetStatementResponse], error)
+	// Render the Statement of a Wallet as a CSV or PDF file, streamed in chunks.
+	ExportStatement(context.Context, *connect.Request[v1alpha1.ExportStatementRequest], *connect.ServerStream[v1alpha1.ExportStatementResponse]) error
 	CreateRecharge(context.Context, *connect.Request[v1alpha1.CreateRechargeRequest]) (*connect.Response[v1alpha1.CreateRechargeResponse], error)
 	GetRecharge(context.Context, *connect.Request[v1alpha1.GetRechargeRequest]) (*connect.Response[v1alpha1.GetRechargeResponse], error)
 	ListRecharges(context.Context, *connect.Request[v1alpha1.ListRechargesRequest]) (*connect.Response[v1alpha1.ListRechargesResponse], error)
 	CreatePayout(context.Context, *connect.Request[v1alpha1.CreatePayoutRequest]) (*connect.Response[v1alpha1.CreatePayoutResponse], error)
 	GetPayout(context.Context, *connect.Request[v1alpha1.GetPayoutRequest]) (*connect.Response[v1alpha1.GetPayoutResponse], error)
 	ListPayouts(context.Context, *connect.Request[v1alpha1.ListPayoutsRequest]) (*connect.Response[v1alpha1.ListPayoutsResponse], error)
+	// Cancel a payout that is still pending and credit its amount back to the wallet.
+	CancelPayout(context.Context, *connect.Request[v1alpha1.CancelPayoutRequest]) (*connect.Response[v1alpha1.CancelPayoutResponse], error)
 	CreatePayoutAccount(context.Context, *connect.Request[v1alpha1.CreatePayoutAccountRequest]) (*connect.Response[v1alpha1.CreatePayoutAccountResponse], error)
 	GetPayoutAccount(context.Context, *connect.Request[v1alpha1.GetPayoutAccountRequest]) (*connect.Response[v1alpha1.GetPayoutAccountResponse], error)
+	// Change the destination of a PayoutAccount. Payouts to the new destination are blocked for a cooling period.
+	UpdatePayoutAccount(context.Context, *connect.Request[v1alpha1.UpdatePayoutAccountRequest]) (*connect.Response[v1alpha1.UpdatePayoutAccountResponse], error)
 }
 
 // NewPaymentsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -297,12 +726,114 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(paymentsServiceGetWalletMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceWatchWalletHandler := connect.NewServerStreamHandler(
+		PaymentsServiceWatchWalletProcedure,
+		svc.WatchWallet,
+		connect.WithSchema(paymentsServiceWatchWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceCreateWalletHandler := connect.NewUnaryHandler(
+		PaymentsServiceCreateWalletProcedure,
+		svc.CreateWallet,
+		connect.WithSchema(paymentsServiceCreateWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceFreezeWalletHandler := connect.NewUnaryHandler(
+		PaymentsServiceFreezeWalletProcedure,
+		svc.FreezeWallet,
+		connect.WithSchema(paymentsServiceFreezeWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceSuspendWalletHandler := connect.NewUnaryHandler(
+		PaymentsServiceSuspendWalletProcedure,
+		svc.SuspendWallet,
+		connect.WithSchema(paymentsServiceSuspendWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceUnfreezeWalletHandler := connect.NewUnaryHandler(
+		PaymentsServiceUnfreezeWalletProcedure,
+		svc.UnfreezeWallet,
+		connect.WithSchema(paymentsServiceUnfreezeWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceCloseWalletHandler := connect.NewUnaryHandler(
+		PaymentsServiceCloseWalletProcedure,
+		svc.CloseWallet,
+		connect.WithSchema(paymentsServiceCloseWalletMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceSetBalanceFloorHandler := connect.NewUnaryHandler(
+		PaymentsServiceSetBalanceFloorProcedure,
+		svc.SetBalanceFloor,
+		connect.WithSchema(paymentsServiceSetBalanceFloorMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceVerifyLedgerHandler := connect.NewUnaryHandler(
+		PaymentsServiceVerifyLedgerProcedure,
+		svc.VerifyLedger,
+		connect.WithSchema(paymentsServiceVerifyLedgerMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	paymentsServiceCreateTransfersHandler := connect.NewUnaryHandler(
 		PaymentsServiceCreateTransfersProcedure,
 		svc.CreateTransfers,
 		connect.WithSchema(paymentsServiceCreateTransfersMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceGetTransferHandler := connect.NewUnaryHandler(
+		PaymentsServiceGetTransferProcedure,
+		svc.GetTransfer,
+		connect.WithSchema(paymentsServiceGetTransferMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceListTransfersHandler := connect.NewUnaryHandler(
+		PaymentsServiceListTransfersProcedure,
+		svc.ListTransfers,
+		connect.WithSchema(paymentsServiceListTransfersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceCreateTransactionsHandler := connect.NewUnaryHandler(
+		PaymentsServiceCreateTransactionsProcedure,
+		svc.CreateTransactions,
+		connect.WithSchema(paymentsServiceCreateTransactionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceCreateHoldHandler := connect.NewUnaryHandler(
+		PaymentsServiceCreateHoldProcedure,
+		svc.CreateHold,
+		connect.WithSchema(paymentsServiceCreateHoldMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceGetHoldHandler := connect.NewUnaryHandler(
+		PaymentsServiceGetHoldProcedure,
+		svc.GetHold,
+		connect.WithSchema(paymentsServiceGetHoldMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceCaptureHoldHandler := connect.NewUnaryHandler(
+		PaymentsServiceCaptureHoldProcedure,
+		svc.CaptureHold,
+		connect.WithSchema(paymentsServiceCaptureHoldMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceReleaseHoldHandler := connect.NewUnaryHandler(
+		PaymentsServiceReleaseHoldProcedure,
+		svc.ReleaseHold,
+		connect.WithSchema(paymentsServiceReleaseHoldMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceReverseTransactionHandler := connect.NewUnaryHandler(
+		PaymentsServiceReverseTransactionProcedure,
+		svc.ReverseTransaction,
+		connect.WithSchema(paymentsServiceReverseTransactionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceReverseBatchHandler := connect.NewUnaryHandler(
+		PaymentsServiceReverseBatchProcedure,
+		svc.ReverseBatch,
+		connect.WithSchema(paymentsServiceReverseBatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	paymentsServiceGetTransactionHandler := connect.NewUnaryHandler(
 		PaymentsServiceGetTransactionProcedure,
 		svc.GetTransaction,
@@ -315,6 +846,18 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(paymentsServiceListTransactionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceGetStatementHandler := connect.NewUnaryHandler(
+		PaymentsServiceGetStatementProcedure,
+		svc.GetStatement,
+		connect.WithSchema(paymentsServiceGetStatementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	paymentsServiceExportStatementHandler := connect.NewServerStreamHandler(
+		PaymentsServiceExportStatementProcedure,
+		svc.ExportStatement,
+		connect.WithSchema(paymentsServiceExportStatementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	paymentsServiceCreateRechargeHandler := connect.NewUnaryHandler(
 		PaymentsServiceCreateRechargeProcedure,
 		svc.CreateRecharge,
@@ -351,6 +894,12 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(paymentsServiceListPayoutsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceCancelPayoutHandler := connect.NewUnaryHandler(
+		PaymentsServiceCancelPayoutProcedure,
+		svc.CancelPayout,
+		connect.WithSchema(paymentsServiceCancelPayoutMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	paymentsServiceCreatePayoutAccountHandler := connect.NewUnaryHandler(
 		PaymentsServiceCreatePayoutAccountProcedure,
 		svc.CreatePayoutAccount,
@@ -363,16 +912,60 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 		connect.WithSchema(paymentsServiceGetPayoutAccountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	paymentsServiceUpdatePayoutAccountHandler := connect.NewUnaryHandler(
+		PaymentsServiceUpdatePayoutAccountProcedure,
+		svc.UpdatePayoutAccount,
+		connect.WithSchema(paymentsServiceUpdatePayoutAccountMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ride.payments.v1alpha1.PaymentsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PaymentsServiceGetWalletProcedure:
 			paymentsServiceGetWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceWatchWalletProcedure:
+			paymentsServiceWatchWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceCreateWalletProcedure:
+			paymentsServiceCreateWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceFreezeWalletProcedure:
+			paymentsServiceFreezeWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceSuspendWalletProcedure:
+			paymentsServiceSuspendWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceUnfreezeWalletProcedure:
+			paymentsServiceUnfreezeWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceCloseWalletProcedure:
+			paymentsServiceCloseWalletHandler.ServeHTTP(w, r)
+		case PaymentsServiceSetBalanceFloorProcedure:
+			paymentsServiceSetBalanceFloorHandler.ServeHTTP(w, r)
+		case PaymentsServiceVerifyLedgerProcedure:
+			paymentsServiceVerifyLedgerHandler.ServeHTTP(w, r)
 		case PaymentsServiceCreateTransfersProcedure:
 			paymentsServiceCreateTransfersHandler.ServeHTTP(w, r)
+		case PaymentsServiceGetTransferProcedure:
+			paymentsServiceGetTransferHandler.ServeHTTP(w, r)
+		case PaymentsServiceListTransfersProcedure:
+			paymentsServiceListTransfersHandler.ServeHTTP(w, r)
+		case PaymentsServiceCreateTransactionsProcedure:
+			paymentsServiceCreateTransactionsHandler.ServeHTTP(w, r)
+		case PaymentsServiceCreateHoldProcedure:
+			paymentsServiceCreateHoldHandler.ServeHTTP(w, r)
+		case PaymentsServiceGetHoldProcedure:
+			paymentsServiceGetHoldHandler.ServeHTTP(w, r)
+		case PaymentsServiceCaptureHoldProcedure:
+			paymentsServiceCaptureHoldHandler.ServeHTTP(w, r)
+		case PaymentsServiceReleaseHoldProcedure:
+			paymentsServiceReleaseHoldHandler.ServeHTTP(w, r)
+		case PaymentsServiceReverseTransactionProcedure:
+			paymentsServiceReverseTransactionHandler.ServeHTTP(w, r)
+		case PaymentsServiceReverseBatchProcedure:
+			paymentsServiceReverseBatchHandler.ServeHTTP(w, r)
 		case PaymentsServiceGetTransactionProcedure:
 			paymentsServiceGetTransactionHandler.ServeHTTP(w, r)
 		case PaymentsServiceListTransactionsProcedure:
 			paymentsServiceListTransactionsHandler.ServeHTTP(w, r)
+		case PaymentsServiceGetStatementProcedure:
+			paymentsServiceGetStatementHandler.ServeHTTP(w, r)
+		case PaymentsServiceExportStatementProcedure:
+			paymentsServiceExportStatementHandler.ServeHTTP(w, r)
 		case PaymentsServiceCreateRechargeProcedure:
 			paymentsServiceCreateRechargeHandler.ServeHTTP(w, r)
 		case PaymentsServiceGetRechargeProcedure:
@@ -385,10 +978,14 @@ func NewPaymentsServiceHandler(svc PaymentsServiceHandler, opts ...connect.Handl
 			paymentsServiceGetPayoutHandler.ServeHTTP(w, r)
 		case PaymentsServiceListPayoutsProcedure:
 			paymentsServiceListPayoutsHandler.ServeHTTP(w, r)
+		case PaymentsServiceCancelPayoutProcedure:
+			paymentsServiceCancelPayoutHandler.ServeHTTP(w, r)
 		case PaymentsServiceCreatePayoutAccountProcedure:
 			paymentsServiceCreatePayoutAccountHandler.ServeHTTP(w, r)
 		case PaymentsServiceGetPayoutAccountProcedure:
 			paymentsServiceGetPayoutAccountHandler.ServeHTTP(w, r)
+		case PaymentsServiceUpdatePayoutAccountProcedure:
+			paymentsServiceUpdatePayoutAccountHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -402,10 +999,78 @@ func (UnimplementedPaymentsServiceHandler) GetWallet(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetWallet is not implemented"))
 }
 
+func (UnimplementedPaymentsServiceHandler) WatchWallet(context.Context, *connect.Request[v1alpha1.WatchWalletRequest], *connect.ServerStream[v1alpha1.WatchWalletResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.WatchWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) CreateWallet(context.Context, *connect.Request[v1alpha1.CreateWalletRequest]) (*connect.Response[v1alpha1.CreateWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreateWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) FreezeWallet(context.Context, *connect.Request[v1alpha1.FreezeWalletRequest]) (*connect.Response[v1alpha1.FreezeWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.FreezeWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) SuspendWallet(context.Context, *connect.Request[v1alpha1.SuspendWalletRequest]) (*connect.Response[v1alpha1.SuspendWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.SuspendWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) UnfreezeWallet(context.Context, *connect.Request[v1alpha1.UnfreezeWalletRequest]) (*connect.Response[v1alpha1.UnfreezeWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.UnfreezeWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) CloseWallet(context.Context, *connect.Request[v1alpha1.CloseWalletRequest]) (*connect.Response[v1alpha1.CloseWalletResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CloseWallet is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) SetBalanceFloor(context.Context, *connect.Request[v1alpha1.SetBalanceFloorRequest]) (*connect.Response[v1alpha1.SetBalanceFloorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.SetBalanceFloor is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) VerifyLedger(context.Context, *connect.Request[v1alpha1.VerifyLedgerRequest]) (*connect.Response[v1alpha1.VerifyLedgerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.VerifyLedger is not implemented"))
+}
+
 func (UnimplementedPaymentsServiceHandler) CreateTransfers(context.Context, *connect.Request[v1alpha1.CreateTransfersRequest]) (*connect.Response[v1alpha1.CreateTransfersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreateTransfers is not implemented"))
 }
 
+func (UnimplementedPaymentsServiceHandler) GetTransfer(context.Context, *connect.Request[v1alpha1.GetTransferRequest]) (*connect.Response[v1alpha1.GetTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetTransfer is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ListTransfers(context.Context, *connect.Request[v1alpha1.ListTransfersRequest]) (*connect.Response[v1alpha1.ListTransfersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ListTransfers is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) CreateTransactions(context.Context, *connect.Request[v1alpha1.CreateTransactionsRequest]) (*connect.Response[v1alpha1.CreateTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreateTransactions is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) CreateHold(context.Context, *connect.Request[v1alpha1.CreateHoldRequest]) (*connect.Response[v1alpha1.CreateHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreateHold is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) GetHold(context.Context, *connect.Request[v1alpha1.GetHoldRequest]) (*connect.Response[v1alpha1.GetHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetHold is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) CaptureHold(context.Context, *connect.Request[v1alpha1.CaptureHoldRequest]) (*connect.Response[v1alpha1.CaptureHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CaptureHold is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ReleaseHold(context.Context, *connect.Request[v1alpha1.ReleaseHoldRequest]) (*connect.Response[v1alpha1.ReleaseHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ReleaseHold is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ReverseTransaction(context.Context, *connect.Request[v1alpha1.ReverseTransactionRequest]) (*connect.Response[v1alpha1.ReverseTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ReverseTransaction is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ReverseBatch(context.Context, *connect.Request[v1alpha1.ReverseBatchRequest]) (*connect.Response[v1alpha1.ReverseBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ReverseBatch is not implemented"))
+}
+
 func (UnimplementedPaymentsServiceHandler) GetTransaction(context.Context, *connect.Request[v1alpha1.GetTransactionRequest]) (*connect.Response[v1alpha1.GetTransactionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetTransaction is not implemented"))
 }
@@ -414,6 +1079,14 @@ func (UnimplementedPaymentsServiceHandler) ListTransactions(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ListTransactions is not implemented"))
 }
 
+func (UnimplementedPaymentsServiceHandler) GetStatement(context.Context, *connect.Request[v1alpha1.GetStatementRequest]) (*connect.Response[v1alpha1.GetStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetStatement is not implemented"))
+}
+
+func (UnimplementedPaymentsServiceHandler) ExportStatement(context.Context, *connect.Request[v1alpha1.ExportStatementRequest], *connect.ServerStream[v1alpha1.ExportStatementResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ExportStatement is not implemented"))
+}
+
 func (UnimplementedPaymentsServiceHandler) CreateRecharge(context.Context, *connect.Request[v1alpha1.CreateRechargeRequest]) (*connect.Response[v1alpha1.CreateRechargeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreateRecharge is not implemented"))
 }
@@ -438,6 +1111,10 @@ func (UnimplementedPaymentsServiceHandler) ListPayouts(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.ListPayouts is not implemented"))
 }
 
+func (UnimplementedPaymentsServiceHandler) CancelPayout(context.Context, *connect.Request[v1alpha1.CancelPayoutRequest]) (*connect.Response[v1alpha1.CancelPayoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CancelPayout is not implemented"))
+}
+
 func (UnimplementedPaymentsServiceHandler) CreatePayoutAccount(context.Context, *connect.Request[v1alpha1.CreatePayoutAccountRequest]) (*connect.Response[v1alpha1.CreatePayoutAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.CreatePayoutAccount is not implemented"))
 }
@@ -445,3 +1122,7 @@ func (UnimplementedPaymentsServiceHandler) CreatePayoutAccount(context.Context,
 func (UnimplementedPaymentsServiceHandler) GetPayoutAccount(context.Context, *connect.Request[v1alpha1.GetPayoutAccountRequest]) (*connect.Response[v1alpha1.GetPayoutAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.GetPayoutAccount is not implemented"))
 }
+
+func (UnimplementedPaymentsServiceHandler) UpdatePayoutAccount(context.Context, *connect.Request[v1alpha1.UpdatePayoutAccountRequest]) (*connect.Response[v1alpha1.UpdatePayoutAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ride.payments.v1alpha1.PaymentsService.UpdatePayoutAccount is not implemented"))
+}
@@ -23,6 +23,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Wallet_State int32
+
+const (
+	Wallet_STATE_UNSPECIFIED Wallet_State = 0
+	// Money can move in and out of the Wallet.
+	Wallet_STATE_ACTIVE Wallet_State = 1
+	// The Wallet can be credited but not debited, for example while its owner is under investigation.
+	Wallet_STATE_FROZEN Wallet_State = 2
+	// The Wallet can neither be credited nor debited.
+	Wallet_STATE_SUSPENDED Wallet_State = 3
+	// The Wallet was closed with a zero balance and can not be used again.
+	Wallet_STATE_CLOSED Wallet_State = 4
+)
+
+// Enum value maps for Wallet_State.
+var (
+	Wallet_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_ACTIVE",
+		2: "STATE_FROZEN",
+		3: "STATE_SUSPENDED",
+		4: "STATE_CLOSED",
+	}
+	Wallet_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_ACTIVE":      1,
+		"STATE_FROZEN":      2,
+		"STATE_SUSPENDED":   3,
+		"STATE_CLOSED":      4,
+	}
+)
+
+func (x Wallet_State) Enum() *Wallet_State {
+	p := new(Wallet_State)
+	*p = x
+	return p
+}
+
+func (x Wallet_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Wallet_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_ride_payments_v1alpha1_wallet_proto_enumTypes[0].Descriptor()
+}
+
+func (Wallet_State) Type() protoreflect.EnumType {
+	return &file_ride_payments_v1alpha1_wallet_proto_enumTypes[0]
+}
+
+func (x Wallet_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Wallet_State.Descriptor instead.
+func (Wallet_State) EnumDescriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_wallet_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // A Wallet is a singleton resource that represents a user's wallet. There can only be one Wallet per user.
 type Wallet struct {
 	state         protoimpl.MessageState
@@ -32,11 +91,25 @@ type Wallet struct {
 	// Relative resource name of the Wallet, for example, "users/user1/wallet"
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Output only. The balance in the Wallet in smallest currecy unit, for example, Rs. 1.75 is 175.
+	// Balance, balance_floor and available_balance are in the primary currency of the Wallet, see currency_code.
 	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
 	// Output only. Timestamp when the Wallet was created.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// Output only. Timestamp when the Wallet was last updated.
 	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. The lowest the balance can be taken by debits, in smallest currency unit. 0 for most wallets,
+	// and negative for wallets with a credit limit, for example drivers who collect cash fares.
+	BalanceFloor int64 `protobuf:"varint,5,opt,name=balance_floor,json=balanceFloor,proto3" json:"balance_floor,omitempty"`
+	// Output only. The balance minus the funds reserved by active Holds. Debits can only spend the available balance.
+	AvailableBalance int64 `protobuf:"varint,6,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// Output only. The lifecycle state of the Wallet.
+	State Wallet_State `protobuf:"varint,7,opt,name=state,proto3,enum=ride.payments.v1alpha1.Wallet_State" json:"state,omitempty"`
+	// Output only. Why the Wallet was last moved to its state, for example the case id of an investigation.
+	StateReason string `protobuf:"bytes,8,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	// Output only. The primary currency of the Wallet, which its balance is in. For example, "INR".
+	CurrencyCode string `protobuf:"bytes,9,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// Output only. The balances of the Wallet in every currency it holds, including the primary currency.
+	Balances []*Wallet_Balance `protobuf:"bytes,10,rep,name=balances,proto3" json:"balances,omitempty"`
 }
 
 func (x *Wallet) Reset() {
@@ -99,6 +172,115 @@ func (x *Wallet) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Wallet) GetBalanceFloor() int64 {
+	if x != nil {
+		return x.BalanceFloor
+	}
+	return 0
+}
+
+func (x *Wallet) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *Wallet) GetState() Wallet_State {
+	if x != nil {
+		return x.State
+	}
+	return Wallet_STATE_UNSPECIFIED
+}
+
+func (x *Wallet) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *Wallet) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Wallet) GetBalances() []*Wallet_Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// The balance of a Wallet in one currency.
+type Wallet_Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The currency of the balance. For example, "USD".
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// The balance in smallest currency unit.
+	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The balance minus the funds in this currency reserved by active Holds.
+	AvailableBalance int64 `protobuf:"varint,3,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+}
+
+func (x *Wallet_Balance) Reset() {
+	*x = Wallet_Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ride_payments_v1alpha1_wallet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wallet_Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet_Balance) ProtoMessage() {}
+
+func (x *Wallet_Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_ride_payments_v1alpha1_wallet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet_Balance.ProtoReflect.Descriptor instead.
+func (*Wallet_Balance) Descriptor() ([]byte, []int) {
+	return file_ride_payments_v1alpha1_wallet_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Wallet_Balance) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Wallet_Balance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Wallet_Balance) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
 var File_ride_payments_v1alpha1_wallet_proto protoreflect.FileDescriptor
 
 var file_ride_payments_v1alpha1_wallet_proto_rawDesc = []byte{
@@ -109,7 +291,7 @@ var file_ride_payments_v1alpha1_wallet_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
 	0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x06,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x05, 0x0a, 0x06,
 	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xba, 0x48, 0x24, 0xd0, 0x01, 0x01, 0x72, 0x1f, 0x32, 0x1d,
 	0x5e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
@@ -123,12 +305,43 @@ var file_ride_payments_v1alpha1_wallet_proto_rawDesc = []byte{
 	0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x42, 0x0a,
+	0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x72, 0x69, 0x64, 0x65, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x1a, 0x75, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x10, 0x04, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x69, 0x64, 0x65, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x69, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -143,19 +356,24 @@ func file_ride_payments_v1alpha1_wallet_proto_rawDescGZIP() []byte {
 	return file_ride_payments_v1alpha1_wallet_proto_rawDescData
 }
 
-var file_ride_payments_v1alpha1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ride_payments_v1alpha1_wallet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ride_payments_v1alpha1_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_ride_payments_v1alpha1_wallet_proto_goTypes = []interface{}{
-	(*Wallet)(nil),                // 0: ride.payments.v1alpha1.Wallet
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(Wallet_State)(0),             // 0: ride.payments.v1alpha1.Wallet.State
+	(*Wallet)(nil),                // 1: ride.payments.v1alpha1.Wallet
+	(*Wallet_Balance)(nil),        // 2: ride.payments.v1alpha1.Wallet.Balance
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_ride_payments_v1alpha1_wallet_proto_depIdxs = []int32{
-	1, // 0: ride.payments.v1alpha1.Wallet.create_time:type_name -> google.protobuf.Timestamp
-	1, // 1: ride.payments.v1alpha1.Wallet.update_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: ride.payments.v1alpha1.Wallet.create_time:type_name -> google.protobuf.Timestamp
+	3, // 1: ride.payments.v1alpha1.Wallet.update_time:type_name -> google.protobuf.Timestamp
+	0, // 2: ride.payments.v1alpha1.Wallet.state:type_name -> ride.payments.v1alpha1.Wallet.State
+	2, // 3: ride.payments.v1alpha1.Wallet.balances:type_name -> ride.payments.v1alpha1.Wallet.Balance
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_ride_payments_v1alpha1_wallet_proto_init() }
@@ -176,19 +394,32 @@ func file_ride_payments_v1alpha1_wallet_proto_init() {
 				return nil
 			}
 		}
+		file_ride_payments_v1alpha1_wallet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wallet_Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ride_payments_v1alpha1_wallet_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ride_payments_v1alpha1_wallet_proto_goTypes,
 		DependencyIndexes: file_ride_payments_v1alpha1_wallet_proto_depIdxs,
+		EnumInfos:         file_ride_payments_v1alpha1_wallet_proto_enumTypes,
 		MessageInfos:      file_ride_payments_v1alpha1_wallet_proto_msgTypes,
 	}.Build()
 	File_ride_payments_v1alpha1_wallet_proto = out.File
@@ -44,7 +44,11 @@ func main() {
 	mux.Handle(v1alpha1connect.NewPaymentsServiceHandler(service, connectInterceptors))
 
 	middleware := authn.NewMiddleware(middlewares.FirebaseAuth)
-	handler := middleware.Wrap(mux)
+
+	// Webhooks are authenticated by their signature instead of a Firebase token.
+	handler := http.NewServeMux()
+	handler.HandleFunc("POST /webhooks/{gateway}", service.PaymentGatewayWebhook)
+	handler.Handle("/", middleware.Wrap(mux))
 
 	// trunk-ignore(semgrep/go.lang.security.audit.net.use-tls.use-tls)
 	panic(http.ListenAndServe(
@@ -7,7 +7,10 @@ import (
 	"github.com/google/wire"
 	"github.com/ride-app/payments-service/config"
 	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	"github.com/ride-app/payments-service/internal/gateway"
+	razorpaygateway "github.com/ride-app/payments-service/internal/gateway/razorpay"
 	authrepository "github.com/ride-app/payments-service/internal/repositories/auth"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
 	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
 	rechargerepository "github.com/ride-app/payments-service/internal/repositories/recharge"
 	transferrepository "github.com/ride-app/payments-service/internal/repositories/transfer"
@@ -19,8 +22,11 @@ func InitializeService(logger logger.Logger, config *config.Config) (*apihandler
 	panic(
 		wire.Build(
 			thirdparty.NewFirebaseApp,
-			thirdparty.NewPubSubClient,
-			thirdparty.NewRazorpayClient,
+			razorpaygateway.NewRazorpayGateway,
+			wire.Bind(
+				new(gateway.PaymentGateway),
+				new(*razorpaygateway.RazorpayImpl),
+			),
 			authrepository.NewFirebaseAuthRepository,
 			wire.Bind(
 				new(authrepository.AuthRepository),
@@ -46,6 +52,11 @@ func InitializeService(logger logger.Logger, config *config.Config) (*apihandler
 				new(payoutrepository.PayoutRepository),
 				new(*payoutrepository.FirestoreImpl),
 			),
+			idempotencyrepository.NewFirestoreIdempotencyRepository,
+			wire.Bind(
+				new(idempotencyrepository.IdempotencyRepository),
+				new(*idempotencyrepository.FirestoreImpl),
+			),
 			apihandlers.New,
 		),
 	)
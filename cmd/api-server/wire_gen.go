@@ -10,7 +10,9 @@ import (
 	"github.com/dragonfish/go/v2/pkg/logger"
 	"github.com/ride-app/payments-service/config"
 	"github.com/ride-app/payments-service/internal/api-handlers"
+	"github.com/ride-app/payments-service/internal/gateway/razorpay"
 	"github.com/ride-app/payments-service/internal/repositories/auth"
+	"github.com/ride-app/payments-service/internal/repositories/idempotency"
 	"github.com/ride-app/payments-service/internal/repositories/payout"
 	"github.com/ride-app/payments-service/internal/repositories/recharge"
 	"github.com/ride-app/payments-service/internal/repositories/transfer"
@@ -37,19 +39,22 @@ func InitializeService(logger2 logger.Logger, config2 *config.Config) (*apihandl
 	if err != nil {
 		return nil, err
 	}
-	client, err := thirdparty.NewPubSubClient(config2)
+	rechargerepositoryFirestoreImpl, err := rechargerepository.NewFirestoreRechargeRepository(config2, app)
 	if err != nil {
 		return nil, err
 	}
-	rechargerepositoryFirestoreImpl, err := rechargerepository.NewFirestoreRechargeRepository(config2, app, client)
+	razorpayImpl := razorpaygateway.NewRazorpayGateway(config2)
+	payoutrepositoryFirestoreImpl, err := payoutrepository.NewFirestorePayoutRepository(config2, app, razorpayImpl)
 	if err != nil {
 		return nil, err
 	}
-	payoutrepositoryFirestoreImpl, err := payoutrepository.NewFirestorePayoutRepository(config2, app)
+	idempotencyrepositoryFirestoreImpl, err := idempotencyrepository.NewFirestoreIdempotencyRepository(config2, app)
+	if err != nil {
+		return nil, err
+	}
+	paymentsServiceServer, err := apihandlers.New(logger2, config2, firebaseImpl, firestoreImpl, transferrepositoryFirestoreImpl, rechargerepositoryFirestoreImpl, payoutrepositoryFirestoreImpl, idempotencyrepositoryFirestoreImpl, razorpayImpl)
 	if err != nil {
 		return nil, err
 	}
-	razorpayClient := thirdparty.NewRazorpayClient(config2)
-	paymentsServiceServer := apihandlers.New(logger2, config2, firebaseImpl, firestoreImpl, transferrepositoryFirestoreImpl, rechargerepositoryFirestoreImpl, payoutrepositoryFirestoreImpl, razorpayClient)
 	return paymentsServiceServer, nil
 }
@@ -0,0 +1,51 @@
+// Command backfill-create-time sets create_time on recharges and payouts written before it was stored, so that they
+// are listed by ListRecharges and ListPayouts again. It can be run again until it reports no missing create times.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/migrations"
+	thirdparty "github.com/ride-app/payments-service/third-party"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "count the documents missing create_time without writing them")
+	flag.Parse()
+
+	config, err := config.New()
+
+	log := logger.New(!config.Production, config.LogDebug)
+
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read environment variables")
+	}
+
+	app, err := thirdparty.NewFirebaseApp(config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize firebase app: %v", err)
+	}
+
+	ctx := context.Background()
+	client, err := app.Firestore(ctx)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize firestore client: %v", err)
+	}
+
+	defer client.Close()
+
+	for _, collectionGroup := range []string{"recharges", "payouts"} {
+		backfilled, err := migrations.BackfillCreateTime(ctx, log, client, collectionGroup, *dryRun)
+
+		if err != nil {
+			log.Fatalf("Failed to backfill create time of %s: %v", collectionGroup, err)
+		}
+
+		log.Infof("Backfilled create time of %d %s", backfilled, collectionGroup)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+)
+
+func main() {
+	config, err := config.New()
+
+	log := logger.New(!config.Production, config.LogDebug)
+
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read environment variables")
+	}
+
+	handler, err := InitializeEventHandler(log, config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize event handler: %v", err)
+	}
+
+	log.Info("Event Handler Initialized")
+
+	// Events are delivered by Eventarc as CloudEvents in binary content mode.
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /events/user-created", handler.UserCreated)
+	mux.HandleFunc("POST /events/transaction-created", handler.TransactionCreated)
+	mux.HandleFunc("POST /events/ride-completed", handler.RideCompleted)
+
+	// trunk-ignore(semgrep/go.lang.security.audit.net.use-tls.use-tls)
+	panic(http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", config.Port), mux))
+}
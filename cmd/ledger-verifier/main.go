@@ -0,0 +1,101 @@
+// Command ledger-verifier recomputes wallet balances from the transaction log and writes a report of
+// mismatched wallets to stdout, one JSON encoded LedgerDiscrepancy per line.
+// It exits with status 1 if a mismatch was found and not repaired.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/pagination"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	repair := flag.Bool("repair", false, "overwrite mismatched balances with the balances computed from the transactions")
+	userId := flag.String("user", "", "verify the wallet of a single user instead of all wallets")
+	flag.Parse()
+
+	config, err := config.New()
+
+	log := logger.New(!config.Production, config.LogDebug)
+
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read environment variables")
+	}
+
+	walletRepository, err := InitializeWalletRepository(config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize wallet repository: %v", err)
+	}
+
+	verified, unrepaired, err := verify(context.Background(), log, walletRepository, *userId, *repair, os.Stdout)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	log.Infof("Verified %d wallets, %d unrepaired discrepancies", verified, unrepaired)
+
+	if unrepaired > 0 {
+		os.Exit(1)
+	}
+}
+
+// verify verifies the wallet of a user, or every wallet if userId is empty, and reports their discrepancies to out.
+// It returns the number of verified wallets and of discrepancies that were not repaired.
+func verify(ctx context.Context, log logger.Logger, walletRepository walletrepository.WalletRepository, userId string, repair bool, out io.Writer) (int, int, error) {
+	userIds := []string{userId}
+	page := pagination.NewPage(pagination.MaxPageSize, nil)
+	verified, unrepaired := 0, 0
+
+	for {
+		if userId == "" {
+			var next *pagination.Cursor
+			var err error
+			userIds, next, err = walletRepository.GetWalletIds(ctx, log, page)
+
+			if err != nil {
+				return verified, unrepaired, fmt.Errorf("failed to fetch wallet ids: %w", err)
+			}
+
+			page.After = next
+		}
+
+		for _, userId := range userIds {
+			discrepancies, err := walletRepository.VerifyWallet(ctx, log, userId, repair)
+
+			if err != nil {
+				return verified, unrepaired, fmt.Errorf("failed to verify wallet of user %s: %w", userId, err)
+			}
+
+			verified++
+
+			for _, discrepancy := range discrepancies {
+				report(out, discrepancy)
+
+				if !discrepancy.Repaired {
+					unrepaired++
+				}
+			}
+		}
+
+		if userId != "" || page.After == nil {
+			return verified, unrepaired, nil
+		}
+	}
+}
+
+// report writes a discrepancy to out as a single line of JSON.
+func report(out io.Writer, discrepancy *pb.LedgerDiscrepancy) {
+	line, _ := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(discrepancy)
+	fmt.Fprintln(out, string(line))
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/pagination"
+	mock_wallet "github.com/ride-app/payments-service/internal/repositories/wallet/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("verify", func() {
+	var (
+		walletRepository *mock_wallet.MockWalletRepository
+		log              logger.Logger
+		out              *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		walletRepository = mock_wallet.NewMockWalletRepository(gomock.NewController(GinkgoT()))
+		log = logger.New(true, false)
+		out = &bytes.Buffer{}
+	})
+
+	mismatch := &pb.LedgerDiscrepancy{Wallet: "users/user2/wallet", CurrencyCode: "INR", RecordedBalance: 800, ComputedBalance: 500, Difference: 300}
+
+	It("verifies every page of wallets and reports their discrepancies", func() {
+		walletRepository.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user1"}, &pagination.Cursor{Id: "user1"}, nil)
+		walletRepository.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user2"}, nil, nil)
+		walletRepository.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user1", false).Return(nil, nil)
+		walletRepository.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user2", false).Return([]*pb.LedgerDiscrepancy{mismatch}, nil)
+
+		verified, unrepaired, err := verify(context.Background(), log, walletRepository, "", false, out)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verified).To(Equal(2))
+		Expect(unrepaired).To(Equal(1))
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"wallet":"users/user2/wallet"`))
+		Expect(lines[0]).To(ContainSubstring(`"repaired":false`))
+	})
+
+	It("verifies the wallet of a single user", func() {
+		walletRepository.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user2", false).Return(nil, nil)
+
+		verified, unrepaired, err := verify(context.Background(), log, walletRepository, "user2", false, out)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verified).To(Equal(1))
+		Expect(unrepaired).To(BeZero())
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("does not count repaired discrepancies as unrepaired", func() {
+		repaired := proto.Clone(mismatch).(*pb.LedgerDiscrepancy)
+		repaired.Repaired = true
+
+		walletRepository.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user2", true).Return([]*pb.LedgerDiscrepancy{repaired}, nil)
+
+		_, unrepaired, err := verify(context.Background(), log, walletRepository, "user2", true, out)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unrepaired).To(BeZero())
+		Expect(out.String()).To(ContainSubstring(`"repaired":true`))
+	})
+
+	It("stops at the first wallet that can not be verified", func() {
+		walletRepository.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user2", false).Return(nil, errors.New("deadline exceeded"))
+
+		_, _, err := verify(context.Background(), log, walletRepository, "user2", false, out)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,23 @@
+//go:build wireinject
+
+package main
+
+import (
+	"github.com/google/wire"
+	"github.com/ride-app/payments-service/config"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	thirdparty "github.com/ride-app/payments-service/third-party"
+)
+
+func InitializeWalletRepository(config *config.Config) (walletrepository.WalletRepository, error) {
+	panic(
+		wire.Build(
+			thirdparty.NewFirebaseApp,
+			walletrepository.NewFirestoreWalletRepository,
+			wire.Bind(
+				new(walletrepository.WalletRepository),
+				new(*walletrepository.FirestoreImpl),
+			),
+		),
+	)
+}
@@ -0,0 +1,56 @@
+// Command outbox-relay publishes the events of the outbox to the events topic. Instances take turns publishing through
+// a lease in Firestore, so only one of them publishes at a time.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+)
+
+func main() {
+	config, err := config.New()
+
+	log := logger.New(!config.Production, config.LogDebug)
+
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read environment variables")
+	}
+
+	relay, err := InitializeRelay(log, config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize outbox relay: %v", err)
+	}
+
+	log.Info("Outbox Relay Initialized")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Cloud Run needs the container to listen on its port.
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	go func() {
+		// trunk-ignore(semgrep/go.lang.security.audit.net.use-tls.use-tls)
+		if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", config.Port), mux); err != nil {
+			log.WithError(err).Fatal("Failed to serve health checks")
+		}
+	}()
+
+	if err := relay.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.WithError(err).Fatal("Outbox relay stopped")
+	}
+
+	log.Info("Outbox Relay Stopped")
+}
@@ -0,0 +1,21 @@
+//go:build wireinject
+
+package main
+
+import (
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/google/wire"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/outbox"
+	thirdparty "github.com/ride-app/payments-service/third-party"
+)
+
+func InitializeRelay(logger logger.Logger, config *config.Config) (*outbox.Relay, error) {
+	panic(
+		wire.Build(
+			thirdparty.NewFirebaseApp,
+			thirdparty.NewPubSubClient,
+			outbox.NewRelay,
+		),
+	)
+}
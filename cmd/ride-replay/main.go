@@ -0,0 +1,105 @@
+// Command ride-replay settles the ride completed events that were dead-lettered after failing to settle. Events that
+// settle are acknowledged and removed from the dead-letter subscription, and events that fail again are left on it.
+// It stops once no new event has arrived for the idle timeout, and exits with status 1 if an event failed again.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	thirdparty "github.com/ride-app/payments-service/third-party"
+)
+
+func main() {
+	subscriptionId := flag.String("subscription", "", "dead-letter subscription to replay, defaults to RIDE_DEAD_LETTER_SUBSCRIPTION")
+	idleTimeout := flag.Duration("idle", 10*time.Second, "stop after no new event has arrived for this long")
+	flag.Parse()
+
+	config, err := config.New()
+
+	log := logger.New(!config.Production, config.LogDebug)
+
+	if err != nil {
+		log.WithError(err).Fatal("Failed to read environment variables")
+	}
+
+	if *subscriptionId == "" {
+		*subscriptionId = config.RideDeadLetterSub
+	}
+
+	handler, err := InitializeEventHandler(log, config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize event handler: %v", err)
+	}
+
+	client, err := thirdparty.NewPubSubClient(config)
+
+	if err != nil {
+		log.Fatalf("Failed to initialize pubsub client: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	subscription := client.Subscription(*subscriptionId)
+	subscription.ReceiveSettings.NumGoroutines = 1
+	subscription.ReceiveSettings.MaxOutstandingMessages = 1
+
+	var mu sync.Mutex
+	seen := map[string]bool{}
+	replayed, failed := 0, 0
+	idle := time.AfterFunc(*idleTimeout, cancel)
+
+	log.Infof("Replaying events of subscription %s", *subscriptionId)
+	err = subscription.Receive(ctx, func(ctx context.Context, message *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Events that failed earlier in this run are redelivered after their nack, and are left for the next run.
+		if seen[message.ID] {
+			message.Nack()
+			return
+		}
+
+		seen[message.ID] = true
+
+		// The idle timeout is not counted while an event is being settled.
+		idle.Stop()
+		defer idle.Reset(*idleTimeout)
+
+		log := log.WithField("message_id", message.ID)
+
+		if err := handler.SettleRide(ctx, log, message.Data); err != nil {
+			log.WithError(err).Error("Failed to settle ride")
+			failed++
+			message.Nack()
+			return
+		}
+
+		replayed++
+		message.Ack()
+	})
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatalf("Failed to receive events: %v", err)
+	}
+
+	log.Infof("Replayed %d events, %d failed again", replayed, failed)
+
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build wireinject
+
+package main
+
+import (
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/google/wire"
+	"github.com/ride-app/payments-service/config"
+	eventhandlers "github.com/ride-app/payments-service/internal/event-handlers"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	thirdparty "github.com/ride-app/payments-service/third-party"
+)
+
+func InitializeEventHandler(logger logger.Logger, config *config.Config) (*eventhandlers.EventHandler, error) {
+	panic(
+		wire.Build(
+			thirdparty.NewFirebaseApp,
+			walletrepository.NewFirestoreWalletRepository,
+			wire.Bind(
+				new(walletrepository.WalletRepository),
+				new(*walletrepository.FirestoreImpl),
+			),
+			idempotencyrepository.NewFirestoreIdempotencyRepository,
+			wire.Bind(
+				new(idempotencyrepository.IdempotencyRepository),
+				new(*idempotencyrepository.FirestoreImpl),
+			),
+			eventhandlers.New,
+		),
+	)
+}
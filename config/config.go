@@ -1,23 +1,59 @@
 package config
 
-import "github.com/ilyakaznacheev/cleanenv"
+import (
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
 
 type Config struct {
-	Production              bool   `env:"PRODUCTION" env-description:"dev or prod" env-default:"true"`
-	LogDebug                bool   `env:"LOG_DEBUG" env-description:"should log at debug level" env-default:"false"`
-	Port                    int32  `env:"PORT" env-description:"server port" env-default:"50051"`
-	Razorpay_Key            string `env:"RAZORPAY_KEY" env-description:"razorpay key" env-default:""`
-	Razorpay_Secret         string `env:"RAZORPAY_SECRET" env-description:"razorpay secret" env-default:""`
-	Razorpay_Account_Number string `env:"RAZORPAY_ACCOUNT_NUMBER" env-description:"razorpay account number" env-default:""`
-	ProjectId               string `env:"PROJECT_ID" env-description:"firebase project id" env-default:"NO_PROJECT"`
+	Production              bool          `env:"PRODUCTION" env-description:"dev or prod" env-default:"true"`
+	LogDebug                bool          `env:"LOG_DEBUG" env-description:"should log at debug level" env-default:"false"`
+	Port                    int32         `env:"PORT" env-description:"server port" env-default:"50051"`
+	Razorpay_Key            string        `env:"RAZORPAY_KEY" env-description:"razorpay key" env-default:""`
+	Razorpay_Secret         string        `env:"RAZORPAY_SECRET" env-description:"razorpay secret" env-default:""`
+	Razorpay_Account_Number string        `env:"RAZORPAY_ACCOUNT_NUMBER" env-description:"razorpay account number" env-default:""`
+	Razorpay_Webhook_Secret string        `env:"RAZORPAY_WEBHOOK_SECRET" env-description:"razorpay webhook secret" env-default:""`
+	PaymentGatewayTimeout   time.Duration `env:"PAYMENT_GATEWAY_TIMEOUT" env-description:"timeout of requests to the payment gateway" env-default:"15s"`
+	ProjectId               string        `env:"PROJECT_ID" env-description:"firebase project id" env-default:"NO_PROJECT"`
+	IdempotencyKeyTTL       time.Duration `env:"IDEMPOTENCY_KEY_TTL" env-description:"how long request ids are remembered" env-default:"24h"`
+	IdempotencyLeaseTTL     time.Duration `env:"IDEMPOTENCY_LEASE_TTL" env-description:"how long a request id stays reserved by a request that stopped renewing it" env-default:"30s"`
+	PageTokenSecret         string        `env:"PAGE_TOKEN_SECRET" env-description:"secret used to sign page tokens" env-default:""`
+	TrustedCallers          []string      `env:"TRUSTED_CALLERS" env-description:"comma separated uids of internal services allowed to call internal rpcs" env-separator:","`
+	DefaultBalanceFloor     int64         `env:"DEFAULT_BALANCE_FLOOR" env-description:"lowest balance of wallets without a balance floor of their own" env-default:"0"`
+	HoldTTL                 time.Duration `env:"HOLD_TTL" env-description:"how long holds reserve funds when created without an expire time" env-default:"6h"`
+	PayoutCoolingPeriod     time.Duration `env:"PAYOUT_COOLING_PERIOD" env-description:"how long payouts are blocked after a payout account destination changes" env-default:"24h"`
+	SupportedCurrencies     []string      `env:"SUPPORTED_CURRENCIES" env-description:"comma separated currency codes wallets can hold" env-separator:"," env-default:"INR"`
+	EventsTopic             string        `env:"EVENTS_TOPIC" env-description:"pubsub topic the outbox relay publishes domain events to" env-default:"payments-events"`
+	OutboxPollInterval      time.Duration `env:"OUTBOX_POLL_INTERVAL" env-description:"how often the outbox relay looks for unpublished events" env-default:"1s"`
+	OutboxMaxAttempts       int64         `env:"OUTBOX_MAX_ATTEMPTS" env-description:"failed publish attempts after which an event is moved to the outbox dead letter collection" env-default:"10"`
+	OutboxLeaseTTL          time.Duration `env:"OUTBOX_LEASE_TTL" env-description:"how long the outbox relay lease is held by a relay that stopped renewing it" env-default:"1m"`
+	PlatformWalletId        string        `env:"PLATFORM_WALLET_ID" env-description:"user id of the wallet ride commissions are credited to" env-default:""`
+	TaxWalletId             string        `env:"TAX_WALLET_ID" env-description:"user id of the wallet taxes collected on rides are credited to" env-default:""`
+	RideCommissionBps       int64         `env:"RIDE_COMMISSION_BPS" env-description:"platform commission on ride fares in basis points" env-default:"2000"`
+	RideTaxBps              int64         `env:"RIDE_TAX_BPS" env-description:"tax charged to riders on ride fares in basis points" env-default:"500"`
+	RideDeadLetterSub       string        `env:"RIDE_DEAD_LETTER_SUBSCRIPTION" env-description:"pubsub subscription of ride completed events that failed to settle" env-default:"ride-completed-dead-letter"`
 }
 
 func New() (*Config, error) {
 	config := Config{
-		Production: true,
-		LogDebug:   false,
-		Port:       50051,
-		ProjectId:  "NO_PROJECT",
+		Production:            true,
+		LogDebug:              false,
+		Port:                  50051,
+		ProjectId:             "NO_PROJECT",
+		PaymentGatewayTimeout: 15 * time.Second,
+		IdempotencyKeyTTL:     24 * time.Hour,
+		IdempotencyLeaseTTL:   30 * time.Second,
+		PayoutCoolingPeriod:   24 * time.Hour,
+		HoldTTL:               6 * time.Hour,
+		SupportedCurrencies:   []string{"INR"},
+		EventsTopic:           "payments-events",
+		OutboxPollInterval:    time.Second,
+		OutboxMaxAttempts:     10,
+		OutboxLeaseTTL:        time.Minute,
+		RideCommissionBps:     2000,
+		RideTaxBps:            500,
+		RideDeadLetterSub:     "ride-completed-dead-letter",
 	}
 
 	if err := cleanenv.ReadEnv(&config); err != nil {
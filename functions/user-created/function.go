@@ -0,0 +1,58 @@
+// Package usercreated publishes the users created in Firebase Auth to the user created topic, which provisions their
+// wallets in the event handler. Firebase Auth events are only delivered to 1st gen Cloud Functions, so this is deployed
+// as a function instead of being handled by the event handler directly.
+package usercreated
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"cloud.google.com/go/pubsub"
+)
+
+// AuthEvent is the data of a Firebase Auth user created event.
+type AuthEvent struct {
+	Uid string `json:"uid"`
+}
+
+var (
+	topic     *pubsub.Topic
+	topicErr  error
+	topicOnce sync.Once
+)
+
+// PublishUserCreated publishes the uid of a new user to the topic named by USER_CREATED_TOPIC.
+// Errors are returned so that the function is retried when it is deployed with retries.
+func PublishUserCreated(ctx context.Context, event AuthEvent) error {
+	if event.Uid == "" {
+		return nil
+	}
+
+	topicOnce.Do(func() {
+		var client *pubsub.Client
+		client, topicErr = pubsub.NewClient(context.Background(), os.Getenv("PROJECT_ID"))
+
+		if topicErr == nil {
+			topic = client.Topic(os.Getenv("USER_CREATED_TOPIC"))
+		}
+	})
+
+	if topicErr != nil {
+		return fmt.Errorf("failed to create pubsub client: %w", topicErr)
+	}
+
+	data, err := json.Marshal(&event)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := topic.Publish(ctx, &pubsub.Message{Data: data}).Get(ctx); err != nil {
+		return fmt.Errorf("failed to publish user %s: %w", event.Uid, err)
+	}
+
+	return nil
+}
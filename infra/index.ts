@@ -4,6 +4,10 @@ import * as pulumi from "@pulumi/pulumi";
 const serviceName = new pulumi.Config("service").get("name") || pulumi.getProject();
 const location = gcp.config.region || "asia-east1";
 
+// Cloud Run services and jobs are deployed by cloudbuild.yaml to this region.
+const runRegion = "asia-south2";
+const rideConfig = new pulumi.Config("ride");
+
 const github_connection = gcp.cloudbuildv2.Connection.get(
   "github-connection",
   pulumi.interpolate`projects/${gcp.config.project}/locations/${location}/connections/GitHub`,
@@ -25,7 +29,228 @@ new gcp.cloudbuild.Trigger("build-trigger", {
   },
   substitutions: {
     _LOG_DEBUG: new pulumi.Config().get("logDebug") ?? "false",
+    _PLATFORM_WALLET_ID: rideConfig.get("platformWalletId") ?? "",
+    _TAX_WALLET_ID: rideConfig.get("taxWalletId") ?? "",
   },
   filename: "cloudbuild.yaml",
   includeBuildLogs: "INCLUDE_BUILD_LOGS_WITH_STATUS",
 });
+
+// Razorpay webhooks look up recharges across all wallets by their order id.
+new gcp.firestore.Field("recharges-reference", {
+  collection: "recharges",
+  field: "reference",
+  indexConfig: {
+    indexes: [
+      { order: "ASCENDING", queryScope: "COLLECTION" },
+      { order: "ASCENDING", queryScope: "COLLECTION_GROUP" },
+    ],
+  },
+});
+
+// Request ids of create calls are forgotten once they expire.
+new gcp.firestore.Field("idempotency-keys-expire-time", {
+  collection: "idempotency-keys",
+  field: "expire_time",
+  ttlConfig: {},
+});
+
+// Transactions are listed per wallet in either direction of create_time. Equality filters on the other
+// fields are served by merging the wallet index with the index of each filtered field.
+for (const order of ["ASCENDING", "DESCENDING"]) {
+  for (const filter of ["wallet_id", "batch_id", "type", "currency_code", "details.reference"]) {
+    new gcp.firestore.Index(`transactions-${filter.replace(/[_.]/g, "-")}-create-time-${order.toLowerCase()}`, {
+      collection: "transactions",
+      fields: [
+        { fieldPath: filter, order: "ASCENDING" },
+        { fieldPath: "create_time", order },
+        { fieldPath: "__name__", order },
+      ],
+    });
+  }
+}
+
+// Recharges and payouts of a wallet are filtered by status and transaction id, newest first.
+// Ranges on create_time are served by the same indexes.
+for (const collection of ["recharges", "payouts"]) {
+  for (const filters of [["status"], ["transaction_id"], ["status", "transaction_id"]]) {
+    new gcp.firestore.Index(`${collection}-${filters.join("-").replace(/_/g, "-")}-create-time`, {
+      collection,
+      fields: [
+        ...filters.map((fieldPath) => ({ fieldPath, order: "ASCENDING" })),
+        { fieldPath: "create_time", order: "DESCENDING" },
+        { fieldPath: "__name__", order: "DESCENDING" },
+      ],
+    });
+  }
+}
+
+// Transfers are stored once and listed from both the source and the destination wallet, newest first.
+for (const filter of ["source", "destination"]) {
+  new gcp.firestore.Index(`transfers-${filter}-create-time`, {
+    collection: "transfers",
+    fields: [
+      { fieldPath: filter, order: "ASCENDING" },
+      { fieldPath: "create_time", order: "DESCENDING" },
+      { fieldPath: "__name__", order: "DESCENDING" },
+    ],
+  });
+}
+
+// Active holds of a wallet are summed to compute its available balance.
+new gcp.firestore.Index("holds-status-expire-time", {
+  collection: "holds",
+  fields: [
+    { fieldPath: "status", order: "ASCENDING" },
+    { fieldPath: "expire_time", order: "ASCENDING" },
+  ],
+});
+
+// The outbox relay publishes unpublished events oldest first, and published events are deleted after their retention.
+new gcp.firestore.Index("outbox-published-create-time", {
+  collection: "outbox",
+  fields: [
+    { fieldPath: "published", order: "ASCENDING" },
+    { fieldPath: "create_time", order: "ASCENDING" },
+  ],
+});
+
+new gcp.firestore.Field("outbox-expire-time", {
+  collection: "outbox",
+  field: "expire_time",
+  ttlConfig: {},
+});
+
+new gcp.pubsub.Topic("events-topic", {
+  name: "payments-events",
+});
+
+const project = gcp.organizations.getProjectOutput({});
+const pubsubServiceAgent = pulumi.interpolate`serviceAccount:service-${project.number}@gcp-sa-pubsub.iam.gserviceaccount.com`;
+
+// The event handler is deployed by cloudbuild.yaml without public access, and is invoked by Eventarc triggers and
+// Pub/Sub push subscriptions as the event invoker service account.
+const eventHandler = gcp.cloudrunv2.getServiceOutput({
+  name: `${serviceName}-event-handler`,
+  location: runRegion,
+});
+
+const eventInvoker = new gcp.serviceaccount.Account("event-invoker", {
+  accountId: `${serviceName}-events`.slice(0, 30),
+  displayName: "Invokes the payments event handler",
+});
+
+const eventInvokerMember = pulumi.interpolate`serviceAccount:${eventInvoker.email}`;
+
+new gcp.cloudrunv2.ServiceIamMember("event-handler-invoker", {
+  name: eventHandler.name,
+  location: runRegion,
+  role: "roles/run.invoker",
+  member: eventInvokerMember,
+});
+
+new gcp.projects.IAMMember("event-invoker-event-receiver", {
+  project: project.projectId,
+  role: "roles/eventarc.eventReceiver",
+  member: eventInvokerMember,
+});
+
+// The Pub/Sub service agent signs the tokens of push requests as the event invoker.
+new gcp.serviceaccount.IAMMember("event-invoker-token-creator", {
+  serviceAccountId: eventInvoker.name,
+  role: "roles/iam.serviceAccountTokenCreator",
+  member: pubsubServiceAgent,
+});
+
+// Users created in Firebase Auth are published to the user created topic by the user-created function deployed by
+// cloudbuild.yaml, see functions/user-created.
+const userCreatedTopic = new gcp.pubsub.Topic("user-created-topic", {
+  name: new pulumi.Config("users").get("createdTopic") ?? "user-created",
+});
+
+// Wallets are provisioned for users published to the user created topic.
+new gcp.eventarc.Trigger("user-created-trigger", {
+  name: `${serviceName}-user-created`,
+  location: runRegion,
+  matchingCriterias: [{ attribute: "type", value: "google.cloud.pubsub.topic.v1.messagePublished" }],
+  transport: {
+    pubsub: {
+      topic: userCreatedTopic.id,
+    },
+  },
+  destination: {
+    cloudRunService: { service: eventHandler.name, region: runRegion, path: "/events/user-created" },
+  },
+  serviceAccount: eventInvoker.email,
+});
+
+// Transactions written by legacy clients are applied to their wallets. Firestore triggers are created in the
+// location of the database.
+new gcp.eventarc.Trigger("transaction-created-trigger", {
+  name: `${serviceName}-transaction-created`,
+  location: new pulumi.Config("firestore").get("location") ?? runRegion,
+  matchingCriterias: [
+    { attribute: "type", value: "google.cloud.firestore.document.v1.created" },
+    { attribute: "database", value: "(default)" },
+    { attribute: "document", value: "transactions/{transactionId}", operator: "match-path-pattern" },
+  ],
+  eventDataContentType: "application/protobuf",
+  destination: {
+    cloudRunService: { service: eventHandler.name, region: runRegion, path: "/events/transaction-created" },
+  },
+  serviceAccount: eventInvoker.email,
+});
+
+// Ride completed events are pushed to the event handler, and events that fail to settle after
+// maxDeliveryAttempts are dead-lettered for the ride-replay job to settle once fixed.
+
+const rideCompletedDeadLetterTopic = new gcp.pubsub.Topic("ride-completed-dead-letter-topic", {
+  name: "ride-completed-dead-letter",
+});
+
+const rideCompletedDeadLetterSubscription = new gcp.pubsub.Subscription("ride-completed-dead-letter-subscription", {
+  name: "ride-completed-dead-letter",
+  topic: rideCompletedDeadLetterTopic.id,
+  messageRetentionDuration: "604800s",
+  expirationPolicy: { ttl: "" },
+});
+
+const rideCompletedSubscription = new gcp.pubsub.Subscription("ride-completed-subscription", {
+  name: `${serviceName}-ride-completed`,
+  topic: pulumi.interpolate`projects/${gcp.config.project}/topics/${rideConfig.get("completedTopic") ?? "ride-completed"}`,
+  ackDeadlineSeconds: 60,
+  pushConfig: {
+    pushEndpoint: pulumi.interpolate`${eventHandler.uri}/events/ride-completed`,
+    oidcToken: { serviceAccountEmail: eventInvoker.email, audience: eventHandler.uri },
+    noWrapper: { writeMetadata: true },
+  },
+  retryPolicy: {
+    minimumBackoff: "10s",
+    maximumBackoff: "600s",
+  },
+  deadLetterPolicy: {
+    deadLetterTopic: rideCompletedDeadLetterTopic.id,
+    maxDeliveryAttempts: 10,
+  },
+  expirationPolicy: { ttl: "" },
+});
+
+// The Pub/Sub service agent forwards undeliverable events to the dead-letter topic.
+new gcp.pubsub.TopicIAMMember("ride-completed-dead-letter-publisher", {
+  topic: rideCompletedDeadLetterTopic.name,
+  role: "roles/pubsub.publisher",
+  member: pubsubServiceAgent,
+});
+
+new gcp.pubsub.SubscriptionIAMMember("ride-completed-dead-letter-subscriber", {
+  subscription: rideCompletedSubscription.name,
+  role: "roles/pubsub.subscriber",
+  member: pubsubServiceAgent,
+});
+
+// The ride-replay job reads the dead-letter subscription as the default compute service account.
+new gcp.pubsub.SubscriptionIAMMember("ride-completed-dead-letter-replayer", {
+  subscription: rideCompletedDeadLetterSubscription.name,
+  role: "roles/pubsub.subscriber",
+  member: pulumi.interpolate`serviceAccount:${project.number}-compute@developer.gserviceaccount.com`,
+});
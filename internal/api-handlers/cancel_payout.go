@@ -0,0 +1,94 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
+	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
+)
+
+func (service *PaymentsServiceServer) CancelPayout(ctx context.Context, req *connect.Request[pb.CancelPayoutRequest]) (*connect.Response[pb.CancelPayoutResponse], error) {
+	log := service.logger.WithField("method", "CancelPayout")
+	log.WithField("request", req.Msg).Debug("Received CancelPayout request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting payout id from request message")
+	payoutId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Payout id: %s", payoutId)
+
+	log.Info("Fetching payout")
+	payout, err := service.payoutRepository.GetPayout(ctx, log, userId, payoutId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch payout")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("payout", err))
+	}
+
+	if payout == nil {
+		log.Error("Payout not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("payout"))
+	}
+
+	// A payout whose cancellation is confirmed is returned by CancelPayout again, and refunded again below in case an
+	// earlier request stopped before the credit. The refund is only credited once.
+	log.Info("Cancelling payout")
+	payout, err = service.payoutRepository.CancelPayout(ctx, log, payout)
+
+	if errors.Is(err, payoutrepository.ErrPayoutNotPending) || errors.Is(err, payoutrepository.ErrCancelInProgress) {
+		log.WithError(err).Info("Payout can not be cancelled")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if errors.Is(err, gateway.ErrRejected) {
+		log.WithError(err).Info("Payment gateway refused cancellation")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to cancel payout")
+		return nil, connect.NewError(connect.CodeInternal, failedToUpdateError("payout", err))
+	}
+
+	log.Info("Refunding payout")
+	if err := service.refundPayout(ctx, log, payout, "Payout cancelled"); err != nil {
+		log.WithError(err).Error("Failed to refund payout")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.CancelPayoutResponse{
+		Payout: payout,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned CancelPayout response")
+	log.Info("Returning CancelPayout response")
+	return res, nil
+}
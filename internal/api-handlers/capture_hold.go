@@ -0,0 +1,141 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/protobuf/proto"
+)
+
+func (service *PaymentsServiceServer) CaptureHold(ctx context.Context, req *connect.Request[pb.CaptureHoldRequest]) (*connect.Response[pb.CaptureHoldResponse], error) {
+	return withIdempotency(ctx, service, "CaptureHold", req, service.captureHold)
+}
+
+func (service *PaymentsServiceServer) captureHold(ctx context.Context, req *connect.Request[pb.CaptureHoldRequest]) (*connect.Response[pb.CaptureHoldResponse], error) {
+	log := service.logger.WithField("method", "CaptureHold")
+	log.WithField("request", req.Msg).Debug("Received CaptureHold request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting hold id from request message")
+	holdId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Hold id: %s", holdId)
+
+	log.Info("Fetching hold")
+	hold, err := service.walletRepository.GetHold(ctx, log, userId, holdId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch hold")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("hold", err))
+	}
+
+	if hold == nil {
+		log.Error("Hold not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("hold"))
+	}
+
+	var capturedAmount int64
+
+	for _, split := range req.Msg.Splits {
+		capturedAmount += split.Amount
+	}
+
+	if capturedAmount > hold.Amount {
+		log.Info("Captured amount exceeds held amount")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(errors.New("sum of splits must not exceed the held amount")))
+	}
+
+	details := req.Msg.Details
+
+	if details.Reference == "" {
+		details.Reference = hold.Name
+	}
+
+	log.Info("Generating transaction entries")
+	entries := make(walletrepository.Entries, 0, len(req.Msg.Splits)+1)
+	entries = append(entries, &walletrepository.Entry{
+		UserId: userId,
+		Transaction: &pb.Transaction{
+			Type:         pb.Transaction_TYPE_DEBIT,
+			Amount:       capturedAmount,
+			CurrencyCode: hold.CurrencyCode,
+			Details:      details,
+		},
+	})
+
+	for _, split := range req.Msg.Splits {
+		entries = append(entries, &walletrepository.Entry{
+			UserId: strings.Split(split.Destination, "/")[1],
+			Transaction: &pb.Transaction{
+				Type:         pb.Transaction_TYPE_CREDIT,
+				Amount:       split.Amount,
+				CurrencyCode: hold.CurrencyCode,
+				Details:      proto.Clone(details).(*pb.Transaction_Details),
+			},
+		})
+	}
+
+	log.Info("Capturing hold")
+	hold, batchId, err := service.walletRepository.CaptureHold(ctx, log, hold, &entries)
+
+	if errors.Is(err, walletrepository.ErrHoldNotActive) {
+		log.WithError(err).Info("Hold is not active")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to capture hold")
+		return nil, createTransactionsError(err)
+	}
+
+	log.Debugf("Batch id: %s", *batchId)
+
+	transactions := make([]*pb.Transaction, 0, len(entries))
+
+	for _, entry := range entries {
+		transactions = append(transactions, entry.Transaction)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.CaptureHoldResponse{
+		Hold:         hold,
+		Transactions: transactions,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned CaptureHold response")
+	log.Info("Returning CaptureHold response")
+	return res, nil
+}
@@ -0,0 +1,66 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) CloseWallet(ctx context.Context, req *connect.Request[pb.CloseWalletRequest]) (*connect.Response[pb.CloseWalletResponse], error) {
+	return withIdempotency(ctx, service, "CloseWallet", req, service.closeWallet)
+}
+
+func (service *PaymentsServiceServer) closeWallet(ctx context.Context, req *connect.Request[pb.CloseWalletRequest]) (*connect.Response[pb.CloseWalletResponse], error) {
+	log := service.logger.WithField("method", "CloseWallet")
+	log.WithField("request", req.Msg).Debug("Received CloseWallet request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Closing wallet")
+	wallet, err := service.walletRepository.SetWalletState(ctx, log, userId, pb.Wallet_STATE_CLOSED, []pb.Wallet_State{pb.Wallet_STATE_ACTIVE, pb.Wallet_STATE_FROZEN, pb.Wallet_STATE_SUSPENDED}, req.Msg.Reason)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to close wallet")
+		return nil, setWalletStateError(err)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.CloseWalletResponse{
+		Wallet: wallet,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned CloseWallet response")
+	log.Info("Returning CloseWallet response")
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/aidarkhanov/nanoid"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (service *PaymentsServiceServer) CreateHold(ctx context.Context, req *connect.Request[pb.CreateHoldRequest]) (*connect.Response[pb.CreateHoldResponse], error) {
+	return withIdempotency(ctx, service, "CreateHold", req, service.createHold)
+}
+
+func (service *PaymentsServiceServer) createHold(ctx context.Context, req *connect.Request[pb.CreateHoldRequest]) (*connect.Response[pb.CreateHoldResponse], error) {
+	log := service.logger.WithField("method", "CreateHold")
+	log.WithField("request", req.Msg).Debug("Received CreateHold request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Parent, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	hold := req.Msg.Hold
+
+	log.Info("Checking if currency is supported")
+	if !service.isSupportedCurrency(hold.CurrencyCode) {
+		log.Infof("Unsupported currency: %s", hold.CurrencyCode)
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(hold.CurrencyCode)))
+	}
+
+	hold.Name = fmt.Sprintf("%s/holds/%s", req.Msg.Parent, nanoid.New())
+
+	if hold.ExpireTime == nil {
+		hold.ExpireTime = timestamppb.New(time.Now().Add(service.config.HoldTTL))
+	} else if !hold.ExpireTime.AsTime().After(time.Now()) {
+		log.Info("Expire time is in the past")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(errors.New("expire_time must be in the future")))
+	}
+
+	log.Info("Creating hold")
+	hold, err = service.walletRepository.CreateHold(ctx, log, hold)
+
+	if errors.Is(err, walletrepository.ErrInsufficientFunds) {
+		log.WithError(err).Info("Insufficient funds")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to create hold")
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("hold", err))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.CreateHoldResponse{
+		Hold: hold,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned CreateHold response")
+	log.Info("Returning CreateHold response")
+	return res, nil
+}
@@ -3,15 +3,23 @@ package apihandlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/aidarkhanov/nanoid"
 	"github.com/bufbuild/protovalidate-go"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
 	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
 )
 
 func (service *PaymentsServiceServer) CreatePayout(ctx context.Context, req *connect.Request[pb.CreatePayoutRequest]) (*connect.Response[pb.CreatePayoutResponse], error) {
+	return withIdempotency(ctx, service, "CreatePayout", req, service.createPayout)
+}
+
+func (service *PaymentsServiceServer) createPayout(ctx context.Context, req *connect.Request[pb.CreatePayoutRequest]) (*connect.Response[pb.CreatePayoutResponse], error) {
 	log := service.logger.WithField("method", "CreatePayout")
 	log.WithField("request", req.Msg).Debug("Received CreatePayout request")
 
@@ -46,10 +54,11 @@ func (service *PaymentsServiceServer) CreatePayout(ctx context.Context, req *con
 		return nil, connect.NewError(connect.CodeFailedPrecondition, notFoundError("wallet"))
 	}
 
-	log.Info("Checking if payout amount is greater than wallet balance")
-	// TODO: change payout amount type to int64
-	if int64(req.Msg.Payout.Amount) > wallet.Balance {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount must be smaller than wallet balance"))
+	// The debit enforces the state of the wallet too, this only fails early before a payout account is looked up.
+	log.Info("Checking if wallet can be debited")
+	if wallet.State != pb.Wallet_STATE_ACTIVE {
+		log.Infof("Wallet is %s", wallet.State)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, walletrepository.ErrWalletNotActive)
 	}
 
 	log.Info("Fetching payout account")
@@ -65,34 +74,109 @@ func (service *PaymentsServiceServer) CreatePayout(ctx context.Context, req *con
 		return nil, connect.NewError(connect.CodeFailedPrecondition, notFoundError("payout account"))
 	}
 
-	log.Info("Creating payout")
-	payout, err := service.payoutRepository.CreatePayout(ctx, log, payoutAccount, req.Msg.Payout)
+	if destinationUpdateTime := payoutAccount.DestinationUpdateTime; destinationUpdateTime != nil {
+		availableTime := destinationUpdateTime.AsTime().Add(service.config.PayoutCoolingPeriod)
+
+		if time.Now().Before(availableTime) {
+			log.Infof("Payout account destination changed recently, payouts available at %s", availableTime)
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("payouts to the new destination are available after %s", availableTime.Format(time.RFC3339)))
+		}
+	}
+
+	log.Info("Selecting payout mode")
+	mode, err := payoutMode(payoutAccount, req.Msg.Payout)
 
 	if err != nil {
-		log.WithError(err).Error("Failed to create payout")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("payout", err))
+		log.WithError(err).Info("Invalid payout mode")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	req.Msg.Payout.Mode = mode
+	log.Debugf("Payout mode: %s", mode)
+
+	payoutId := nanoid.New()
+	req.Msg.Payout.Name = fmt.Sprintf("%s/payouts/%s", req.Msg.Parent, payoutId)
+
+	if req.Msg.Payout.CurrencyCode == "" {
+		req.Msg.Payout.CurrencyCode = payoutAccount.CurrencyCode
+	}
+
+	log.Info("Checking if currency is supported")
+	if !service.isSupportedCurrency(req.Msg.Payout.CurrencyCode) {
+		log.Infof("Unsupported currency: %s", req.Msg.Payout.CurrencyCode)
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(req.Msg.Payout.CurrencyCode)))
 	}
 
+	if req.Msg.Payout.CurrencyCode != payoutAccount.CurrencyCode {
+		log.Infof("Payout currency %s does not match payout account currency %s", req.Msg.Payout.CurrencyCode, payoutAccount.CurrencyCode)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("payout account can only receive %s", payoutAccount.CurrencyCode))
+	}
+
+	// The wallet is debited before the payout is stored, so that the balance floor is enforced atomically.
+	// Nothing is sent before the payout is stored, so a payout that fails to be stored can be refunded.
 	log.Info("Forming transactions")
-	entries := make(walletrepository.Entries, 1)
-	entry := walletrepository.Entry{
-		UserId: userId,
-		Transaction: &pb.Transaction{
-			Type:   pb.Transaction_TYPE_DEBIT,
-			Amount: req.Msg.Payout.Amount,
+	entries := walletrepository.Entries{
+		{
+			UserId:        userId,
+			TransactionId: "payout-" + payoutId,
+			Transaction: &pb.Transaction{
+				Type:         pb.Transaction_TYPE_DEBIT,
+				Amount:       req.Msg.Payout.Amount,
+				CurrencyCode: req.Msg.Payout.CurrencyCode,
+				Details: &pb.Transaction_Details{
+					DisplayName: "Payout",
+					Reference:   req.Msg.Payout.Name,
+				},
+			},
 		},
 	}
 
-	entries = append(entries, &entry)
-
 	log.Info("Creating transactions")
 	batchId, err := service.walletRepository.CreateTransactions(ctx, log, &entries)
 
+	if err != nil {
+		log.WithError(err).Error("Failed to create transactions")
+		return nil, createTransactionsError(err)
+	}
+
 	log.Debugf("Batch id: %s", *batchId)
 
+	log.Info("Creating payout")
+	payout, err := service.payoutRepository.CreatePayout(ctx, log, payoutAccount, req.Msg.Payout, entries[0].Transaction.Name)
+
 	if err != nil {
-		log.WithError(err).Error("Failed to create transactions")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+		log.WithError(err).Error("Failed to create payout")
+
+		if err := service.refundPayout(ctx, log, req.Msg.Payout, "Payout refund"); err != nil {
+			log.WithError(err).Error("Failed to refund payout")
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("payout", err))
+	}
+
+	log.Info("Sending payout")
+	sent, err := service.payoutRepository.SendPayout(ctx, log, payoutAccount, payout)
+
+	if errors.Is(err, gateway.ErrRejected) {
+		log.WithError(err).Error("Payment gateway rejected payout")
+
+		payout.Status = pb.Payout_STATUS_FAILED
+		payout.Metadata = &pb.Payout_FailureReason{FailureReason: err.Error()}
+
+		if _, err := service.payoutRepository.UpdatePayout(ctx, log, payout, []pb.Payout_Status{pb.Payout_STATUS_PENDING}); err != nil {
+			log.WithError(err).Error("Failed to update payout")
+		} else if err := service.refundPayout(ctx, log, payout, "Payout refund"); err != nil {
+			log.WithError(err).Error("Failed to refund payout")
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("payout", err))
+	}
+
+	if err != nil {
+		// The payout may have been sent, so it stays pending and is completed or refunded by the webhook of the gateway.
+		log.WithError(err).Warn("Failed to send payout, leaving it pending")
+	} else {
+		payout = sent
 	}
 
 	res := connect.NewResponse(&pb.CreatePayoutResponse{
@@ -109,3 +193,59 @@ func (service *PaymentsServiceServer) CreatePayout(ctx context.Context, req *con
 	log.Info("Returning CreatePayout response")
 	return res, nil
 }
+
+const (
+	// impsLimit is the largest amount, in paise, that can be sent over IMPS.
+	impsLimit = 5_00_000_00
+
+	// rtgsMinimum is the smallest amount, in paise, that can be sent over RTGS.
+	rtgsMinimum = 2_00_000_00
+)
+
+// payoutMode checks the requested mode of a payout against the destination of the payout account and the amount,
+// or selects one when unspecified. Payout accounts without a destination only support payout links.
+func payoutMode(payoutAccount *pb.PayoutAccount, payout *pb.Payout) (pb.Payout_Mode, error) {
+	mode := payout.Mode
+
+	if mode == pb.Payout_MODE_LINK {
+		return mode, errors.New("payout links can not be requested")
+	}
+
+	switch payoutAccount.Destination.(type) {
+	case nil:
+		if payoutAccount.RazorpayFundAccountId != "" || mode != pb.Payout_MODE_UNSPECIFIED {
+			return mode, errors.New("payout account has no destination")
+		}
+
+		return pb.Payout_MODE_LINK, nil
+
+	case *pb.PayoutAccount_UpiId:
+		if mode != pb.Payout_MODE_UNSPECIFIED && mode != pb.Payout_MODE_UPI {
+			return mode, errors.New("only UPI payouts can be sent to a UPI id")
+		}
+
+		return pb.Payout_MODE_UPI, nil
+
+	default:
+		switch mode {
+		case pb.Payout_MODE_UNSPECIFIED:
+			if payout.Amount > impsLimit {
+				return pb.Payout_MODE_NEFT, nil
+			}
+
+			return pb.Payout_MODE_IMPS, nil
+		case pb.Payout_MODE_UPI:
+			return mode, errors.New("UPI payouts can not be sent to a bank account")
+		case pb.Payout_MODE_IMPS:
+			if payout.Amount > impsLimit {
+				return mode, errors.New("amount exceeds the IMPS limit")
+			}
+		case pb.Payout_MODE_RTGS:
+			if payout.Amount < rtgsMinimum {
+				return mode, errors.New("amount is below the RTGS minimum")
+			}
+		}
+
+		return mode, nil
+	}
+}
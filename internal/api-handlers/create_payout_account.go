@@ -2,14 +2,20 @@ package apihandlers
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/bufbuild/protovalidate-go"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
 )
 
 func (service *PaymentsServiceServer) CreatePayoutAccount(ctx context.Context, req *connect.Request[pb.CreatePayoutAccountRequest]) (*connect.Response[pb.CreatePayoutAccountResponse], error) {
+	return withIdempotency(ctx, service, "CreatePayoutAccount", req, service.createPayoutAccount)
+}
+
+func (service *PaymentsServiceServer) createPayoutAccount(ctx context.Context, req *connect.Request[pb.CreatePayoutAccountRequest]) (*connect.Response[pb.CreatePayoutAccountResponse], error) {
 	log := service.logger.WithField("method", "CreatePayoutAccount")
 	log.WithField("request", req.Msg).Debug("Received CreatePayoutAccount request")
 
@@ -27,6 +33,16 @@ func (service *PaymentsServiceServer) CreatePayoutAccount(ctx context.Context, r
 		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
 	}
 
+	if req.Msg.PayoutAccount.CurrencyCode == "" {
+		req.Msg.PayoutAccount.CurrencyCode = money.DefaultCurrencyCode
+	}
+
+	log.Info("Checking if currency is supported")
+	if !service.isSupportedCurrency(req.Msg.PayoutAccount.CurrencyCode) {
+		log.Infof("Unsupported currency: %s", req.Msg.PayoutAccount.CurrencyCode)
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(req.Msg.PayoutAccount.CurrencyCode)))
+	}
+
 	log.Info("Extracting user id from request message")
 	userId := strings.Split(req.Msg.PayoutAccount.Name, "/")[1]
 	log.Debugf("User id: %s", userId)
@@ -44,6 +60,11 @@ func (service *PaymentsServiceServer) CreatePayoutAccount(ctx context.Context, r
 		return nil, connect.NewError(connect.CodeFailedPrecondition, notFoundError("wallet"))
 	}
 
+	if req.Msg.PayoutAccount.CurrencyCode != wallet.CurrencyCode {
+		log.Infof("Payout account currency %s does not match wallet currency %s", req.Msg.PayoutAccount.CurrencyCode, wallet.CurrencyCode)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("wallet can only pay out %s", wallet.CurrencyCode))
+	}
+
 	log.Info("Fetching user")
 	user, err := service.authRepository.GetUser(ctx, log, userId)
 
@@ -3,18 +3,23 @@ package apihandlers
 import (
 	"context"
 	"errors"
-	"math"
 	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/aidarkhanov/nanoid"
 	"github.com/bufbuild/protovalidate-go"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
+	"github.com/ride-app/payments-service/internal/money"
 	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (service *PaymentsServiceServer) CreateRecharge(ctx context.Context, req *connect.Request[pb.CreateRechargeRequest]) (*connect.Response[pb.CreateRechargeResponse], error) {
+	return withIdempotency(ctx, service, "CreateRecharge", req, service.createRecharge)
+}
+
+func (service *PaymentsServiceServer) createRecharge(ctx context.Context, req *connect.Request[pb.CreateRechargeRequest]) (*connect.Response[pb.CreateRechargeResponse], error) {
 	log := service.logger.WithField("method", "CreateRecharge")
 	log.WithField("request", req.Msg).Debug("Received CreateRecharge request")
 
@@ -37,7 +42,7 @@ func (service *PaymentsServiceServer) CreateRecharge(ctx context.Context, req *c
 	log.Debugf("User id: %s", userId)
 
 	log.Info("Checking if user id in request message matches with user id in request header")
-	if userId != req.Header().Get("user_id") {
+	if userId != req.Header().Get("uid") {
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("permission denied"))
 	}
 
@@ -54,8 +59,32 @@ func (service *PaymentsServiceServer) CreateRecharge(ctx context.Context, req *c
 		return nil, connect.NewError(connect.CodeFailedPrecondition, notFoundError("wallet"))
 	}
 
+	log.Info("Checking if wallet can be credited")
+	if wallet.State == pb.Wallet_STATE_SUSPENDED || wallet.State == pb.Wallet_STATE_CLOSED {
+		log.Infof("Wallet is %s", wallet.State)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, walletrepository.ErrWalletNotActive)
+	}
+
+	if req.Msg.Recharge.CurrencyCode == "" {
+		req.Msg.Recharge.CurrencyCode = money.DefaultCurrencyCode
+	}
+
+	log.Info("Checking if currency is supported")
+	if !service.isSupportedCurrency(req.Msg.Recharge.CurrencyCode) {
+		log.Infof("Unsupported currency: %s", req.Msg.Recharge.CurrencyCode)
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(req.Msg.Recharge.CurrencyCode)))
+	}
+
+	var balance int64
+
+	for _, b := range wallet.Balances {
+		if b.CurrencyCode == req.Msg.Recharge.CurrencyCode {
+			balance = b.Balance
+		}
+	}
+
 	log.Info("Checking if recharge amount is greater than balance due")
-	if wallet.Balance < 0 && req.Msg.Recharge.Amount < int32(math.Abs(float64(wallet.Balance))) {
+	if balance < 0 && req.Msg.Recharge.Amount < -balance {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount must be greater than balance due"))
 	}
 
@@ -63,59 +92,43 @@ func (service *PaymentsServiceServer) CreateRecharge(ctx context.Context, req *c
 	rechargeId := nanoid.New()
 	req.Msg.Recharge.Name = req.Msg.Parent + "/recharges/" + rechargeId
 
-	log.Info("Creating razorpay order")
-	rzp_response, err := service.razorpay.Order.Create(map[string]interface{}{
-		"amount":   req.Msg.Recharge.Amount,
-		"currency": "INR",
-		"reciept":  "recharge/" + rechargeId,
-	}, nil)
+	log.Infof("Creating %s order", service.paymentGateway.Name())
+	order, err := service.paymentGateway.CreateOrder(ctx, &gateway.OrderRequest{
+		Amount:       req.Msg.Recharge.Amount,
+		CurrencyCode: req.Msg.Recharge.CurrencyCode,
+		Receipt:      "recharge/" + rechargeId,
+		Reference:    req.Msg.Recharge.Name,
+	})
 
 	if err != nil {
-		log.WithError(err).Error("Failed to create razorpay order")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("razorpay order", err))
+		log.WithError(err).Error("Failed to create payment gateway order")
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("payment gateway order", err))
 	}
 
 	log.Info("Creating recharge")
-	createTime, err := service.rechargeRepository.CreateRecharge(ctx, log, req.Msg.Recharge, &rzp_response)
+	createTime, err := service.rechargeRepository.CreateRecharge(ctx, log, req.Msg.Recharge, order)
 
 	if err != nil {
 		log.WithError(err).Error("Failed to create recharge")
 		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("recharge", err))
 	}
 
-	log.Info("Creating transaction entries")
-	entries := make(walletrepository.Entries, 1)
-	entry := walletrepository.Entry{
-		UserId: userId,
-		Transaction: &pb.Transaction{
-			Type:   pb.Transaction_TYPE_CREDIT,
-			Amount: req.Msg.Recharge.Amount,
-		},
-	}
-
-	entries = append(entries, &entry)
-
-	log.Info("Creating transactions for Recharge")
-	batchId, err := service.walletRepository.CreateTransactions(ctx, log, &entries)
+	log.Info("Updating Recharge details")
+	req.Msg.Recharge.CreateTime = timestamppb.New(*createTime)
+	req.Msg.Recharge.Status = pb.Recharge_STATUS_PENDING
 
-	if err != nil {
-		log.WithError(err).Error("Failed to create transactions")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+	checkoutInfo := map[string]string{
+		"payment_gateway": order.Gateway,
 	}
 
-	log.Debugf("Batch id: %s", batchId)
-
-	log.Info("Updating Recharge details")
-	req.Msg.Recharge.CreateTime = timestamppb.New(*createTime)
-	req.Msg.Recharge.Status = pb.Recharge_STATUS_SUCCESS
+	for key, value := range order.CheckoutInfo {
+		checkoutInfo[key] = value
+	}
 
 	log.Info("Creating response")
 	res := connect.NewResponse(&pb.CreateRechargeResponse{
-		Recharge: req.Msg.Recharge,
-		CheckoutInfo: map[string]string{
-			"payment_gateway": "razorpay",
-			"rzp_order_id":    rzp_response["id"].(string),
-		},
+		Recharge:     req.Msg.Recharge,
+		CheckoutInfo: checkoutInfo,
 	})
 
 	log.Info("Validating response message")
@@ -11,6 +11,10 @@ import (
 )
 
 func (service *PaymentsServiceServer) CreateTransactions(ctx context.Context, req *connect.Request[pb.CreateTransactionsRequest]) (*connect.Response[pb.CreateTransactionsResponse], error) {
+	return withIdempotency(ctx, service, "CreateTransactions", req, service.createTransactions)
+}
+
+func (service *PaymentsServiceServer) createTransactions(ctx context.Context, req *connect.Request[pb.CreateTransactionsRequest]) (*connect.Response[pb.CreateTransactionsResponse], error) {
 	log := service.logger.WithField("method", "CreateTransactions")
 	log.WithField("request", req.Msg).Debug("Received CreateTransactions request")
 
@@ -21,6 +25,13 @@ func (service *PaymentsServiceServer) CreateTransactions(ctx context.Context, re
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	log.Info("Clearing output only fields")
+	for _, entry := range req.Msg.Entries {
+		if entry.Transaction != nil {
+			clearOutputOnlyFields(entry.Transaction)
+		}
+	}
+
 	log.Info("Validating request")
 	if err := validator.Validate(req.Msg); err != nil {
 		log.WithError(err).Info("Invalid request")
@@ -28,7 +39,17 @@ func (service *PaymentsServiceServer) CreateTransactions(ctx context.Context, re
 		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
 	}
 
-	log.Info("Generating batch id")
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Validating ledger entries")
+	if err := service.validateLedgerEntries(req.Msg.Entries, req.Msg.Fx); err != nil {
+		log.WithError(err).Info("Unbalanced ledger entries")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
 
 	var entries walletrepository.Entries = make(walletrepository.Entries, 0, len(req.Msg.Entries))
 
@@ -64,7 +85,7 @@ func (service *PaymentsServiceServer) CreateTransactions(ctx context.Context, re
 
 	if err != nil {
 		log.WithError(err).Error("Failed to create transactions")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+		return nil, createTransactionsError(err)
 	}
 
 	transactionsInResponse := make([]*pb.Transaction, 0)
@@ -0,0 +1,239 @@
+package apihandlers_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+func entry(userId string, transactionType pb.Transaction_Type, amount int64, currencyCode string) *pb.CreateTransactionsRequest_Entry {
+	return &pb.CreateTransactionsRequest_Entry{
+		Parent: "users/" + userId + "/wallet",
+		Transaction: &pb.Transaction{
+			Type:         transactionType,
+			Amount:       amount,
+			CurrencyCode: currencyCode,
+			Details:      &pb.Transaction_Details{DisplayName: "Transfer"},
+		},
+	}
+}
+
+var _ = Describe("CreateTransactions", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		written walletrepository.Entries
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+		written = nil
+
+		m.wallet.EXPECT().GetWallet(gomock.Any(), gomock.Any(), gomock.Any()).Return(&pb.Wallet{}, nil).AnyTimes()
+	})
+
+	expectWrite := func(err error) {
+		batchId := "batch1"
+
+		m.wallet.EXPECT().CreateTransactions(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ logger.Logger, entries *walletrepository.Entries) (*string, error) {
+				written = *entries
+
+				if err != nil {
+					return nil, err
+				}
+
+				return &batchId, nil
+			},
+		)
+	}
+
+	It("creates balanced transactions", func() {
+		expectWrite(nil)
+
+		res, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, ""),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 60, ""),
+				entry("user3", pb.Transaction_TYPE_CREDIT, 40, ""),
+			},
+		}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.BatchId).To(Equal("batch1"))
+		Expect(res.Msg.Transactions).To(HaveLen(3))
+		Expect(written).To(HaveLen(3))
+		Expect(written[0].UserId).To(Equal("user1"))
+		Expect(written[0].Transaction.CurrencyCode).To(Equal("INR"))
+	})
+
+	It("rejects callers that are not trusted", func() {
+		_, err := service.CreateTransactions(context.Background(), connect.NewRequest(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, ""),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, ""),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("rejects unbalanced entries", func() {
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, ""),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 99, ""),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+
+	It("rejects entries in unsupported currencies", func() {
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, "EUR"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "EUR"),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+
+	It("rejects batches in two currencies without an fx leg", func() {
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 8300, "INR"),
+				entry("fx-desk", pb.Transaction_TYPE_CREDIT, 8300, "INR"),
+				entry("fx-desk", pb.Transaction_TYPE_DEBIT, 100, "USD"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "USD"),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+
+	It("rejects fx legs in single currency batches", func() {
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, "INR"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "INR"),
+			},
+			Fx: &pb.FxConversion{SourceCurrencyCode: "INR", SourceAmount: 100, TargetCurrencyCode: "USD", TargetAmount: 1},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+
+	It("rejects batches in more than two currencies", func() {
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, "INR"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "INR"),
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, "USD"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "USD"),
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, "JPY"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "JPY"),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+
+	DescribeTable("rejects fx legs whose amounts do not match the batch",
+		func(fx *pb.FxConversion) {
+			_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+				Entries: []*pb.CreateTransactionsRequest_Entry{
+					entry("user1", pb.Transaction_TYPE_DEBIT, 8300, "INR"),
+					entry("fx-desk", pb.Transaction_TYPE_CREDIT, 8300, "INR"),
+					entry("fx-desk", pb.Transaction_TYPE_DEBIT, 100, "USD"),
+					entry("user2", pb.Transaction_TYPE_CREDIT, 100, "USD"),
+				},
+				Fx: fx,
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		},
+		Entry("source amount", &pb.FxConversion{SourceCurrencyCode: "INR", SourceAmount: 9000, TargetCurrencyCode: "USD", TargetAmount: 100}),
+		Entry("target amount", &pb.FxConversion{SourceCurrencyCode: "INR", SourceAmount: 8300, TargetCurrencyCode: "USD", TargetAmount: 1}),
+	)
+
+	It("records the fx leg on every transaction of a batch in two currencies", func() {
+		expectWrite(nil)
+
+		fx := &pb.FxConversion{SourceCurrencyCode: "INR", SourceAmount: 8300, TargetCurrencyCode: "USD", TargetAmount: 100}
+
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 8300, "INR"),
+				entry("fx-desk", pb.Transaction_TYPE_CREDIT, 8300, "INR"),
+				entry("fx-desk", pb.Transaction_TYPE_DEBIT, 100, "USD"),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, "USD"),
+			},
+			Fx: fx,
+		}))
+
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, entry := range written {
+			Expect(entry.Transaction.Fx).To(Equal(fx))
+		}
+	})
+
+	It("clears output only fields set by the client", func() {
+		expectWrite(nil)
+
+		debit := entry("user1", pb.Transaction_TYPE_DEBIT, 100, "")
+		reversalOf := "users/user2/wallet/transactions/transaction1"
+		balance := int64(1000000)
+		debit.Transaction.ReversalOf = &reversalOf
+		debit.Transaction.ReversedAmount = 100
+		debit.Transaction.BalanceAfter = &balance
+
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				debit,
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, ""),
+			},
+		}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(written[0].Transaction.ReversalOf).To(BeNil())
+		Expect(written[0].Transaction.ReversedAmount).To(BeZero())
+		Expect(written[0].Transaction.BalanceAfter).To(BeNil())
+	})
+
+	It("rejects debits beyond the balance floor", func() {
+		expectWrite(walletrepository.ErrInsufficientFunds)
+
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, ""),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, ""),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+	})
+
+	It("rejects entries of wallets that do not exist", func() {
+		service, m = newService()
+		m.wallet.EXPECT().GetWallet(gomock.Any(), gomock.Any(), "user1").Return(nil, nil)
+
+		_, err := service.CreateTransactions(context.Background(), trusted(&pb.CreateTransactionsRequest{
+			Entries: []*pb.CreateTransactionsRequest_Entry{
+				entry("user1", pb.Transaction_TYPE_DEBIT, 100, ""),
+				entry("user2", pb.Transaction_TYPE_CREDIT, 100, ""),
+			},
+		}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+	})
+})
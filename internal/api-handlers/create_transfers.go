@@ -12,6 +12,10 @@ import (
 )
 
 func (service *PaymentsServiceServer) CreateTransfers(ctx context.Context, req *connect.Request[pb.CreateTransfersRequest]) (*connect.Response[pb.CreateTransfersResponse], error) {
+	return withIdempotency(ctx, service, "CreateTransfers", req, service.createTransfers)
+}
+
+func (service *PaymentsServiceServer) createTransfers(ctx context.Context, req *connect.Request[pb.CreateTransfersRequest]) (*connect.Response[pb.CreateTransfersResponse], error) {
 	log := service.logger.WithField("method", "CreateTransfers")
 	log.WithField("request", req.Msg).Debug("Received CreateTransfers request")
 
@@ -29,6 +33,14 @@ func (service *PaymentsServiceServer) CreateTransfers(ctx context.Context, req *
 		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
 	}
 
+	log.Info("Checking if currencies are supported")
+	for _, transfer := range req.Msg.Transfers {
+		if !service.isSupportedCurrency(transfer.CurrencyCode) {
+			log.Infof("Unsupported currency: %s", transfer.CurrencyCode)
+			return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(transfer.CurrencyCode)))
+		}
+	}
+
 	log.Info("Creating transfers")
 	transfers, err := service.transferRepository.CreateTransfers(ctx, log, &req.Msg.Transfers)
 	if err != nil {
@@ -50,10 +62,26 @@ func (service *PaymentsServiceServer) CreateTransfers(ctx context.Context, req *
 
 	if err != nil {
 		log.WithError(err).Error("Failed to create transactions")
-		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+
+		log.Info("Marking transfers as failed")
+		setTransfersStatus(transfers, pb.Transfer_STATUS_FAILED)
+
+		if _, err := service.transferRepository.UpdateTransfers(ctx, log, transfers); err != nil {
+			log.WithError(err).Error("Failed to update transfers")
+		}
+
+		return nil, createTransactionsError(err)
 	}
 
-	log.Debugf("Batch id: %s", batchId)
+	log.Debugf("Batch id: %s", *batchId)
+
+	log.Info("Marking transfers as successful")
+	setTransfersStatus(transfers, pb.Transfer_STATUS_SUCCESS)
+
+	if _, err := service.transferRepository.UpdateTransfers(ctx, log, transfers); err != nil {
+		log.WithError(err).Error("Failed to update transfers")
+		return nil, connect.NewError(connect.CodeInternal, failedToUpdateError("transfers", err))
+	}
 
 	log.Info("Creating response message")
 	res := connect.NewResponse(&pb.CreateTransfersResponse{
@@ -74,14 +102,15 @@ func (service *PaymentsServiceServer) CreateTransfers(ctx context.Context, req *
 
 func generateTransactionEntries(log logger.Logger, transfer *pb.Transfer) *walletrepository.Entries {
 	log.Info("Generating transaction entries for transfer")
-	entries := make(walletrepository.Entries, 2)
+	entries := make(walletrepository.Entries, 0, 2)
 
 	log.Info("Creating transaction entry for source wallet")
 	sourceTransactionEntry := walletrepository.Entry{
 		UserId: strings.Split(transfer.Source, "/")[1],
 		Transaction: &pb.Transaction{
-			Amount: transfer.Amount,
-			Type:   pb.Transaction_TYPE_DEBIT,
+			Amount:       transfer.Amount,
+			CurrencyCode: transfer.CurrencyCode,
+			Type:         pb.Transaction_TYPE_DEBIT,
 			Details: &pb.Transaction_Details{
 				DisplayName: transfer.Details.DisplayName,
 				Description: transfer.Details.Description,
@@ -96,8 +125,9 @@ func generateTransactionEntries(log logger.Logger, transfer *pb.Transfer) *walle
 	destinationTransactionEntry := walletrepository.Entry{
 		UserId: strings.Split(transfer.Destination, "/")[1],
 		Transaction: &pb.Transaction{
-			Amount: transfer.Amount,
-			Type:   pb.Transaction_TYPE_CREDIT,
+			Amount:       transfer.Amount,
+			CurrencyCode: transfer.CurrencyCode,
+			Type:         pb.Transaction_TYPE_CREDIT,
 			Details: &pb.Transaction_Details{
 				DisplayName: transfer.Details.DisplayName,
 				Description: transfer.Details.Description,
@@ -110,3 +140,9 @@ func generateTransactionEntries(log logger.Logger, transfer *pb.Transfer) *walle
 
 	return &entries
 }
+
+func setTransfersStatus(transfers *[]*pb.Transfer, status pb.Transfer_Status) {
+	for _, transfer := range *transfers {
+		transfer.Status = status
+	}
+}
@@ -0,0 +1,72 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) CreateWallet(ctx context.Context, req *connect.Request[pb.CreateWalletRequest]) (*connect.Response[pb.CreateWalletResponse], error) {
+	return withIdempotency(ctx, service, "CreateWallet", req, service.createWallet)
+}
+
+func (service *PaymentsServiceServer) createWallet(ctx context.Context, req *connect.Request[pb.CreateWalletRequest]) (*connect.Response[pb.CreateWalletResponse], error) {
+	log := service.logger.WithField("method", "CreateWallet")
+	log.WithField("request", req.Msg).Debug("Received CreateWallet request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Parent, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Checking if caller owns the wallet or is trusted")
+	if userId != req.Header().Get("uid") && !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller can not create the wallet")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Checking if currency is supported")
+	if !service.isSupportedCurrency(req.Msg.CurrencyCode) {
+		log.Infof("Unsupported currency: %s", req.Msg.CurrencyCode)
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(unsupportedCurrencyError(req.Msg.CurrencyCode)))
+	}
+
+	log.Info("Creating wallet")
+	wallet, err := service.walletRepository.CreateWallet(ctx, log, userId, req.Msg.CurrencyCode)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to create wallet")
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("wallet", err))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.CreateWalletResponse{
+		Wallet: wallet,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned CreateWallet response")
+	log.Info("Returning CreateWallet response")
+	return res, nil
+}
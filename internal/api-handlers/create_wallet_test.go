@@ -0,0 +1,53 @@
+package apihandlers_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+)
+
+var _ = Describe("CreateWallet", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		req     *connect.Request[pb.CreateWalletRequest]
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+		req = connect.NewRequest(&pb.CreateWalletRequest{Parent: "users/user1"})
+	})
+
+	It("creates the wallet of the authenticated user", func() {
+		req.Header().Set("uid", "user1")
+		m.wallet.EXPECT().CreateWallet(gomock.Any(), gomock.Any(), "user1", "").Return(&pb.Wallet{Name: "users/user1/wallet"}, nil)
+
+		res, err := service.CreateWallet(context.Background(), req)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.Wallet.Name).To(Equal("users/user1/wallet"))
+	})
+
+	It("ignores a user_id header sent by the client", func() {
+		req.Header().Set("uid", "user2")
+		req.Header().Set("user_id", "user1")
+
+		_, err := service.CreateWallet(context.Background(), req)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("rejects currencies that are not supported", func() {
+		req.Header().Set("uid", "user1")
+		req.Msg.CurrencyCode = "EUR"
+
+		_, err := service.CreateWallet(context.Background(), req)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+	})
+})
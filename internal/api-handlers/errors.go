@@ -1,6 +1,9 @@
 package apihandlers
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 func invalidArgumentError(err error) error {
 	return fmt.Errorf("invalid argument: %v", err)
@@ -18,6 +21,18 @@ func failedToCreateError(entity string, err error) error {
 	return fmt.Errorf("failed to create %s: %v", entity, err)
 }
 
+func failedToUpdateError(entity string, err error) error {
+	return fmt.Errorf("failed to update %s: %v", entity, err)
+}
+
 func notFoundError(entity string) error {
 	return fmt.Errorf("%s not found", entity)
 }
+
+func permissionDeniedError() error {
+	return errors.New("permission denied")
+}
+
+func unsupportedCurrencyError(currencyCode string) error {
+	return fmt.Errorf("unsupported currency %s", currencyCode)
+}
@@ -0,0 +1,111 @@
+package apihandlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/statement"
+)
+
+// exportChunkSize is the largest chunk of a rendered statement sent in one ExportStatement response message.
+const exportChunkSize = 64 * 1024
+
+func (service *PaymentsServiceServer) ExportStatement(ctx context.Context, req *connect.Request[pb.ExportStatementRequest], stream *connect.ServerStream[pb.ExportStatementResponse]) error {
+	log := service.logger.WithField("method", "ExportStatement")
+	log.WithField("request", req.Msg).Debug("Received ExportStatement request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Wallet, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Checking if caller owns the wallet or is trusted")
+	if userId != req.Header().Get("uid") && !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller can not export the statement")
+		return connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Resolving statement period")
+	start, end, err := statementPeriod(req.Msg.GetMonth(), req.Msg.GetInterval())
+
+	if err != nil {
+		log.WithError(err).Info("Invalid period")
+		return connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Fetching wallet")
+	wallet, err := service.walletRepository.GetWallet(ctx, log, userId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch wallet")
+		return connect.NewError(connect.CodeInternal, failedToFetchError("wallet", err))
+	}
+
+	if wallet == nil {
+		log.Error("Wallet not found")
+		return connect.NewError(connect.CodeNotFound, notFoundError("wallet"))
+	}
+
+	currencyCode := req.Msg.CurrencyCode
+
+	if currencyCode == "" {
+		currencyCode = wallet.CurrencyCode
+	}
+
+	log.Info("Building statement")
+	result, err := service.buildStatement(ctx, log, userId, currencyCode, start, end)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to build statement")
+		return connect.NewError(connect.CodeInternal, failedToFetchError("transactions", err))
+	}
+
+	log.Infof("Rendering statement as %s", req.Msg.Format)
+	var file bytes.Buffer
+	var contentType string
+
+	switch req.Msg.Format {
+	case pb.ExportStatementRequest_FORMAT_PDF:
+		contentType = statement.PDFContentType
+		err = statement.WritePDF(&file, result)
+	default:
+		contentType = statement.CSVContentType
+		err = statement.WriteCSV(&file, result)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to render statement")
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Infof("Streaming %d bytes", file.Len())
+	for file.Len() > 0 {
+		if err := stream.Send(&pb.ExportStatementResponse{
+			ContentType: contentType,
+			Data:        file.Next(exportChunkSize),
+		}); err != nil {
+			log.WithError(err).Warn("Failed to send statement chunk")
+			return err
+		}
+	}
+
+	log.Info("Returned ExportStatement response")
+	return nil
+}
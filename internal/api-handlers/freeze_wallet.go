@@ -0,0 +1,66 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) FreezeWallet(ctx context.Context, req *connect.Request[pb.FreezeWalletRequest]) (*connect.Response[pb.FreezeWalletResponse], error) {
+	return withIdempotency(ctx, service, "FreezeWallet", req, service.freezeWallet)
+}
+
+func (service *PaymentsServiceServer) freezeWallet(ctx context.Context, req *connect.Request[pb.FreezeWalletRequest]) (*connect.Response[pb.FreezeWalletResponse], error) {
+	log := service.logger.WithField("method", "FreezeWallet")
+	log.WithField("request", req.Msg).Debug("Received FreezeWallet request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Freezing wallet")
+	wallet, err := service.walletRepository.SetWalletState(ctx, log, userId, pb.Wallet_STATE_FROZEN, []pb.Wallet_State{pb.Wallet_STATE_ACTIVE}, req.Msg.Reason)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to freeze wallet")
+		return nil, setWalletStateError(err)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.FreezeWalletResponse{
+		Wallet: wallet,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned FreezeWallet response")
+	log.Info("Returning FreezeWallet response")
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) GetHold(ctx context.Context, req *connect.Request[pb.GetHoldRequest]) (*connect.Response[pb.GetHoldResponse], error) {
+	log := service.logger.WithField("method", "GetHold")
+	log.WithField("request", req.Msg).Debug("Received GetHold request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting hold id from request message")
+	holdId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Hold id: %s", holdId)
+
+	log.Info("Fetching hold")
+	hold, err := service.walletRepository.GetHold(ctx, log, userId, holdId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch hold")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("hold", err))
+	}
+
+	if hold == nil {
+		log.Error("Hold not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("hold"))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.GetHoldResponse{
+		Hold: hold,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned GetHold response")
+	log.Info("Returning GetHold response")
+	return res, nil
+}
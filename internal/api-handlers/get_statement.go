@@ -0,0 +1,89 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) GetStatement(ctx context.Context, req *connect.Request[pb.GetStatementRequest]) (*connect.Response[pb.GetStatementResponse], error) {
+	log := service.logger.WithField("method", "GetStatement")
+	log.WithField("request", req.Msg).Debug("Received GetStatement request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Wallet, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Checking if caller owns the wallet or is trusted")
+	if userId != req.Header().Get("uid") && !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller can not read the statement")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Resolving statement period")
+	start, end, err := statementPeriod(req.Msg.GetMonth(), req.Msg.GetInterval())
+
+	if err != nil {
+		log.WithError(err).Info("Invalid period")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Fetching wallet")
+	wallet, err := service.walletRepository.GetWallet(ctx, log, userId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch wallet")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("wallet", err))
+	}
+
+	if wallet == nil {
+		log.Error("Wallet not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("wallet"))
+	}
+
+	currencyCode := req.Msg.CurrencyCode
+
+	if currencyCode == "" {
+		currencyCode = wallet.CurrencyCode
+	}
+
+	log.Info("Building statement")
+	statement, err := service.buildStatement(ctx, log, userId, currencyCode, start, end)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to build statement")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("transactions", err))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.GetStatementResponse{
+		Statement: statement,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned GetStatement response")
+	log.Info("Returning GetStatement response")
+	return res, nil
+}
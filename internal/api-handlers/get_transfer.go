@@ -0,0 +1,65 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) GetTransfer(ctx context.Context, req *connect.Request[pb.GetTransferRequest]) (*connect.Response[pb.GetTransferResponse], error) {
+	log := service.logger.WithField("method", "GetTransfer")
+	log.WithField("request", req.Msg).Debug("Received GetTransfer request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting transfer id from request message")
+	transferId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Transfer id: %s", transferId)
+
+	log.Info("Fetching transfer")
+	transfer, err := service.transferRepository.GetTransfer(ctx, log, userId, transferId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch transfer")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("transfer", err))
+	}
+
+	if transfer == nil {
+		log.Error("Transfer not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("transfer"))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.GetTransferResponse{
+		Transfer: transfer,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned GetTransfer response")
+	log.Info("Returning GetTransfer response")
+	return res, nil
+}
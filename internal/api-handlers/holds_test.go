@@ -0,0 +1,189 @@
+package apihandlers_test
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Holds", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		hold    *pb.Hold
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+
+		hold = &pb.Hold{
+			Name:         "users/user1/wallet/holds/hold1",
+			Amount:       1000,
+			CurrencyCode: "INR",
+			Status:       pb.Hold_STATUS_ACTIVE,
+			Reference:    "rides/ride1",
+		}
+	})
+
+	Describe("CreateHold", func() {
+		It("creates a hold that expires after the hold ttl by default", func() {
+			m.wallet.EXPECT().CreateHold(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ logger.Logger, hold *pb.Hold) (*pb.Hold, error) {
+					return hold, nil
+				},
+			)
+
+			res, err := service.CreateHold(context.Background(), trusted(&pb.CreateHoldRequest{
+				Parent: "users/user1/wallet",
+				Hold:   &pb.Hold{Amount: 1000, Reference: "rides/ride1"},
+			}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Msg.Hold.Name).To(HavePrefix("users/user1/wallet/holds/"))
+			Expect(res.Msg.Hold.ExpireTime.AsTime()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("rejects callers that are not trusted", func() {
+			_, err := service.CreateHold(context.Background(), connect.NewRequest(&pb.CreateHoldRequest{
+				Parent: "users/user1/wallet",
+				Hold:   &pb.Hold{Amount: 1000},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+		})
+
+		It("rejects holds that expire in the past", func() {
+			_, err := service.CreateHold(context.Background(), trusted(&pb.CreateHoldRequest{
+				Parent: "users/user1/wallet",
+				Hold:   &pb.Hold{Amount: 1000, ExpireTime: timestamppb.New(time.Now().Add(-time.Minute))},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		It("rejects holds beyond the available balance", func() {
+			m.wallet.EXPECT().CreateHold(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, walletrepository.ErrInsufficientFunds)
+
+			_, err := service.CreateHold(context.Background(), trusted(&pb.CreateHoldRequest{
+				Parent: "users/user1/wallet",
+				Hold:   &pb.Hold{Amount: 1000},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+		})
+	})
+
+	Describe("CaptureHold", func() {
+		It("debits the captured amount and credits the splits", func() {
+			var captured walletrepository.Entries
+			batchId := "batch1"
+
+			m.wallet.EXPECT().GetHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(hold, nil)
+			m.wallet.EXPECT().CaptureHold(gomock.Any(), gomock.Any(), hold, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ logger.Logger, hold *pb.Hold, entries *walletrepository.Entries) (*pb.Hold, *string, error) {
+					captured = *entries
+					hold.Status = pb.Hold_STATUS_CAPTURED
+
+					return hold, &batchId, nil
+				},
+			)
+
+			res, err := service.CaptureHold(context.Background(), trusted(&pb.CaptureHoldRequest{
+				Name: "users/user1/wallet/holds/hold1",
+				Splits: []*pb.CaptureHoldRequest_Split{
+					{Destination: "users/driver1/wallet", Amount: 700},
+					{Destination: "users/platform/wallet", Amount: 200},
+				},
+				Details: &pb.Transaction_Details{DisplayName: "Ride"},
+			}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Msg.Hold.Status).To(Equal(pb.Hold_STATUS_CAPTURED))
+			Expect(captured).To(HaveLen(3))
+			Expect(captured[0].UserId).To(Equal("user1"))
+			Expect(captured[0].Transaction.Type).To(Equal(pb.Transaction_TYPE_DEBIT))
+			Expect(captured[0].Transaction.Amount).To(Equal(int64(900)))
+			Expect(captured[0].Transaction.Details.Reference).To(Equal(hold.Name))
+			Expect(captured[1].UserId).To(Equal("driver1"))
+			Expect(captured[1].Transaction.Amount).To(Equal(int64(700)))
+			Expect(captured[2].UserId).To(Equal("platform"))
+			Expect(captured[2].Transaction.Amount).To(Equal(int64(200)))
+		})
+
+		It("rejects splits that exceed the held amount", func() {
+			m.wallet.EXPECT().GetHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(hold, nil)
+
+			_, err := service.CaptureHold(context.Background(), trusted(&pb.CaptureHoldRequest{
+				Name:    "users/user1/wallet/holds/hold1",
+				Splits:  []*pb.CaptureHoldRequest_Split{{Destination: "users/driver1/wallet", Amount: 1001}},
+				Details: &pb.Transaction_Details{DisplayName: "Ride"},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		It("returns not found for holds that do not exist", func() {
+			m.wallet.EXPECT().GetHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(nil, nil)
+
+			_, err := service.CaptureHold(context.Background(), trusted(&pb.CaptureHoldRequest{
+				Name:    "users/user1/wallet/holds/hold1",
+				Splits:  []*pb.CaptureHoldRequest_Split{{Destination: "users/driver1/wallet", Amount: 100}},
+				Details: &pb.Transaction_Details{DisplayName: "Ride"},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+
+		It("rejects holds that are no longer active", func() {
+			m.wallet.EXPECT().GetHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(hold, nil)
+			m.wallet.EXPECT().CaptureHold(gomock.Any(), gomock.Any(), hold, gomock.Any()).Return(nil, nil, walletrepository.ErrHoldNotActive)
+
+			_, err := service.CaptureHold(context.Background(), trusted(&pb.CaptureHoldRequest{
+				Name:    "users/user1/wallet/holds/hold1",
+				Splits:  []*pb.CaptureHoldRequest_Split{{Destination: "users/driver1/wallet", Amount: 100}},
+				Details: &pb.Transaction_Details{DisplayName: "Ride"},
+			}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+		})
+	})
+
+	Describe("ReleaseHold", func() {
+		It("releases an active hold", func() {
+			released := &pb.Hold{Name: hold.Name, Amount: hold.Amount, Status: pb.Hold_STATUS_RELEASED}
+			m.wallet.EXPECT().ReleaseHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(released, nil)
+
+			res, err := service.ReleaseHold(context.Background(), trusted(&pb.ReleaseHoldRequest{Name: hold.Name}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Msg.Hold.Status).To(Equal(pb.Hold_STATUS_RELEASED))
+		})
+
+		It("returns not found for holds that do not exist", func() {
+			m.wallet.EXPECT().ReleaseHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(nil, status.Error(codes.NotFound, "hold not found"))
+
+			_, err := service.ReleaseHold(context.Background(), trusted(&pb.ReleaseHoldRequest{Name: hold.Name}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+
+		It("rejects holds that are no longer active", func() {
+			m.wallet.EXPECT().ReleaseHold(gomock.Any(), gomock.Any(), "user1", "hold1").Return(nil, walletrepository.ErrHoldNotActive)
+
+			_, err := service.ReleaseHold(context.Background(), trusted(&pb.ReleaseHoldRequest{Name: hold.Name}))
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+		})
+	})
+})
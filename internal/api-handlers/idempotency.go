@@ -0,0 +1,172 @@
+package apihandlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	"google.golang.org/protobuf/proto"
+)
+
+type idempotentRequest interface {
+	proto.Message
+	GetRequestId() string
+}
+
+// withIdempotency runs handler at most once per request id and caller.
+// Retries of a completed request get the original response back, and a request id reused with a different payload is rejected.
+// The request id is freed for a retry when the handler rejects the request before applying it, see isRejection. Other
+// errors may come after the request was partly applied, so retries of the request id fail instead.
+// The lease of the request id is renewed while the handler runs, so a request id held by a crashed server is freed
+// once its lease lapses.
+func withIdempotency[Req any, Res any](
+	ctx context.Context,
+	service *PaymentsServiceServer,
+	method string,
+	req *connect.Request[Req],
+	handler func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error),
+) (*connect.Response[Res], error) {
+	log := service.logger.WithField("method", method)
+
+	msg, ok := any(req.Msg).(idempotentRequest)
+
+	if !ok || msg.GetRequestId() == "" {
+		log.Debug("No request id, skipping idempotency check")
+		return handler(ctx, req)
+	}
+
+	key := idempotencyrepository.Key{
+		Method:    method,
+		Caller:    req.Header().Get("uid"),
+		RequestId: msg.GetRequestId(),
+	}
+
+	log.Info("Hashing request")
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal request")
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	hash := sha256.Sum256(data)
+	requestHash := hex.EncodeToString(hash[:])
+
+	log.Info("Reserving request id")
+	record, err := service.idempotencyRepository.Reserve(ctx, log, &key, requestHash)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to reserve request id")
+		return nil, connect.NewError(connect.CodeInternal, failedToCreateError("idempotency key", err))
+	}
+
+	if record != nil {
+		if record.RequestHash != requestHash {
+			log.Warn("Request id reused with a different request")
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("request id already used for a different request"))
+		}
+
+		if record.Failed {
+			log.Warn("Request with same id failed after it may have been applied")
+			return nil, connect.NewError(connect.CodeAborted, errors.New("request with same id failed after it may have been applied, check its outcome before retrying with a new request id"))
+		}
+
+		if record.Response == nil {
+			log.Warn("Request with same id is still in progress")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("request with same id is still in progress"))
+		}
+
+		log.Info("Replaying response of previous request")
+		res := new(Res)
+
+		if err := proto.Unmarshal(record.Response, any(res).(proto.Message)); err != nil {
+			log.WithError(err).Error("Failed to unmarshal stored response")
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		return connect.NewResponse(res), nil
+	}
+
+	renewCtx, stopRenewing := context.WithCancel(ctx)
+	go service.renewLease(renewCtx, log, &key)
+
+	res, err := handler(ctx, req)
+	stopRenewing()
+
+	if err != nil && isRejection(err) {
+		log.Info("Releasing request id of rejected request")
+		if err := service.idempotencyRepository.Release(ctx, log, &key); err != nil {
+			log.WithError(err).Error("Failed to release request id")
+		}
+
+		return nil, err
+	}
+
+	if err != nil {
+		log.Info("Keeping request id of failed request")
+		if err := service.idempotencyRepository.Fail(ctx, log, &key); err != nil {
+			log.WithError(err).Error("Failed to keep request id")
+		}
+
+		return nil, err
+	}
+
+	log.Info("Storing response for request id")
+	data, err = proto.Marshal(any(res.Msg).(proto.Message))
+
+	if err == nil {
+		err = service.idempotencyRepository.Complete(ctx, log, &key, data)
+	}
+
+	if err != nil {
+		// The request has already been applied, so the response is still returned.
+		log.WithError(err).Error("Failed to store response")
+	}
+
+	return res, nil
+}
+
+// renewLease renews the lease of a request id every third of the lease until ctx is done.
+func (service *PaymentsServiceServer) renewLease(ctx context.Context, log logger.Logger, key *idempotencyrepository.Key) {
+	if service.config.IdempotencyLeaseTTL <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(service.config.IdempotencyLeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := service.idempotencyRepository.Renew(ctx, log, key); err != nil && ctx.Err() == nil {
+				log.WithError(err).Warn("Failed to renew lease of request id")
+			}
+		}
+	}
+}
+
+// isRejection reports whether an error of a handler rejects the request before it is applied. Handlers validate
+// requests and check their preconditions before writing, and the writes that fail on a precondition, like a debit
+// beyond the balance floor, are single transactions.
+func isRejection(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument,
+		connect.CodeNotFound,
+		connect.CodeAlreadyExists,
+		connect.CodePermissionDenied,
+		connect.CodeFailedPrecondition,
+		connect.CodeOutOfRange,
+		connect.CodeUnauthenticated,
+		connect.CodeUnimplemented:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,151 @@
+package apihandlers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/protobuf/proto"
+)
+
+// requestHash hashes a request the way the service does to detect reused request ids.
+func requestHash(msg proto.Message) string {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	Expect(err).NotTo(HaveOccurred())
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])
+}
+
+var _ = Describe("Idempotency", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		req     *pb.FreezeWalletRequest
+		key     *idempotencyrepository.Key
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+
+		req = &pb.FreezeWalletRequest{
+			RequestId: "request1",
+			Name:      "users/user1/wallet",
+			Reason:    "Investigation",
+		}
+
+		key = &idempotencyrepository.Key{
+			Method:    "FreezeWallet",
+			Caller:    trustedCaller,
+			RequestId: "request1",
+		}
+	})
+
+	It("stores the response of a request", func() {
+		wallet := &pb.Wallet{Name: "users/user1/wallet", State: pb.Wallet_STATE_FROZEN}
+		stored, err := proto.Marshal(&pb.FreezeWalletResponse{Wallet: wallet})
+		Expect(err).NotTo(HaveOccurred())
+
+		gomock.InOrder(
+			m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(nil, nil),
+			m.wallet.EXPECT().SetWalletState(gomock.Any(), gomock.Any(), "user1", pb.Wallet_STATE_FROZEN, gomock.Any(), "Investigation").Return(wallet, nil),
+			m.idempotency.EXPECT().Complete(gomock.Any(), gomock.Any(), key, stored).Return(nil),
+		)
+
+		res, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.Wallet.State).To(Equal(pb.Wallet_STATE_FROZEN))
+	})
+
+	It("replays the response of a completed request", func() {
+		wallet := &pb.Wallet{Name: "users/user1/wallet", State: pb.Wallet_STATE_FROZEN}
+		stored, err := proto.Marshal(&pb.FreezeWalletResponse{Wallet: wallet})
+		Expect(err).NotTo(HaveOccurred())
+
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(&idempotencyrepository.Record{
+			RequestHash: requestHash(req),
+			Response:    stored,
+		}, nil)
+
+		res, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(res.Msg.Wallet, wallet)).To(BeTrue())
+	})
+
+	It("rejects a request id reused for a different request", func() {
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(&idempotencyrepository.Record{
+			RequestHash: "another request",
+		}, nil)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeAlreadyExists))
+	})
+
+	It("rejects retries of a request that is still in progress", func() {
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(&idempotencyrepository.Record{
+			RequestHash: requestHash(req),
+		}, nil)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+	})
+
+	It("aborts retries of a request that failed after it may have been applied", func() {
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(&idempotencyrepository.Record{
+			RequestHash: requestHash(req),
+			Failed:      true,
+		}, nil)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeAborted))
+	})
+
+	It("releases the request id of a rejected request", func() {
+		gomock.InOrder(
+			m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(nil, nil),
+			m.wallet.EXPECT().SetWalletState(gomock.Any(), gomock.Any(), "user1", pb.Wallet_STATE_FROZEN, gomock.Any(), gomock.Any()).Return(nil, walletrepository.ErrInvalidStateTransition),
+			m.idempotency.EXPECT().Release(gomock.Any(), gomock.Any(), key).Return(nil),
+		)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+	})
+
+	It("keeps the request id of a request that failed after it may have been applied", func() {
+		gomock.InOrder(
+			m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), key, requestHash(req)).Return(nil, nil),
+			m.wallet.EXPECT().SetWalletState(gomock.Any(), gomock.Any(), "user1", pb.Wallet_STATE_FROZEN, gomock.Any(), gomock.Any()).Return(nil, errors.New("deadline exceeded")),
+			m.idempotency.EXPECT().Fail(gomock.Any(), gomock.Any(), key).Return(nil),
+		)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInternal))
+	})
+
+	It("skips the idempotency check for requests without a request id", func() {
+		req.RequestId = ""
+
+		m.wallet.EXPECT().SetWalletState(gomock.Any(), gomock.Any(), "user1", pb.Wallet_STATE_FROZEN, []pb.Wallet_State{pb.Wallet_STATE_ACTIVE}, "Investigation").Return(&pb.Wallet{Name: "users/user1/wallet"}, nil)
+
+		_, err := service.FreezeWallet(context.Background(), trusted(req))
+
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
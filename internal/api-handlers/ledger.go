@@ -0,0 +1,144 @@
+package apihandlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"connectrpc.com/connect"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+// isTrustedCaller checks if the authenticated caller is one of the internal services allowed to call internal rpcs.
+func (service *PaymentsServiceServer) isTrustedCaller(header http.Header) bool {
+	uid := header.Get("uid")
+
+	return uid != "" && slices.Contains(service.config.TrustedCallers, uid)
+}
+
+// isSupportedCurrency checks if wallets can hold a currency. An empty currency code stands for the default currency.
+func (service *PaymentsServiceServer) isSupportedCurrency(currencyCode string) bool {
+	return currencyCode == "" || slices.Contains(service.config.SupportedCurrencies, currencyCode)
+}
+
+// clearOutputOnlyFields clears the fields of a transaction that are set by the service, so that a client can not
+// write them, for example to mark a transaction as the reversal of another one.
+func clearOutputOnlyFields(transaction *pb.Transaction) {
+	transaction.Name = ""
+	transaction.CreateTime = nil
+	transaction.BatchId = nil
+	transaction.ReversalOf = nil
+	transaction.ReversedAmount = 0
+	transaction.Fx = nil
+	transaction.BalanceBefore = nil
+	transaction.BalanceAfter = nil
+}
+
+// validateLedgerEntries enforces double-entry rules on a batch of transactions.
+// The sum of debits must equal the sum of credits in every currency. A batch in two currencies must name them in its FX leg
+// with the amounts the batch moves in each of them, and the leg is recorded on every transaction of the batch. Batches in
+// more currencies are rejected.
+func (service *PaymentsServiceServer) validateLedgerEntries(entries []*pb.CreateTransactionsRequest_Entry, fx *pb.FxConversion) error {
+	totals := map[string]int64{}
+	moved := map[string]int64{}
+
+	for _, entry := range entries {
+		transaction := entry.Transaction
+
+		if transaction.CurrencyCode == "" {
+			transaction.CurrencyCode = money.DefaultCurrencyCode
+		}
+
+		if !service.isSupportedCurrency(transaction.CurrencyCode) {
+			return unsupportedCurrencyError(transaction.CurrencyCode)
+		}
+
+		switch transaction.Type {
+		case pb.Transaction_TYPE_DEBIT:
+			totals[transaction.CurrencyCode] -= transaction.Amount
+			moved[transaction.CurrencyCode] += transaction.Amount
+		case pb.Transaction_TYPE_CREDIT:
+			totals[transaction.CurrencyCode] += transaction.Amount
+		}
+	}
+
+	for currencyCode, total := range totals {
+		if total != 0 {
+			return fmt.Errorf("sum of debits must equal sum of credits in %s", currencyCode)
+		}
+	}
+
+	switch len(totals) {
+	case 1:
+		if fx != nil {
+			return errors.New("fx is only allowed for batches in two currencies")
+		}
+	case 2:
+		if fx == nil {
+			return errors.New("batches in two currencies must include an fx leg")
+		}
+
+		_, hasSource := totals[fx.SourceCurrencyCode]
+		_, hasTarget := totals[fx.TargetCurrencyCode]
+
+		if fx.SourceCurrencyCode == fx.TargetCurrencyCode || !hasSource || !hasTarget {
+			return errors.New("fx leg must convert between the two currencies of the batch")
+		}
+
+		if fx.SourceAmount != moved[fx.SourceCurrencyCode] {
+			return fmt.Errorf("fx source amount must equal the amount moved in %s", fx.SourceCurrencyCode)
+		}
+
+		if fx.TargetAmount != moved[fx.TargetCurrencyCode] {
+			return fmt.Errorf("fx target amount must equal the amount moved in %s", fx.TargetCurrencyCode)
+		}
+
+		for _, entry := range entries {
+			entry.Transaction.Fx = fx
+		}
+	default:
+		return errors.New("batches can not use more than two currencies")
+	}
+
+	return nil
+}
+
+// createTransactionsError maps an error of WalletRepository.CreateTransactions to the error returned to the client.
+func createTransactionsError(err error) *connect.Error {
+	if errors.Is(err, walletrepository.ErrInsufficientFunds) || errors.Is(err, walletrepository.ErrWalletNotActive) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+}
+
+// reverseTransactionsError maps an error of WalletRepository.ReverseTransaction or ReverseBatch to the error returned to the client.
+func reverseTransactionsError(err error) *connect.Error {
+	if errors.Is(err, walletrepository.ErrTransactionNotFound) {
+		return connect.NewError(connect.CodeNotFound, notFoundError("transaction"))
+	}
+
+	if errors.Is(err, walletrepository.ErrAlreadyReversed) ||
+		errors.Is(err, walletrepository.ErrReversalExceedsAmount) ||
+		errors.Is(err, walletrepository.ErrWalletNotActive) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, failedToCreateError("transactions", err))
+}
+
+// setWalletStateError maps an error of WalletRepository.SetWalletState to the error returned to the client.
+func setWalletStateError(err error) *connect.Error {
+	if errors.Is(err, walletrepository.ErrWalletNotFound) {
+		return connect.NewError(connect.CodeNotFound, notFoundError("wallet"))
+	}
+
+	if errors.Is(err, walletrepository.ErrInvalidStateTransition) || errors.Is(err, walletrepository.ErrWalletNotEmpty) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, failedToUpdateError("wallet", err))
+}
@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/bufbuild/protovalidate-go"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
 )
 
 func (service *PaymentsServiceServer) ListPayouts(ctx context.Context, req *connect.Request[pb.ListPayoutsRequest]) (*connect.Response[pb.ListPayoutsResponse], error) {
@@ -31,8 +32,17 @@ func (service *PaymentsServiceServer) ListPayouts(ctx context.Context, req *conn
 	userId := strings.Split(req.Msg.Parent, "/")[1]
 	log.Debugf("User id: %s", userId)
 
+	log.Info("Decoding page token")
+	scope := listScope(req.Msg.Parent, req.Msg.Filter)
+	page, err := service.page(scope, req.Msg.PageSize, req.Msg.PageToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid page token")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
 	log.Info("Fetching payouts")
-	payouts, err := service.payoutRepository.GetPayouts(ctx, log, userId)
+	payouts, next, err := service.payoutRepository.GetPayouts(ctx, log, userId, payoutFilter(req.Msg.Filter), page)
 
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch payouts")
@@ -42,7 +52,7 @@ func (service *PaymentsServiceServer) ListPayouts(ctx context.Context, req *conn
 	log.Info("Creating response message")
 	res := connect.NewResponse(&pb.ListPayoutsResponse{
 		Payouts:       payouts,
-		NextPageToken: "",
+		NextPageToken: service.nextPageToken(scope, next),
 	})
 
 	log.Info("Validating response message")
@@ -55,3 +65,29 @@ func (service *PaymentsServiceServer) ListPayouts(ctx context.Context, req *conn
 	log.Info("Returning ListPayouts response")
 	return res, nil
 }
+
+// payoutFilter converts the filter of a list request to a repository filter.
+func payoutFilter(filter *pb.ListPayoutsRequest_Filter) *payoutrepository.Filter {
+	if filter == nil {
+		return nil
+	}
+
+	result := &payoutrepository.Filter{
+		Status:        filter.Status,
+		TransactionId: filter.TransactionId,
+	}
+
+	if createTime := filter.GetCreateTime(); createTime != nil {
+		if createTime.StartTime != nil {
+			start := createTime.StartTime.AsTime()
+			result.CreateTimeStart = &start
+		}
+
+		if createTime.EndTime != nil {
+			end := createTime.EndTime.AsTime()
+			result.CreateTimeEnd = &end
+		}
+	}
+
+	return result
+}
@@ -7,6 +7,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/bufbuild/protovalidate-go"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	rechargerepository "github.com/ride-app/payments-service/internal/repositories/recharge"
 )
 
 func (service *PaymentsServiceServer) ListRecharges(ctx context.Context, req *connect.Request[pb.ListRechargesRequest]) (*connect.Response[pb.ListRechargesResponse], error) {
@@ -31,8 +32,17 @@ func (service *PaymentsServiceServer) ListRecharges(ctx context.Context, req *co
 	userId := strings.Split(req.Msg.Parent, "/")[1]
 	log.Debugf("User id: %s", userId)
 
+	log.Info("Decoding page token")
+	scope := listScope(req.Msg.Parent, req.Msg.Filter)
+	page, err := service.page(scope, req.Msg.PageSize, req.Msg.PageToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid page token")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
 	log.Info("Fetching recharges")
-	recharges, err := service.rechargeRepository.GetRecharges(ctx, log, userId)
+	recharges, next, err := service.rechargeRepository.GetRecharges(ctx, log, userId, rechargeFilter(req.Msg.Filter), page)
 
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch recharges")
@@ -42,7 +52,7 @@ func (service *PaymentsServiceServer) ListRecharges(ctx context.Context, req *co
 	log.Info("Creating response message")
 	res := connect.NewResponse(&pb.ListRechargesResponse{
 		Recharges:     recharges,
-		NextPageToken: "",
+		NextPageToken: service.nextPageToken(scope, next),
 	})
 
 	log.Info("Validating response message")
@@ -55,3 +65,29 @@ func (service *PaymentsServiceServer) ListRecharges(ctx context.Context, req *co
 	log.Info("Returning ListRecharges response")
 	return res, nil
 }
+
+// rechargeFilter converts the filter of a list request to a repository filter.
+func rechargeFilter(filter *pb.ListRechargesRequest_Filter) *rechargerepository.Filter {
+	if filter == nil {
+		return nil
+	}
+
+	result := &rechargerepository.Filter{
+		Status:        filter.Status,
+		TransactionId: filter.TransactionId,
+	}
+
+	if createTime := filter.GetCreateTime(); createTime != nil {
+		if createTime.StartTime != nil {
+			start := createTime.StartTime.AsTime()
+			result.CreateTimeStart = &start
+		}
+
+		if createTime.EndTime != nil {
+			end := createTime.EndTime.AsTime()
+			result.CreateTimeEnd = &end
+		}
+	}
+
+	return result
+}
@@ -31,8 +31,26 @@ func (service *PaymentsServiceServer) ListTransactions(ctx context.Context, req
 	userId := strings.Split(req.Msg.Parent, "/")[1]
 	log.Debugf("User id: %s", userId)
 
+	log.Info("Parsing filter")
+	filter, err := transactionFilter(req.Msg.Filter, req.Msg.OrderBy)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid filter")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	scope := listScope(req.Msg.Parent, &pb.ListTransactionsRequest{Filter: req.Msg.Filter, OrderBy: req.Msg.OrderBy})
+
+	log.Info("Decoding page token")
+	page, err := service.page(scope, req.Msg.PageSize, req.Msg.PageToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid page token")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
 	log.Info("Fetching transactions for user")
-	transactions, err := service.walletRepository.GetTransactions(ctx, log, userId, nil)
+	transactions, next, err := service.walletRepository.GetTransactions(ctx, log, userId, filter, page)
 	if err != nil {
 		log.WithError(err).Error("Failed to fetch transactions")
 		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("transactions", err))
@@ -41,7 +59,7 @@ func (service *PaymentsServiceServer) ListTransactions(ctx context.Context, req
 	log.Info("Creating response message")
 	res := connect.NewResponse(&pb.ListTransactionsResponse{
 		Transactions:  transactions,
-		NextPageToken: "",
+		NextPageToken: service.nextPageToken(scope, next),
 	})
 
 	log.Info("Validating response message")
@@ -0,0 +1,69 @@
+package apihandlers_test
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("ListTransactions", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+	})
+
+	list := func(filter string, orderBy string) error {
+		_, err := service.ListTransactions(context.Background(), connect.NewRequest(&pb.ListTransactionsRequest{
+			Parent:  "users/user1/wallet",
+			Filter:  filter,
+			OrderBy: orderBy,
+		}))
+
+		return err
+	}
+
+	It("converts the filter and order_by into a repository filter", func() {
+		m.wallet.EXPECT().GetTransactions(gomock.Any(), gomock.Any(), "user1", &walletrepository.Filter{
+			Conditions: []walletrepository.Condition{
+				{Path: "type", Operator: "==", Value: "TYPE_DEBIT"},
+				{Path: "create_time", Operator: ">=", Value: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{Path: "details.reference", Operator: "==", Value: "rides/ride1"},
+			},
+			Ascending: true,
+		}, gomock.Any()).Return(nil, nil, nil)
+
+		Expect(list(`type = DEBIT AND create_time >= "2026-01-01T00:00:00Z" AND details.reference = "rides/ride1"`, "create_time asc")).To(Succeed())
+	})
+
+	It("lists newest transactions first by default", func() {
+		m.wallet.EXPECT().GetTransactions(gomock.Any(), gomock.Any(), "user1", &walletrepository.Filter{}, gomock.Any()).Return(nil, nil, nil)
+
+		Expect(list("", "")).To(Succeed())
+	})
+
+	DescribeTable("rejects unsupported filters and orderings as invalid arguments",
+		func(filter string, orderBy string) {
+			Expect(connect.CodeOf(list(filter, orderBy))).To(Equal(connect.CodeInvalidArgument))
+		},
+		Entry("malformed filter", "type =", ""),
+		Entry("unsupported field", "amount = 100", ""),
+		Entry("unsupported operator", "type != DEBIT", ""),
+		Entry("range on a field other than create_time", "batch_id > batch1", ""),
+		Entry("unknown transaction type", "type = REFUND", ""),
+		Entry("malformed create_time", "create_time > yesterday", ""),
+		Entry("malformed order_by", "", "create_time sideways"),
+		Entry("unsupported order_by field", "", "amount desc"),
+		Entry("more than one order_by field", "", "create_time desc, batch_id"),
+	)
+})
@@ -0,0 +1,78 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	transferrepository "github.com/ride-app/payments-service/internal/repositories/transfer"
+)
+
+func (service *PaymentsServiceServer) ListTransfers(ctx context.Context, req *connect.Request[pb.ListTransfersRequest]) (*connect.Response[pb.ListTransfersResponse], error) {
+	log := service.logger.WithField("method", "ListTransfers")
+	log.WithField("request", req.Msg).Debug("Received ListTransfers request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Parent, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Decoding page token")
+	scope := listScope(req.Msg.Parent, req.Msg.Filter)
+	page, err := service.page(scope, req.Msg.PageSize, req.Msg.PageToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid page token")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Fetching transfers")
+	transfers, next, err := service.transferRepository.GetTransfers(ctx, log, userId, transferFilter(req.Msg.Filter), page)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch transfers")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("transfers", err))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.ListTransfersResponse{
+		Transfers:     transfers,
+		NextPageToken: service.nextPageToken(scope, next),
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned ListTransfers response")
+	log.Info("Returning ListTransfers response")
+	return res, nil
+}
+
+// transferFilter converts the filter of a list request to a repository filter.
+func transferFilter(filter *pb.ListTransfersRequest_Filter) *transferrepository.Filter {
+	if filter == nil {
+		return nil
+	}
+
+	return &transferrepository.Filter{
+		Direction: filter.Direction,
+	}
+}
@@ -0,0 +1,34 @@
+package apihandlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ride-app/payments-service/internal/pagination"
+	"google.golang.org/protobuf/proto"
+)
+
+// page decodes the page token of a list request into the page to fetch. Page tokens are bound to the scope they were issued for.
+func (service *PaymentsServiceServer) page(scope string, pageSize int32, pageToken string) (*pagination.Page, error) {
+	cursor, err := pagination.DecodeToken(service.config.PageTokenSecret, scope, pageToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return pagination.NewPage(pageSize, cursor), nil
+}
+
+// nextPageToken encodes the cursor of the next page, or returns an empty token if there are no more pages.
+func (service *PaymentsServiceServer) nextPageToken(scope string, next *pagination.Cursor) string {
+	return pagination.EncodeToken(service.config.PageTokenSecret, scope, next)
+}
+
+// listScope binds page tokens to the parent and the filter of a list request,
+// so that a token cannot be used to page through a differently filtered list.
+func listScope(parent string, filter proto.Message) string {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	hash := sha256.Sum256(data)
+
+	return parent + "?" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,347 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
+	"github.com/ride-app/payments-service/internal/money"
+	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+// PaymentGatewayWebhook verifies and applies webhook events of the payment gateway to recharges and payouts.
+// Webhooks are posted to the path named after the gateway, for example /webhooks/razorpay.
+// It responds with a non 2xx status when the event should be redelivered.
+func (service *PaymentsServiceServer) PaymentGatewayWebhook(w http.ResponseWriter, r *http.Request) {
+	log := service.logger.WithField("method", "PaymentGatewayWebhook")
+
+	if r.PathValue("gateway") != service.paymentGateway.Name() {
+		log.Warnf("Webhook for unknown gateway: %s", r.PathValue("gateway"))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to read request body")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.Info("Parsing webhook event")
+	event, err := service.paymentGateway.ParseWebhook(r.Header, body)
+
+	if errors.Is(err, gateway.ErrInvalidSignature) {
+		log.Warn("Invalid webhook signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to parse webhook event")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log = log.WithField("event", event.Name)
+	log.Info("Received webhook event")
+
+	switch event.Type {
+	case gateway.WebhookEventPaymentCaptured:
+		err = service.completeRecharge(r.Context(), log, event.Payment)
+	case gateway.WebhookEventPaymentFailed:
+		err = service.failRecharge(r.Context(), log, event.Payment)
+	case gateway.WebhookEventPayoutProcessed:
+		err = service.completePayout(r.Context(), log, event.Payout)
+	case gateway.WebhookEventPayoutFailed, gateway.WebhookEventPayoutCancelled:
+		err = service.failPayout(r.Context(), log, event.Payout, event.Type == gateway.WebhookEventPayoutCancelled)
+	default:
+		log.Info("Ignoring unhandled webhook event")
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to handle webhook event")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// completeRecharge credits the wallet for a captured payment and then marks the recharge as successful.
+// The credit is named after the recharge, so a redelivered or concurrent event can not credit the wallet twice, and
+// a recharge whose update failed after the credit is marked successful on redelivery.
+// The captured money has already moved, so the wallet is credited even if it is no longer active. A payment that does
+// not match the amount or currency of its recharge is not credited, and the recharge is marked failed instead.
+func (service *PaymentsServiceServer) completeRecharge(ctx context.Context, log logger.Logger, payment *gateway.Payment) error {
+	log.Info("Fetching recharge for order")
+	recharge, err := service.rechargeRepository.GetRechargeByReference(ctx, log, payment.OrderId)
+
+	if err != nil {
+		return failedToFetchError("recharge", err)
+	}
+
+	if recharge == nil {
+		log.Warnf("No recharge found for order: %s", payment.OrderId)
+		return nil
+	}
+
+	if recharge.GetTransactionId() != "" {
+		log.Info("Recharge already credited")
+		return nil
+	}
+
+	if payment.Amount != recharge.Amount || money.CurrencyCode(payment.CurrencyCode) != money.CurrencyCode(recharge.CurrencyCode) {
+		log.Errorf("Captured %d %s for a recharge of %d %s", payment.Amount, payment.CurrencyCode, recharge.Amount, recharge.CurrencyCode)
+
+		log.Info("Marking recharge as failed")
+		recharge.Status = pb.Recharge_STATUS_FAILED
+		recharge.Metadata = &pb.Recharge_FailureReason{FailureReason: "Captured payment does not match the recharge"}
+
+		if _, err := service.rechargeRepository.UpdateRecharge(ctx, log, recharge, []pb.Recharge_Status{
+			pb.Recharge_STATUS_PENDING,
+			pb.Recharge_STATUS_FAILED,
+		}); err != nil {
+			return failedToUpdateError("recharge", err)
+		}
+
+		return nil
+	}
+
+	substrings := strings.Split(recharge.Name, "/")
+
+	entries := walletrepository.Entries{
+		{
+			UserId:        substrings[1],
+			TransactionId: "recharge-" + substrings[4],
+			Settles:       true,
+			Transaction: &pb.Transaction{
+				Type:         pb.Transaction_TYPE_CREDIT,
+				Amount:       recharge.Amount,
+				CurrencyCode: recharge.CurrencyCode,
+				Details: &pb.Transaction_Details{
+					DisplayName: "Recharge",
+					Reference:   recharge.Name,
+				},
+			},
+		},
+	}
+
+	log.Info("Crediting wallet")
+	batchId, err := service.walletRepository.CreateTransactions(ctx, log, &entries)
+
+	if errors.Is(err, walletrepository.ErrTransactionExists) {
+		log.Info("Wallet already credited for recharge")
+	} else if err != nil {
+		return failedToCreateError("transactions", err)
+	} else {
+		log.Debugf("Batch id: %s", *batchId)
+	}
+
+	log.Info("Marking recharge as successful")
+	recharge.Status = pb.Recharge_STATUS_SUCCESS
+	recharge.Metadata = &pb.Recharge_TransactionId{
+		TransactionId: fmt.Sprintf("users/%s/wallet/transactions/%s", substrings[1], entries[0].TransactionId),
+	}
+
+	// Failed attempts can precede the captured payment, so failed recharges are completed too.
+	if _, err := service.rechargeRepository.UpdateRecharge(ctx, log, recharge, []pb.Recharge_Status{
+		pb.Recharge_STATUS_PENDING,
+		pb.Recharge_STATUS_FAILED,
+	}); err != nil {
+		return failedToUpdateError("recharge", err)
+	}
+
+	return nil
+}
+
+// failRecharge marks a pending recharge as failed. A recharge that has already succeeded is left untouched
+// since gateways report every failed attempt, including ones made before a successful payment.
+// A recharge that changes while it is marked is retried by redelivery.
+func (service *PaymentsServiceServer) failRecharge(ctx context.Context, log logger.Logger, payment *gateway.Payment) error {
+	log.Info("Fetching recharge for order")
+	recharge, err := service.rechargeRepository.GetRechargeByReference(ctx, log, payment.OrderId)
+
+	if err != nil {
+		return failedToFetchError("recharge", err)
+	}
+
+	if recharge == nil {
+		log.Warnf("No recharge found for order: %s", payment.OrderId)
+		return nil
+	}
+
+	if recharge.Status == pb.Recharge_STATUS_SUCCESS {
+		log.Info("Recharge already successful")
+		return nil
+	}
+
+	log.Info("Marking recharge as failed")
+	recharge.Status = pb.Recharge_STATUS_FAILED
+	recharge.Metadata = &pb.Recharge_FailureReason{FailureReason: payment.FailureReason}
+
+	if _, err := service.rechargeRepository.UpdateRecharge(ctx, log, recharge, []pb.Recharge_Status{
+		pb.Recharge_STATUS_PENDING,
+		pb.Recharge_STATUS_FAILED,
+	}); err != nil {
+		return failedToUpdateError("recharge", err)
+	}
+
+	return nil
+}
+
+// getPayoutForEntity looks up the payout a gateway payout was sent for.
+func (service *PaymentsServiceServer) getPayoutForEntity(ctx context.Context, log logger.Logger, entity *gateway.Payout) (*pb.Payout, error) {
+	substrings := strings.Split(entity.Reference, "/")
+
+	if len(substrings) != 5 {
+		return nil, errors.New("invalid payout reference")
+	}
+
+	log.Info("Fetching payout")
+	payout, err := service.payoutRepository.GetPayout(ctx, log, substrings[1], substrings[4])
+
+	if err != nil {
+		return nil, failedToFetchError("payout", err)
+	}
+
+	return payout, nil
+}
+
+// completePayout marks a payout as successful. The wallet was already debited when the payout was created.
+// A payout that is cancelled while it is marked is retried by redelivery.
+func (service *PaymentsServiceServer) completePayout(ctx context.Context, log logger.Logger, entity *gateway.Payout) error {
+	payout, err := service.getPayoutForEntity(ctx, log, entity)
+
+	if err != nil {
+		return err
+	}
+
+	if payout == nil {
+		log.Warnf("No payout found for: %s", entity.Id)
+		return nil
+	}
+
+	if payout.Status == pb.Payout_STATUS_CANCELLED {
+		// A payout is only marked cancelled while its cancellation is in flight, and gateways do not
+		// process cancelled payouts, so the cancellation is about to be rolled back. Ask for redelivery.
+		return errors.New("payout processed while being cancelled")
+	}
+
+	if payout.Status != pb.Payout_STATUS_PENDING {
+		log.Infof("Payout already in status: %s", payout.Status)
+		return nil
+	}
+
+	log.Info("Marking payout as successful")
+	payout.Status = pb.Payout_STATUS_SUCCESS
+	payout.Metadata = nil
+
+	if _, err := service.payoutRepository.UpdatePayout(ctx, log, payout, []pb.Payout_Status{pb.Payout_STATUS_PENDING}); err != nil {
+		return failedToUpdateError("payout", err)
+	}
+
+	return nil
+}
+
+// failPayout marks a payout as failed, or as cancelled when the gateway reports the payout as cancelled, and then
+// credits the debited amount back to the wallet.
+// Reversals can arrive after a payout was processed, so only failed payouts are skipped.
+// A failed payout is still refunded in case the earlier attempt stopped before the credit.
+// A payout whose cancellation by CancelPayout is in flight is only refunded once the gateway reports it cancelled,
+// and otherwise retried by redelivery until the cancellation is rolled back.
+func (service *PaymentsServiceServer) failPayout(ctx context.Context, log logger.Logger, entity *gateway.Payout, cancelled bool) error {
+	payout, err := service.getPayoutForEntity(ctx, log, entity)
+
+	if err != nil {
+		return err
+	}
+
+	if payout == nil {
+		log.Warnf("No payout found for: %s", entity.Id)
+		return nil
+	}
+
+	if cancelled {
+		log.Info("Marking payout as cancelled")
+		payout.Status = pb.Payout_STATUS_CANCELLED
+		payout.Metadata = nil
+
+		if _, err := service.payoutRepository.UpdatePayout(ctx, log, payout, []pb.Payout_Status{
+			pb.Payout_STATUS_PENDING,
+			pb.Payout_STATUS_CANCELLED,
+		}); err != nil {
+			return failedToUpdateError("payout", err)
+		}
+
+		return service.refundPayout(ctx, log, payout, "Payout cancelled")
+	}
+
+	if payout.Status == pb.Payout_STATUS_CANCELLED {
+		// The payout failed on the gateway while it was being cancelled, so the cancellation is about to be rolled back.
+		return errors.New("payout failed while being cancelled")
+	}
+
+	if payout.Status != pb.Payout_STATUS_FAILED {
+		log.Info("Marking payout as failed")
+		payout.Status = pb.Payout_STATUS_FAILED
+		payout.Metadata = &pb.Payout_FailureReason{FailureReason: entity.FailureReason}
+
+		if _, err := service.payoutRepository.UpdatePayout(ctx, log, payout, []pb.Payout_Status{
+			pb.Payout_STATUS_PENDING,
+			pb.Payout_STATUS_SUCCESS,
+		}); err != nil {
+			return failedToUpdateError("payout", err)
+		}
+	}
+
+	return service.refundPayout(ctx, log, payout, "Payout refund")
+}
+
+// refundPayout credits the amount of a failed or cancelled payout back to the wallet, unless it was already refunded.
+// The refund is named after the payout, so concurrent or retried refunds of a payout credit the wallet only once.
+// The payout never left, so the wallet is credited even if it is no longer active.
+func (service *PaymentsServiceServer) refundPayout(ctx context.Context, log logger.Logger, payout *pb.Payout, displayName string) error {
+	substrings := strings.Split(payout.Name, "/")
+
+	entries := walletrepository.Entries{
+		{
+			UserId:        substrings[1],
+			TransactionId: payoutrepository.RefundTransactionId(substrings[4]),
+			Settles:       true,
+			Transaction: &pb.Transaction{
+				Type:         pb.Transaction_TYPE_CREDIT,
+				Amount:       payout.Amount,
+				CurrencyCode: payout.CurrencyCode,
+				Details: &pb.Transaction_Details{
+					DisplayName: displayName,
+					Reference:   payout.Name,
+				},
+			},
+		},
+	}
+
+	log.Info("Crediting payout amount back to wallet")
+	batchId, err := service.walletRepository.CreateTransactions(ctx, log, &entries)
+
+	if errors.Is(err, walletrepository.ErrTransactionExists) {
+		log.Info("Payout already refunded")
+		return nil
+	}
+
+	if err != nil {
+		return failedToCreateError("transactions", err)
+	}
+
+	log.Debugf("Batch id: %s", *batchId)
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package apihandlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	"github.com/ride-app/payments-service/internal/gateway"
+	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
+	rechargerepository "github.com/ride-app/payments-service/internal/repositories/recharge"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("PaymentGatewayWebhook", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		credits walletrepository.Entries
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+		credits = nil
+
+		m.gateway.EXPECT().Name().Return("razorpay").AnyTimes()
+	})
+
+	// post delivers an event to the webhook of a gateway and returns the status code of the response.
+	post := func(gatewayName string, event *gateway.WebhookEvent, err error) int {
+		if gatewayName == "razorpay" {
+			m.gateway.EXPECT().ParseWebhook(gomock.Any(), []byte("body")).Return(event, err)
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/webhooks/"+gatewayName, strings.NewReader("body"))
+		r.SetPathValue("gateway", gatewayName)
+		w := httptest.NewRecorder()
+
+		service.PaymentGatewayWebhook(w, r)
+
+		return w.Code
+	}
+
+	expectCredit := func(err error) {
+		batchId := "batch1"
+
+		m.wallet.EXPECT().CreateTransactions(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ logger.Logger, entries *walletrepository.Entries) (*string, error) {
+				credits = append(credits, *entries...)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return &batchId, nil
+			},
+		)
+	}
+
+	It("rejects webhooks of other gateways", func() {
+		Expect(post("stripe", nil, nil)).To(Equal(http.StatusNotFound))
+	})
+
+	It("rejects webhooks with an invalid signature", func() {
+		Expect(post("razorpay", nil, gateway.ErrInvalidSignature)).To(Equal(http.StatusUnauthorized))
+	})
+
+	Describe("recharges", func() {
+		var recharge *pb.Recharge
+
+		BeforeEach(func() {
+			recharge = &pb.Recharge{
+				Name:         "users/user1/wallet/recharges/recharge1",
+				Amount:       500,
+				CurrencyCode: "INR",
+				Status:       pb.Recharge_STATUS_PENDING,
+			}
+
+			m.recharge.EXPECT().GetRechargeByReference(gomock.Any(), gomock.Any(), "order1").Return(recharge, nil)
+		})
+
+		captured := &gateway.WebhookEvent{
+			Type:    gateway.WebhookEventPaymentCaptured,
+			Name:    "payment.captured",
+			Payment: &gateway.Payment{Id: "payment1", OrderId: "order1", Amount: 500, CurrencyCode: "INR"},
+		}
+
+		failed := &gateway.WebhookEvent{
+			Type:    gateway.WebhookEventPaymentFailed,
+			Name:    "payment.failed",
+			Payment: &gateway.Payment{Id: "payment1", OrderId: "order1", FailureReason: "Card declined"},
+		}
+
+		It("credits the wallet and then marks a captured recharge as successful", func() {
+			expectCredit(nil)
+			m.recharge.EXPECT().UpdateRecharge(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Recharge_Status{
+				pb.Recharge_STATUS_PENDING,
+				pb.Recharge_STATUS_FAILED,
+			}).Return(nil, nil)
+
+			Expect(post("razorpay", captured, nil)).To(Equal(http.StatusOK))
+			Expect(credits).To(HaveLen(1))
+			Expect(credits[0].TransactionId).To(Equal("recharge-recharge1"))
+			Expect(credits[0].Transaction.Type).To(Equal(pb.Transaction_TYPE_CREDIT))
+			Expect(credits[0].Transaction.Amount).To(Equal(int64(500)))
+			Expect(credits[0].Settles).To(BeTrue())
+			Expect(recharge.Status).To(Equal(pb.Recharge_STATUS_SUCCESS))
+			Expect(recharge.GetTransactionId()).To(Equal("users/user1/wallet/transactions/recharge-recharge1"))
+		})
+
+		DescribeTable("marks recharges that do not match the captured payment as failed without crediting them",
+			func(amount int64, currencyCode string) {
+				m.recharge.EXPECT().UpdateRecharge(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Recharge_Status{
+					pb.Recharge_STATUS_PENDING,
+					pb.Recharge_STATUS_FAILED,
+				}).Return(nil, nil)
+
+				event := &gateway.WebhookEvent{
+					Type:    gateway.WebhookEventPaymentCaptured,
+					Name:    "payment.captured",
+					Payment: &gateway.Payment{Id: "payment1", OrderId: "order1", Amount: amount, CurrencyCode: currencyCode},
+				}
+
+				Expect(post("razorpay", event, nil)).To(Equal(http.StatusOK))
+				Expect(credits).To(BeEmpty())
+				Expect(recharge.Status).To(Equal(pb.Recharge_STATUS_FAILED))
+			},
+			Entry("amount", int64(400), "INR"),
+			Entry("currency", int64(500), "USD"),
+		)
+
+		It("marks a recharge that was already credited as successful", func() {
+			expectCredit(walletrepository.ErrTransactionExists)
+			m.recharge.EXPECT().UpdateRecharge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+			Expect(post("razorpay", captured, nil)).To(Equal(http.StatusOK))
+		})
+
+		It("skips recharges whose credit is recorded", func() {
+			recharge.Status = pb.Recharge_STATUS_SUCCESS
+			recharge.Metadata = &pb.Recharge_TransactionId{TransactionId: "users/user1/wallet/transactions/recharge-recharge1"}
+
+			Expect(post("razorpay", captured, nil)).To(Equal(http.StatusOK))
+		})
+
+		It("asks for redelivery when the recharge changed while it was marked", func() {
+			expectCredit(nil)
+			m.recharge.EXPECT().UpdateRecharge(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, rechargerepository.ErrStatusChanged)
+
+			Expect(post("razorpay", captured, nil)).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("marks a pending recharge as failed", func() {
+			m.recharge.EXPECT().UpdateRecharge(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Recharge_Status{
+				pb.Recharge_STATUS_PENDING,
+				pb.Recharge_STATUS_FAILED,
+			}).Return(nil, nil)
+
+			Expect(post("razorpay", failed, nil)).To(Equal(http.StatusOK))
+			Expect(recharge.Status).To(Equal(pb.Recharge_STATUS_FAILED))
+			Expect(recharge.GetFailureReason()).To(Equal("Card declined"))
+		})
+
+		It("leaves successful recharges untouched by failed attempts", func() {
+			recharge.Status = pb.Recharge_STATUS_SUCCESS
+
+			Expect(post("razorpay", failed, nil)).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("payouts", func() {
+		var payout *pb.Payout
+
+		BeforeEach(func() {
+			payout = &pb.Payout{
+				Name:         "users/user1/wallet/payouts/payout1",
+				Amount:       700,
+				CurrencyCode: "INR",
+				Status:       pb.Payout_STATUS_PENDING,
+			}
+
+			m.payout.EXPECT().GetPayout(gomock.Any(), gomock.Any(), "user1", "payout1").Return(payout, nil)
+		})
+
+		event := func(eventType gateway.WebhookEventType) *gateway.WebhookEvent {
+			return &gateway.WebhookEvent{
+				Type: eventType,
+				Name: "payout.event",
+				Payout: &gateway.Payout{
+					Id:            "gateway-payout1",
+					Reference:     "users/user1/wallet/payouts/payout1",
+					FailureReason: "Account closed",
+				},
+			}
+		}
+
+		It("marks a pending payout as successful", func() {
+			m.payout.EXPECT().UpdatePayout(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Payout_Status{pb.Payout_STATUS_PENDING}).Return(nil, nil)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutProcessed), nil)).To(Equal(http.StatusOK))
+			Expect(payout.Status).To(Equal(pb.Payout_STATUS_SUCCESS))
+		})
+
+		It("asks for redelivery of processed payouts that are being cancelled", func() {
+			payout.Status = pb.Payout_STATUS_CANCELLED
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutProcessed), nil)).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("marks a failed payout as failed and refunds it", func() {
+			m.payout.EXPECT().UpdatePayout(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Payout_Status{
+				pb.Payout_STATUS_PENDING,
+				pb.Payout_STATUS_SUCCESS,
+			}).Return(nil, nil)
+			expectCredit(nil)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutFailed), nil)).To(Equal(http.StatusOK))
+			Expect(payout.Status).To(Equal(pb.Payout_STATUS_FAILED))
+			Expect(credits).To(HaveLen(1))
+			Expect(credits[0].TransactionId).To(Equal(payoutrepository.RefundTransactionId("payout1")))
+			Expect(credits[0].Transaction.Amount).To(Equal(int64(700)))
+			Expect(credits[0].Settles).To(BeTrue())
+		})
+
+		It("refunds a failed payout whose refund is missing", func() {
+			payout.Status = pb.Payout_STATUS_FAILED
+			expectCredit(nil)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutFailed), nil)).To(Equal(http.StatusOK))
+			Expect(credits).To(HaveLen(1))
+		})
+
+		It("refunds a payout only once", func() {
+			payout.Status = pb.Payout_STATUS_FAILED
+			expectCredit(walletrepository.ErrTransactionExists)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutFailed), nil)).To(Equal(http.StatusOK))
+		})
+
+		It("asks for redelivery of failed payouts that are being cancelled", func() {
+			payout.Status = pb.Payout_STATUS_CANCELLED
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutFailed), nil)).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("marks a cancelled payout as cancelled and refunds it", func() {
+			payout.Status = pb.Payout_STATUS_CANCELLED
+
+			m.payout.EXPECT().UpdatePayout(gomock.Any(), gomock.Any(), gomock.Any(), []pb.Payout_Status{
+				pb.Payout_STATUS_PENDING,
+				pb.Payout_STATUS_CANCELLED,
+			}).Return(nil, nil)
+			expectCredit(nil)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutCancelled), nil)).To(Equal(http.StatusOK))
+			Expect(credits).To(HaveLen(1))
+			Expect(credits[0].TransactionId).To(Equal(payoutrepository.RefundTransactionId("payout1")))
+		})
+
+		It("does not refund a cancelled payout that changed while it was marked", func() {
+			m.payout.EXPECT().UpdatePayout(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, payoutrepository.ErrStatusChanged)
+
+			Expect(post("razorpay", event(gateway.WebhookEventPayoutCancelled), nil)).To(Equal(http.StatusInternalServerError))
+			Expect(credits).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (service *PaymentsServiceServer) ReleaseHold(ctx context.Context, req *connect.Request[pb.ReleaseHoldRequest]) (*connect.Response[pb.ReleaseHoldResponse], error) {
+	return withIdempotency(ctx, service, "ReleaseHold", req, service.releaseHold)
+}
+
+func (service *PaymentsServiceServer) releaseHold(ctx context.Context, req *connect.Request[pb.ReleaseHoldRequest]) (*connect.Response[pb.ReleaseHoldResponse], error) {
+	log := service.logger.WithField("method", "ReleaseHold")
+	log.WithField("request", req.Msg).Debug("Received ReleaseHold request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting hold id from request message")
+	holdId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Hold id: %s", holdId)
+
+	log.Info("Releasing hold")
+	hold, err := service.walletRepository.ReleaseHold(ctx, log, userId, holdId)
+
+	if status.Code(err) == codes.NotFound {
+		log.Error("Hold not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("hold"))
+	}
+
+	if errors.Is(err, walletrepository.ErrHoldNotActive) {
+		log.WithError(err).Info("Hold is not active")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to release hold")
+		return nil, connect.NewError(connect.CodeInternal, failedToUpdateError("hold", err))
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.ReleaseHoldResponse{
+		Hold: hold,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned ReleaseHold response")
+	log.Info("Returning ReleaseHold response")
+	return res, nil
+}
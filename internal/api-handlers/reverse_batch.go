@@ -0,0 +1,64 @@
+package apihandlers
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) ReverseBatch(ctx context.Context, req *connect.Request[pb.ReverseBatchRequest]) (*connect.Response[pb.ReverseBatchResponse], error) {
+	return withIdempotency(ctx, service, "ReverseBatch", req, service.reverseBatch)
+}
+
+func (service *PaymentsServiceServer) reverseBatch(ctx context.Context, req *connect.Request[pb.ReverseBatchRequest]) (*connect.Response[pb.ReverseBatchResponse], error) {
+	log := service.logger.WithField("method", "ReverseBatch")
+	log.WithField("request", req.Msg).Debug("Received ReverseBatch request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Reversing batch")
+	transactions, batchId, err := service.walletRepository.ReverseBatch(ctx, log, req.Msg.BatchId, req.Msg.Reason)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to reverse batch")
+		return nil, reverseTransactionsError(err)
+	}
+
+	log.Debugf("Batch id: %s", *batchId)
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.ReverseBatchResponse{
+		BatchId:      *batchId,
+		Transactions: transactions,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned ReverseBatch response")
+	log.Info("Returning ReverseBatch response")
+	return res, nil
+}
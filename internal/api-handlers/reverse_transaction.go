@@ -0,0 +1,73 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) ReverseTransaction(ctx context.Context, req *connect.Request[pb.ReverseTransactionRequest]) (*connect.Response[pb.ReverseTransactionResponse], error) {
+	return withIdempotency(ctx, service, "ReverseTransaction", req, service.reverseTransaction)
+}
+
+func (service *PaymentsServiceServer) reverseTransaction(ctx context.Context, req *connect.Request[pb.ReverseTransactionRequest]) (*connect.Response[pb.ReverseTransactionResponse], error) {
+	log := service.logger.WithField("method", "ReverseTransaction")
+	log.WithField("request", req.Msg).Debug("Received ReverseTransaction request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Extracting transaction id from request message")
+	transactionId := strings.Split(req.Msg.Name, "/")[4]
+	log.Debugf("Transaction id: %s", transactionId)
+
+	log.Info("Reversing transaction")
+	transaction, batchId, err := service.walletRepository.ReverseTransaction(ctx, log, userId, transactionId, req.Msg.GetAmount(), req.Msg.Reason)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to reverse transaction")
+		return nil, reverseTransactionsError(err)
+	}
+
+	log.Debugf("Batch id: %s", *batchId)
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.ReverseTransactionResponse{
+		BatchId:     *batchId,
+		Transaction: transaction,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned ReverseTransaction response")
+	log.Info("Returning ReverseTransaction response")
+	return res, nil
+}
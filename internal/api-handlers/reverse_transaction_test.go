@@ -0,0 +1,83 @@
+package apihandlers_test
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("ReverseTransaction", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		req     *pb.ReverseTransactionRequest
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+
+		req = &pb.ReverseTransactionRequest{
+			Name:   "users/user1/wallet/transactions/transaction1",
+			Reason: "Ride cancelled",
+		}
+	})
+
+	It("reverses the unreversed part of a transaction by default", func() {
+		batchId := "batch1"
+		reversalOf := req.Name
+		reversal := &pb.Transaction{
+			Name:       "users/user1/wallet/transactions/reversal1",
+			Amount:     500,
+			Type:       pb.Transaction_TYPE_CREDIT,
+			ReversalOf: &reversalOf,
+		}
+
+		m.wallet.EXPECT().ReverseTransaction(gomock.Any(), gomock.Any(), "user1", "transaction1", int64(0), "Ride cancelled").Return(reversal, &batchId, nil)
+
+		res, err := service.ReverseTransaction(context.Background(), trusted(req))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.BatchId).To(Equal("batch1"))
+		Expect(res.Msg.Transaction.GetReversalOf()).To(Equal(req.Name))
+	})
+
+	It("reverses part of a transaction", func() {
+		batchId := "batch1"
+		amount := int64(200)
+		req.Amount = &amount
+
+		m.wallet.EXPECT().ReverseTransaction(gomock.Any(), gomock.Any(), "user1", "transaction1", int64(200), gomock.Any()).Return(&pb.Transaction{Amount: 200, Type: pb.Transaction_TYPE_CREDIT}, &batchId, nil)
+
+		_, err := service.ReverseTransaction(context.Background(), trusted(req))
+
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects callers that are not trusted", func() {
+		_, err := service.ReverseTransaction(context.Background(), connect.NewRequest(req))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	DescribeTable("maps reversal errors",
+		func(err error, code connect.Code) {
+			m.wallet.EXPECT().ReverseTransaction(gomock.Any(), gomock.Any(), "user1", "transaction1", gomock.Any(), gomock.Any()).Return(nil, nil, err)
+
+			_, err = service.ReverseTransaction(context.Background(), trusted(req))
+
+			Expect(connect.CodeOf(err)).To(Equal(code))
+		},
+		Entry("transaction not found", walletrepository.ErrTransactionNotFound, connect.CodeNotFound),
+		Entry("already reversed", walletrepository.ErrAlreadyReversed, connect.CodeFailedPrecondition),
+		Entry("reversal exceeds the unreversed amount", walletrepository.ErrReversalExceedsAmount, connect.CodeFailedPrecondition),
+		Entry("wallet not active", walletrepository.ErrWalletNotActive, connect.CodeFailedPrecondition),
+		Entry("other errors", errors.New("deadline exceeded"), connect.CodeInternal),
+	)
+})
@@ -1,10 +1,13 @@
 package apihandlers
 
 import (
+	"errors"
+
 	"github.com/dragonfish/go/v2/pkg/logger"
-	"github.com/razorpay/razorpay-go"
 	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/gateway"
 	ar "github.com/ride-app/payments-service/internal/repositories/auth"
+	ir "github.com/ride-app/payments-service/internal/repositories/idempotency"
 	pr "github.com/ride-app/payments-service/internal/repositories/payout"
 	rr "github.com/ride-app/payments-service/internal/repositories/recharge"
 	tr "github.com/ride-app/payments-service/internal/repositories/transfer"
@@ -12,16 +15,21 @@ import (
 )
 
 type PaymentsServiceServer struct {
-	logger             logger.Logger
-	config             *config.Config
-	authRepository     ar.AuthRepository
-	walletRepository   wr.WalletRepository
-	transferRepository tr.TransferRepository
-	rechargeRepository rr.RechargeRepository
-	payoutRepository   pr.PayoutRepository
-	razorpay           *razorpay.Client
+	logger                logger.Logger
+	config                *config.Config
+	authRepository        ar.AuthRepository
+	walletRepository      wr.WalletRepository
+	transferRepository    tr.TransferRepository
+	rechargeRepository    rr.RechargeRepository
+	payoutRepository      pr.PayoutRepository
+	idempotencyRepository ir.IdempotencyRepository
+	paymentGateway        gateway.PaymentGateway
 }
 
+// ErrNoPageTokenSecret is returned by New in production when no secret is configured to sign page tokens with,
+// since page tokens signed with an empty secret can be forged.
+var ErrNoPageTokenSecret = errors.New("page token secret is not set")
+
 func New(
 	logger logger.Logger,
 	config *config.Config,
@@ -30,16 +38,22 @@ func New(
 	transferRepository tr.TransferRepository,
 	rechargeRepository rr.RechargeRepository,
 	payoutRepository pr.PayoutRepository,
-	razorpay *razorpay.Client,
-) *PaymentsServiceServer {
-	return &PaymentsServiceServer{
-		logger:             logger,
-		config:             config,
-		authRepository:     authRepository,
-		walletRepository:   walletRepository,
-		transferRepository: transferRepository,
-		payoutRepository:   payoutRepository,
-		rechargeRepository: rechargeRepository,
-		razorpay:           razorpay,
+	idempotencyRepository ir.IdempotencyRepository,
+	paymentGateway gateway.PaymentGateway,
+) (*PaymentsServiceServer, error) {
+	if config.Production && config.PageTokenSecret == "" {
+		return nil, ErrNoPageTokenSecret
 	}
+
+	return &PaymentsServiceServer{
+		logger:                logger,
+		config:                config,
+		authRepository:        authRepository,
+		walletRepository:      walletRepository,
+		transferRepository:    transferRepository,
+		payoutRepository:      payoutRepository,
+		rechargeRepository:    rechargeRepository,
+		idempotencyRepository: idempotencyRepository,
+		paymentGateway:        paymentGateway,
+	}, nil
 }
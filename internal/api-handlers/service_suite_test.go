@@ -2,12 +2,84 @@ package apihandlers_test
 
 import (
 	"testing"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/config"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	mock_gateway "github.com/ride-app/payments-service/internal/gateway/mock"
+	mock_auth "github.com/ride-app/payments-service/internal/repositories/auth/mock"
+	mock_idempotency "github.com/ride-app/payments-service/internal/repositories/idempotency/mock"
+	mock_payout "github.com/ride-app/payments-service/internal/repositories/payout/mock"
+	mock_recharge "github.com/ride-app/payments-service/internal/repositories/recharge/mock"
+	mock_transfer "github.com/ride-app/payments-service/internal/repositories/transfer/mock"
+	mock_wallet "github.com/ride-app/payments-service/internal/repositories/wallet/mock"
 )
 
 func TestService(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Service Suite")
 }
+
+// trustedCaller is the uid of the internal service allowed to call internal rpcs in the specs.
+const trustedCaller = "trusted-service"
+
+// mocks holds the mocked dependencies of the service under test.
+type mocks struct {
+	auth        *mock_auth.MockAuthRepository
+	wallet      *mock_wallet.MockWalletRepository
+	transfer    *mock_transfer.MockTransferRepository
+	recharge    *mock_recharge.MockRechargeRepository
+	payout      *mock_payout.MockPayoutRepository
+	idempotency *mock_idempotency.MockIdempotencyRepository
+	gateway     *mock_gateway.MockPaymentGateway
+}
+
+// newService returns a service backed by mocks. Leases of request ids are not renewed, so the idempotency mock only
+// sees the calls of a spec.
+func newService() (*apihandlers.PaymentsServiceServer, *mocks) {
+	ctrl := gomock.NewController(GinkgoT())
+
+	m := &mocks{
+		auth:        mock_auth.NewMockAuthRepository(ctrl),
+		wallet:      mock_wallet.NewMockWalletRepository(ctrl),
+		transfer:    mock_transfer.NewMockTransferRepository(ctrl),
+		recharge:    mock_recharge.NewMockRechargeRepository(ctrl),
+		payout:      mock_payout.NewMockPayoutRepository(ctrl),
+		idempotency: mock_idempotency.NewMockIdempotencyRepository(ctrl),
+		gateway:     mock_gateway.NewMockPaymentGateway(ctrl),
+	}
+
+	service, err := apihandlers.New(
+		logger.New(true, false),
+		&config.Config{
+			Production:          false,
+			TrustedCallers:      []string{trustedCaller},
+			SupportedCurrencies: []string{"INR", "USD"},
+			HoldTTL:             time.Hour,
+		},
+		m.auth,
+		m.wallet,
+		m.transfer,
+		m.recharge,
+		m.payout,
+		m.idempotency,
+		m.gateway,
+	)
+
+	Expect(err).NotTo(HaveOccurred())
+
+	return service, m
+}
+
+// trusted returns a request made by the trusted caller.
+func trusted[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("uid", trustedCaller)
+
+	return req
+}
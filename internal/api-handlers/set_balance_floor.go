@@ -0,0 +1,66 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) SetBalanceFloor(ctx context.Context, req *connect.Request[pb.SetBalanceFloorRequest]) (*connect.Response[pb.SetBalanceFloorResponse], error) {
+	return withIdempotency(ctx, service, "SetBalanceFloor", req, service.setBalanceFloor)
+}
+
+func (service *PaymentsServiceServer) setBalanceFloor(ctx context.Context, req *connect.Request[pb.SetBalanceFloorRequest]) (*connect.Response[pb.SetBalanceFloorResponse], error) {
+	log := service.logger.WithField("method", "SetBalanceFloor")
+	log.WithField("request", req.Msg).Debug("Received SetBalanceFloor request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Setting balance floor")
+	wallet, err := service.walletRepository.SetBalanceFloor(ctx, log, userId, req.Msg.BalanceFloor)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to set balance floor")
+		return nil, setWalletStateError(err)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.SetBalanceFloorResponse{
+		Wallet: wallet,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned SetBalanceFloor response")
+	log.Info("Returning SetBalanceFloor response")
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/pagination"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"github.com/ride-app/payments-service/internal/statement"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statementPeriod returns the start and the end of the period of a statement request, either a month in IST or an interval.
+func statementPeriod(month string, period *interval.Interval) (time.Time, time.Time, error) {
+	if month != "" {
+		return statement.Month(month)
+	}
+
+	if period.GetStartTime() == nil || period.GetEndTime() == nil {
+		return time.Time{}, time.Time{}, errors.New("interval must have a start and an end time")
+	}
+
+	start, end := period.StartTime.AsTime(), period.EndTime.AsTime()
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, errors.New("interval must start before it ends")
+	}
+
+	return start, end, nil
+}
+
+// buildStatement builds the statement of a wallet in a currency from the transactions created from start until end.
+func (service *PaymentsServiceServer) buildStatement(ctx context.Context, log logger.Logger, userId string, currencyCode string, start time.Time, end time.Time) (*pb.Statement, error) {
+	openingBalance, err := service.openingBalance(ctx, log, userId, currencyCode, start)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, _, err := service.walletRepository.GetTransactions(ctx, log, userId, &walletrepository.Filter{
+		Conditions: []walletrepository.Condition{
+			{Path: "create_time", Operator: ">=", Value: start},
+			{Path: "create_time", Operator: "<", Value: end},
+		},
+		Ascending: true,
+	}, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.Statement{
+		Wallet:         "users/" + userId + "/wallet",
+		Interval:       &interval.Interval{StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)},
+		CurrencyCode:   currencyCode,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		Transactions:   make([]*pb.Transaction, 0, len(transactions)),
+	}
+
+	for _, transaction := range transactions {
+		if transaction.CurrencyCode != currencyCode {
+			continue
+		}
+
+		switch transaction.Type {
+		case pb.Transaction_TYPE_CREDIT:
+			result.TotalCredits += transaction.Amount
+			result.CreditCount++
+			result.ClosingBalance += transaction.Amount
+		case pb.Transaction_TYPE_DEBIT:
+			result.TotalDebits += transaction.Amount
+			result.DebitCount++
+			result.ClosingBalance -= transaction.Amount
+		}
+
+		result.Transactions = append(result.Transactions, transaction)
+	}
+
+	return result, nil
+}
+
+// openingBalance returns the balance of a wallet in a currency at a time. Transactions before the time are read newest
+// first until one with a recorded balance after it is found, and the amounts of the newer ones are added to that balance.
+// Wallets whose older transactions have no recorded balances are summed from their first transaction.
+func (service *PaymentsServiceServer) openingBalance(ctx context.Context, log logger.Logger, userId string, currencyCode string, at time.Time) (int64, error) {
+	filter := &walletrepository.Filter{
+		Conditions: []walletrepository.Condition{
+			{Path: "create_time", Operator: "<", Value: at},
+		},
+	}
+
+	var balance int64
+	page := pagination.NewPage(pagination.MaxPageSize, nil)
+
+	for {
+		transactions, next, err := service.walletRepository.GetTransactions(ctx, log, userId, filter, page)
+
+		if err != nil {
+			return 0, err
+		}
+
+		for _, transaction := range transactions {
+			if transaction.CurrencyCode != currencyCode {
+				continue
+			}
+
+			if transaction.BalanceAfter != nil {
+				return *transaction.BalanceAfter + balance, nil
+			}
+
+			if transaction.Type == pb.Transaction_TYPE_DEBIT {
+				balance -= transaction.Amount
+			} else {
+				balance += transaction.Amount
+			}
+		}
+
+		if next == nil {
+			return balance, nil
+		}
+
+		page = pagination.NewPage(pagination.MaxPageSize, next)
+	}
+}
@@ -0,0 +1,68 @@
+package apihandlers_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+)
+
+var _ = Describe("Statements", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+	})
+
+	Describe("GetStatement", func() {
+		var req *connect.Request[pb.GetStatementRequest]
+
+		BeforeEach(func() {
+			req = connect.NewRequest(&pb.GetStatementRequest{
+				Wallet: "users/user1/wallet",
+				Period: &pb.GetStatementRequest_Month{Month: "2024-05"},
+			})
+		})
+
+		It("lets the authenticated owner read the statement", func() {
+			req.Header().Set("uid", "user1")
+			m.wallet.EXPECT().GetWallet(gomock.Any(), gomock.Any(), "user1").Return(nil, nil)
+
+			_, err := service.GetStatement(context.Background(), req)
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+
+		It("ignores a user_id header sent by the client", func() {
+			req.Header().Set("uid", "user2")
+			req.Header().Set("user_id", "user1")
+
+			_, err := service.GetStatement(context.Background(), req)
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+		})
+	})
+
+	Describe("ExportStatement", func() {
+		It("ignores a user_id header sent by the client", func() {
+			req := connect.NewRequest(&pb.ExportStatementRequest{
+				Wallet: "users/user1/wallet",
+				Period: &pb.ExportStatementRequest_Month{Month: "2024-05"},
+				Format: pb.ExportStatementRequest_FORMAT_CSV,
+			})
+			req.Header().Set("uid", "user2")
+			req.Header().Set("user_id", "user1")
+
+			err := service.ExportStatement(context.Background(), req, nil)
+
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+		})
+	})
+})
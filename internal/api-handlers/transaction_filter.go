@@ -0,0 +1,95 @@
+package apihandlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/filtering"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+// transactionFilterFields maps the filterable fields of a transaction to their document paths.
+var transactionFilterFields = map[string]string{
+	"type":              "type",
+	"batch_id":          "batch_id",
+	"currency_code":     "currency_code",
+	"details.reference": "details.reference",
+	"create_time":       "create_time",
+}
+
+// firestoreOperators maps AIP-160 comparators to Firestore operators.
+var firestoreOperators = map[string]string{
+	"=":  "==",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+// transactionFilter converts the filter and order_by of a ListTransactions request into a repository filter.
+func transactionFilter(filter string, orderBy string) (*walletrepository.Filter, error) {
+	restrictions, err := filtering.Parse(filter)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	result := &walletrepository.Filter{}
+
+	for _, restriction := range restrictions {
+		path, ok := transactionFilterFields[restriction.Field]
+
+		if !ok {
+			return nil, fmt.Errorf("unsupported filter field: %s", restriction.Field)
+		}
+
+		operator, ok := firestoreOperators[restriction.Operator]
+
+		if !ok || (operator != "==" && restriction.Field != "create_time") {
+			return nil, fmt.Errorf("unsupported operator %s for field %s", restriction.Operator, restriction.Field)
+		}
+
+		var value interface{} = restriction.Value
+
+		switch restriction.Field {
+		case "type":
+			transactionType := strings.TrimPrefix(restriction.Value, "TYPE_")
+
+			if _, ok := pb.Transaction_Type_value["TYPE_"+transactionType]; !ok || transactionType == "UNSPECIFIED" {
+				return nil, fmt.Errorf("invalid transaction type: %s", restriction.Value)
+			}
+
+			value = "TYPE_" + transactionType
+		case "create_time":
+			createTime, err := time.Parse(time.RFC3339, restriction.Value)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid create_time: %w", err)
+			}
+
+			value = createTime
+		}
+
+		result.Conditions = append(result.Conditions, walletrepository.Condition{
+			Path:     path,
+			Operator: operator,
+			Value:    value,
+		})
+	}
+
+	orders, err := filtering.ParseOrderBy(orderBy)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_by: %w", err)
+	}
+
+	if len(orders) > 1 || (len(orders) == 1 && orders[0].Field != "create_time") {
+		return nil, fmt.Errorf("unsupported order_by: %s", orderBy)
+	}
+
+	result.Ascending = len(orders) == 1 && !orders[0].Descending
+
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package apihandlers
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+func (service *PaymentsServiceServer) UnfreezeWallet(ctx context.Context, req *connect.Request[pb.UnfreezeWalletRequest]) (*connect.Response[pb.UnfreezeWalletResponse], error) {
+	return withIdempotency(ctx, service, "UnfreezeWallet", req, service.unfreezeWallet)
+}
+
+func (service *PaymentsServiceServer) unfreezeWallet(ctx context.Context, req *connect.Request[pb.UnfreezeWalletRequest]) (*connect.Response[pb.UnfreezeWalletResponse], error) {
+	log := service.logger.WithField("method", "UnfreezeWallet")
+	log.WithField("request", req.Msg).Debug("Received UnfreezeWallet request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Unfreezing wallet")
+	wallet, err := service.walletRepository.SetWalletState(ctx, log, userId, pb.Wallet_STATE_ACTIVE, []pb.Wallet_State{pb.Wallet_STATE_FROZEN}, req.Msg.Reason)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to unfreeze wallet")
+		return nil, setWalletStateError(err)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.UnfreezeWalletResponse{
+		Wallet: wallet,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned UnfreezeWallet response")
+	log.Info("Returning UnfreezeWallet response")
+	return res, nil
+}
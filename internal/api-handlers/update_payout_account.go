@@ -0,0 +1,123 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+func (service *PaymentsServiceServer) UpdatePayoutAccount(ctx context.Context, req *connect.Request[pb.UpdatePayoutAccountRequest]) (*connect.Response[pb.UpdatePayoutAccountResponse], error) {
+	return withIdempotency(ctx, service, "UpdatePayoutAccount", req, service.updatePayoutAccount)
+}
+
+func (service *PaymentsServiceServer) updatePayoutAccount(ctx context.Context, req *connect.Request[pb.UpdatePayoutAccountRequest]) (*connect.Response[pb.UpdatePayoutAccountResponse], error) {
+	log := service.logger.WithField("method", "UpdatePayoutAccount")
+	log.WithField("request", req.Msg).Debug("Received UpdatePayoutAccount request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Validating update mask")
+	if err := validateDestinationMask(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid update mask")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.PayoutAccount.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Checking if caller owns the payout account or is trusted")
+	if userId != req.Header().Get("uid") && !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller can not update the payout account")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Fetching payout account")
+	payoutAccount, err := service.payoutRepository.GetPayoutAccount(ctx, log, userId)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch payout account")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("payout account", err))
+	}
+
+	if payoutAccount == nil {
+		log.Error("Payout account not found")
+		return nil, connect.NewError(connect.CodeNotFound, notFoundError("payout account"))
+	}
+
+	updated := proto.Clone(payoutAccount).(*pb.PayoutAccount)
+	updated.Destination = req.Msg.PayoutAccount.Destination
+
+	if proto.Equal(updated, payoutAccount) {
+		log.Info("Destination unchanged, skipping update")
+	} else {
+		log.Info("Updating payout account")
+		payoutAccount, err = service.payoutRepository.UpdatePayoutAccount(ctx, log, updated)
+
+		if err != nil {
+			log.WithError(err).Error("Failed to update payout account")
+			return nil, connect.NewError(connect.CodeInternal, failedToUpdateError("payout account", err))
+		}
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.UpdatePayoutAccountResponse{
+		PayoutAccount: payoutAccount,
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned UpdatePayoutAccount response")
+	log.Info("Returning UpdatePayoutAccount response")
+	return res, nil
+}
+
+// validateDestinationMask checks that an update only changes the destination of a payout account,
+// and that the destination named by the update mask is the one set on the payout account.
+func validateDestinationMask(req *pb.UpdatePayoutAccountRequest) error {
+	var destination string
+
+	switch req.PayoutAccount.Destination.(type) {
+	case *pb.PayoutAccount_BankAccount_:
+		destination = "bank_account"
+	case *pb.PayoutAccount_UpiId:
+		destination = "upi_id"
+	default:
+		return errors.New("payout_account.destination is required")
+	}
+
+	for _, path := range req.UpdateMask.GetPaths() {
+		if path != "bank_account" && path != "upi_id" {
+			return fmt.Errorf("field %s can not be updated", path)
+		}
+
+		if path != destination {
+			return fmt.Errorf("update mask names %s but payout_account sets %s", path, destination)
+		}
+	}
+
+	return nil
+}
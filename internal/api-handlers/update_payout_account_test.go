@@ -0,0 +1,63 @@
+package apihandlers_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+)
+
+var _ = Describe("UpdatePayoutAccount", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+		req     *connect.Request[pb.UpdatePayoutAccountRequest]
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+
+		req = connect.NewRequest(&pb.UpdatePayoutAccountRequest{
+			PayoutAccount: &pb.PayoutAccount{
+				Name:         "users/driver1/wallet/payout-account",
+				CurrencyCode: "INR",
+				Destination:  &pb.PayoutAccount_UpiId{UpiId: "driver1.new@upi"},
+			},
+		})
+	})
+
+	It("rejects callers that do not own the payout account", func() {
+		req.Header().Set("uid", "user2")
+
+		_, err := service.UpdatePayoutAccount(context.Background(), req)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("updates the destination of the payout account of the authenticated owner", func() {
+		req.Header().Set("uid", "driver1")
+
+		current := &pb.PayoutAccount{
+			Name:         "users/driver1/wallet/payout-account",
+			CurrencyCode: "INR",
+			Destination:  &pb.PayoutAccount_UpiId{UpiId: "driver1@upi"},
+		}
+
+		m.payout.EXPECT().GetPayoutAccount(gomock.Any(), gomock.Any(), "driver1").Return(current, nil)
+		m.payout.EXPECT().UpdatePayoutAccount(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ logger.Logger, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error) {
+				return payoutAccount, nil
+			},
+		)
+
+		res, err := service.UpdatePayoutAccount(context.Background(), req)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.PayoutAccount.GetUpiId()).To(Equal("driver1.new@upi"))
+	})
+})
@@ -0,0 +1,88 @@
+package apihandlers
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+// verifyLedgerScope binds VerifyLedger page tokens to the list of wallets.
+const verifyLedgerScope = "users/-/wallet:verifyLedger"
+
+func (service *PaymentsServiceServer) VerifyLedger(ctx context.Context, req *connect.Request[pb.VerifyLedgerRequest]) (*connect.Response[pb.VerifyLedgerResponse], error) {
+	log := service.logger.WithField("method", "VerifyLedger")
+	log.WithField("request", req.Msg).Debug("Received VerifyLedger request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Checking if caller is trusted")
+	if !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller is not trusted")
+		return nil, connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	log.Info("Decoding page token")
+	page, err := service.page(verifyLedgerScope, req.Msg.PageSize, req.Msg.PageToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid page token")
+		return nil, connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Fetching wallet ids")
+	userIds, next, err := service.walletRepository.GetWalletIds(ctx, log, page)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to fetch wallet ids")
+		return nil, connect.NewError(connect.CodeInternal, failedToFetchError("wallets", err))
+	}
+
+	discrepancies := make([]*pb.LedgerDiscrepancy, 0)
+
+	for _, userId := range userIds {
+		log.Debugf("Verifying wallet of user %s", userId)
+		walletDiscrepancies, err := service.walletRepository.VerifyWallet(ctx, log, userId, req.Msg.Repair)
+
+		if err != nil {
+			log.WithError(err).Error("Failed to verify wallet")
+			return nil, connect.NewError(connect.CodeInternal, failedToFetchError("wallet", err))
+		}
+
+		for _, discrepancy := range walletDiscrepancies {
+			log.WithField("discrepancy", discrepancy).Warn("Wallet balance does not match its transactions")
+		}
+
+		discrepancies = append(discrepancies, walletDiscrepancies...)
+	}
+
+	log.Info("Creating response message")
+	res := connect.NewResponse(&pb.VerifyLedgerResponse{
+		Discrepancies: discrepancies,
+		VerifiedCount: int32(len(userIds)),
+		NextPageToken: service.nextPageToken(verifyLedgerScope, next),
+	})
+
+	log.Info("Validating response message")
+	if err := validator.Validate(res.Msg); err != nil {
+		log.WithError(err).Error("Invalid response")
+		return nil, connect.NewError(connect.CodeInternal, invalidResponseError(err))
+	}
+
+	defer log.WithField("response", res.Msg).Debug("Returned VerifyLedger response")
+	log.Info("Returning VerifyLedger response")
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package apihandlers_test
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+	"github.com/ride-app/payments-service/internal/pagination"
+)
+
+var _ = Describe("VerifyLedger", func() {
+	var (
+		service *apihandlers.PaymentsServiceServer
+		m       *mocks
+	)
+
+	BeforeEach(func() {
+		service, m = newService()
+	})
+
+	It("returns the discrepancies of a page of wallets", func() {
+		m.wallet.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user1", "user2"}, nil, nil)
+		m.wallet.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user1", false).Return(nil, nil)
+		m.wallet.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user2", false).Return([]*pb.LedgerDiscrepancy{
+			{Wallet: "users/user2/wallet", CurrencyCode: "INR", RecordedBalance: 800, ComputedBalance: 500, Difference: 300},
+		}, nil)
+
+		res, err := service.VerifyLedger(context.Background(), trusted(&pb.VerifyLedgerRequest{}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.VerifiedCount).To(Equal(int32(2)))
+		Expect(res.Msg.Discrepancies).To(HaveLen(1))
+		Expect(res.Msg.Discrepancies[0].Wallet).To(Equal("users/user2/wallet"))
+		Expect(res.Msg.Discrepancies[0].Repaired).To(BeFalse())
+		Expect(res.Msg.NextPageToken).To(BeEmpty())
+	})
+
+	It("repairs the wallets when asked to", func() {
+		m.wallet.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user1"}, nil, nil)
+		m.wallet.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user1", true).Return([]*pb.LedgerDiscrepancy{
+			{Wallet: "users/user1/wallet", CurrencyCode: "INR", Difference: 300, Repaired: true},
+		}, nil)
+
+		res, err := service.VerifyLedger(context.Background(), trusted(&pb.VerifyLedgerRequest{Repair: true}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.Discrepancies[0].Repaired).To(BeTrue())
+	})
+
+	It("returns a token for the next page of wallets", func() {
+		m.wallet.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user1"}, &pagination.Cursor{Id: "user1"}, nil)
+		m.wallet.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user1", false).Return(nil, nil)
+
+		res, err := service.VerifyLedger(context.Background(), trusted(&pb.VerifyLedgerRequest{PageSize: 1}))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Msg.NextPageToken).NotTo(BeEmpty())
+	})
+
+	It("rejects callers that are not trusted", func() {
+		req := connect.NewRequest(&pb.VerifyLedgerRequest{})
+		req.Header().Set("uid", "user1")
+
+		_, err := service.VerifyLedger(context.Background(), req)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("fails when a wallet can not be verified", func() {
+		m.wallet.EXPECT().GetWalletIds(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{"user1"}, nil, nil)
+		m.wallet.EXPECT().VerifyWallet(gomock.Any(), gomock.Any(), "user1", false).Return(nil, errors.New("deadline exceeded"))
+
+		_, err := service.VerifyLedger(context.Background(), trusted(&pb.VerifyLedgerRequest{}))
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeInternal))
+	})
+})
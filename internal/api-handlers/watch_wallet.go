@@ -0,0 +1,100 @@
+package apihandlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/pagination"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+func (service *PaymentsServiceServer) WatchWallet(ctx context.Context, req *connect.Request[pb.WatchWalletRequest], stream *connect.ServerStream[pb.WatchWalletResponse]) error {
+	log := service.logger.WithField("method", "WatchWallet")
+	log.WithField("request", req.Msg).Debug("Received WatchWallet request")
+
+	validator, err := protovalidate.New()
+	if err != nil {
+		log.WithError(err).Info("Failed to initialize validator")
+
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	log.Info("Validating request")
+	if err := validator.Validate(req.Msg); err != nil {
+		log.WithError(err).Info("Invalid request")
+
+		return connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	log.Info("Extracting user id from request message")
+	userId := strings.Split(req.Msg.Name, "/")[1]
+	log.Debugf("User id: %s", userId)
+
+	log.Info("Checking if caller owns the wallet or is trusted")
+	if userId != req.Header().Get("uid") && !service.isTrustedCaller(req.Header()) {
+		log.Warn("Caller can not watch the wallet")
+		return connect.NewError(connect.CodePermissionDenied, permissionDeniedError())
+	}
+
+	// Resume tokens are signed like page tokens and bound to the watched wallet.
+	scope := req.Msg.Name + ":watch"
+
+	log.Info("Decoding resume token")
+	after, err := pagination.DecodeToken(service.config.PageTokenSecret, scope, req.Msg.ResumeToken)
+
+	if err != nil {
+		log.WithError(err).Info("Invalid resume token")
+		return connect.NewError(connect.CodeInvalidArgument, invalidArgumentError(err))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan *walletrepository.WalletEvent)
+	errs := make(chan error, 1)
+
+	log.Info("Watching wallet")
+	go func() {
+		errs <- service.walletRepository.WatchWallet(ctx, log, userId, after, events)
+	}()
+
+	resumeToken := req.Msg.ResumeToken
+
+	for {
+		select {
+		case event := <-events:
+			res := &pb.WatchWalletResponse{}
+
+			if event.Wallet != nil {
+				res.Event = &pb.WatchWalletResponse_Wallet{Wallet: event.Wallet}
+			} else {
+				resumeToken = pagination.EncodeToken(service.config.PageTokenSecret, scope, event.Cursor)
+				res.Event = &pb.WatchWalletResponse_Transaction{Transaction: event.Transaction}
+			}
+
+			res.ResumeToken = resumeToken
+
+			if err := stream.Send(res); err != nil {
+				log.WithError(err).Info("Failed to send event, client disconnected")
+				return err
+			}
+		case err := <-errs:
+			if errors.Is(err, walletrepository.ErrWalletNotFound) {
+				log.Info("Wallet not found")
+				return connect.NewError(connect.CodeNotFound, notFoundError("wallet"))
+			}
+
+			if ctx.Err() != nil {
+				log.Info("Client stopped watching")
+				return nil
+			}
+
+			log.WithError(err).Error("Failed to watch wallet")
+			return connect.NewError(connect.CodeUnavailable, failedToFetchError("wallet", err))
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package apihandlers_test
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	apihandlers "github.com/ride-app/payments-service/internal/api-handlers"
+)
+
+var _ = Describe("WatchWallet", func() {
+	var service *apihandlers.PaymentsServiceServer
+
+	BeforeEach(func() {
+		service, _ = newService()
+	})
+
+	It("ignores a user_id header sent by the client", func() {
+		req := connect.NewRequest(&pb.WatchWalletRequest{Name: "users/user1/wallet"})
+		req.Header().Set("uid", "user2")
+		req.Header().Set("user_id", "user1")
+
+		err := service.WatchWallet(context.Background(), req, nil)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("rejects callers that are not signed in", func() {
+		req := connect.NewRequest(&pb.WatchWalletRequest{Name: "users/user1/wallet"})
+
+		err := service.WatchWallet(context.Background(), req, nil)
+
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+})
@@ -0,0 +1,51 @@
+package eventhandlers_test
+
+import (
+	"testing"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/config"
+	eventhandlers "github.com/ride-app/payments-service/internal/event-handlers"
+	mock_idempotency "github.com/ride-app/payments-service/internal/repositories/idempotency/mock"
+	mock_wallet "github.com/ride-app/payments-service/internal/repositories/wallet/mock"
+)
+
+func TestEventHandlers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Event Handlers Suite")
+}
+
+// mocks holds the mocked dependencies of the event handler under test.
+type mocks struct {
+	wallet      *mock_wallet.MockWalletRepository
+	idempotency *mock_idempotency.MockIdempotencyRepository
+}
+
+// newHandler returns an event handler backed by mocks. Leases of ride ids are not renewed, so the idempotency mock
+// only sees the calls of a spec.
+func newHandler() (*eventhandlers.EventHandler, *mocks) {
+	ctrl := gomock.NewController(GinkgoT())
+
+	m := &mocks{
+		wallet:      mock_wallet.NewMockWalletRepository(ctrl),
+		idempotency: mock_idempotency.NewMockIdempotencyRepository(ctrl),
+	}
+
+	handler := eventhandlers.New(
+		logger.New(true, false),
+		&config.Config{
+			SupportedCurrencies: []string{"INR", "USD"},
+			PlatformWalletId:    "platform",
+			TaxWalletId:         "tax",
+			RideCommissionBps:   2000,
+			RideTaxBps:          500,
+		},
+		m.wallet,
+		m.idempotency,
+	)
+
+	return handler, m
+}
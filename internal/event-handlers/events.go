@@ -0,0 +1,53 @@
+package eventhandlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// pubSubMessagePublished is the type of CloudEvents that deliver Pub/Sub messages.
+const pubSubMessagePublished = "google.cloud.pubsub.topic.v1.messagePublished"
+
+// pubSubEnvelope is the data of a Pub/Sub CloudEvent. The data of the message is base64 encoded JSON.
+type pubSubEnvelope struct {
+	Message struct {
+		Data       []byte            `json:"data"`
+		Attributes map[string]string `json:"attributes"`
+		MessageId  string            `json:"messageId"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
+}
+
+// decodeEvent unmarshals the JSON data of a CloudEvent delivered in binary content mode.
+// Pub/Sub messages are unwrapped so that the same handler can be triggered directly or through a topic.
+func decodeEvent(r *http.Request, v interface{}) error {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		return err
+	}
+
+	body, err = unwrapEvent(r.Header, body)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// unwrapEvent returns the data of the Pub/Sub message delivered by a Pub/Sub CloudEvent, or the body of any other event.
+func unwrapEvent(header http.Header, body []byte) ([]byte, error) {
+	if header.Get("Ce-Type") != pubSubMessagePublished {
+		return body, nil
+	}
+
+	var envelope pubSubEnvelope
+
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, err
+	}
+
+	return envelope.Message.Data, nil
+}
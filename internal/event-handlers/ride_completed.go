@@ -0,0 +1,287 @@
+package eventhandlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/fare"
+	"github.com/ride-app/payments-service/internal/money"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+	"github.com/thoas/go-funk"
+)
+
+// rideSettlementCaller is the caller idempotency keys of ride settlements are reserved for.
+const rideSettlementCaller = "ride-service"
+
+var (
+	// ErrInvalidRide is returned when a ride completed event can never be settled as it is.
+	ErrInvalidRide = errors.New("invalid ride")
+
+	// errRideInProgress is returned when another delivery of the same ride is still being settled.
+	errRideInProgress = errors.New("ride is already being settled")
+
+	idPattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+)
+
+// rideCompleted is the data of a ride completed event published by the ride service.
+type rideCompleted struct {
+	RideId   string `json:"ride_id"`
+	RiderId  string `json:"rider_id"`
+	DriverId string `json:"driver_id"`
+
+	// Fare is the fare of the ride before taxes, in the smallest unit of the currency.
+	Fare int64 `json:"fare"`
+
+	CurrencyCode string `json:"currency_code"`
+}
+
+// RideCompleted settles the fare of a completed ride.
+// It responds with a non 2xx status when the event should be redelivered, and events that keep failing are dead-lettered.
+func (handler *EventHandler) RideCompleted(w http.ResponseWriter, r *http.Request) {
+	log := handler.logger.WithField("method", "RideCompleted")
+	log.WithField("id", r.Header.Get("Ce-Id")).Debug("Received RideCompleted event")
+
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to read event")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	body, err = unwrapEvent(r.Header, body)
+
+	if err != nil {
+		log.WithError(err).Error("Failed to parse event")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = handler.SettleRide(r.Context(), log, body)
+
+	if errors.Is(err, ErrInvalidRide) {
+		log.WithError(err).Error("Invalid ride")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to settle ride")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.Debug("Handled RideCompleted event")
+	w.WriteHeader(http.StatusOK)
+}
+
+// SettleRide posts the fare split of a ride completed event to the wallets of the rider, the driver, the platform and
+// the tax authority in a single batch. A ride is settled at most once, so duplicate and replayed events are acknowledged
+// without posting again.
+func (handler *EventHandler) SettleRide(ctx context.Context, log logger.Logger, data []byte) error {
+	var ride rideCompleted
+
+	if err := json.Unmarshal(data, &ride); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRide, err)
+	}
+
+	ride.CurrencyCode = money.CurrencyCode(ride.CurrencyCode)
+
+	if err := handler.validateRide(&ride); err != nil {
+		return err
+	}
+
+	log = log.WithField("ride_id", ride.RideId)
+	reference := "rides/" + ride.RideId
+
+	key := idempotencyrepository.Key{
+		Method:    "RideCompleted",
+		Caller:    rideSettlementCaller,
+		RequestId: ride.RideId,
+	}
+
+	// The parsed ride is hashed so that republishing the same ride with its fields in another order is not a conflict.
+	normalized, err := json.Marshal(&ride)
+
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(normalized)
+	requestHash := hex.EncodeToString(hash[:])
+
+	log.Info("Reserving ride id")
+	record, err := handler.idempotencyRepository.Reserve(ctx, log, &key, requestHash)
+
+	if err != nil {
+		return err
+	}
+
+	if record != nil {
+		if record.Response == nil {
+			return errRideInProgress
+		}
+
+		if record.RequestHash != requestHash {
+			return fmt.Errorf("%w: ride %s was already settled with a different fare", ErrInvalidRide, ride.RideId)
+		}
+
+		log.Info("Ride already settled")
+		return nil
+	}
+
+	renewCtx, stopRenewing := context.WithCancel(ctx)
+	go handler.renewLease(renewCtx, log, &key)
+
+	log.Info("Creating fare transactions")
+	id, err := handler.walletRepository.CreateTransactions(ctx, log, handler.fareEntries(&ride, reference))
+	stopRenewing()
+
+	var batchId string
+
+	// Idempotency keys expire, so replays of old events are caught by the transaction ids derived from the ride id.
+	if errors.Is(err, walletrepository.ErrTransactionExists) {
+		log.Info("Ride already settled")
+		transaction, err := handler.walletRepository.GetTransaction(ctx, log, ride.RiderId, fareTransactionId(ride.RideId, "rider"))
+
+		if err != nil {
+			handler.releaseRide(ctx, log, &key)
+			return err
+		}
+
+		batchId = transaction.GetBatchId()
+	} else if err != nil {
+		handler.releaseRide(ctx, log, &key)
+		return err
+	} else {
+		batchId = *id
+	}
+
+	log.Info("Storing batch id for ride id")
+	if err := handler.idempotencyRepository.Complete(ctx, log, &key, []byte(batchId)); err != nil {
+		// The fare has already been posted, so a redelivery finds it by its transaction ids.
+		log.WithError(err).Error("Failed to store batch id")
+	}
+
+	log.Infof("Settled ride in batch %s", batchId)
+	return nil
+}
+
+// validateRide checks that a ride can be settled with the current configuration.
+func (handler *EventHandler) validateRide(ride *rideCompleted) error {
+	for name, id := range map[string]string{"ride_id": ride.RideId, "rider_id": ride.RiderId, "driver_id": ride.DriverId} {
+		if !idPattern.MatchString(id) {
+			return fmt.Errorf("%w: invalid %s %q", ErrInvalidRide, name, id)
+		}
+	}
+
+	if ride.RiderId == ride.DriverId {
+		return fmt.Errorf("%w: rider and driver are the same user", ErrInvalidRide)
+	}
+
+	if ride.Fare <= 0 {
+		return fmt.Errorf("%w: fare must be greater than 0", ErrInvalidRide)
+	}
+
+	if !funk.ContainsString(handler.config.SupportedCurrencies, ride.CurrencyCode) {
+		return fmt.Errorf("%w: unsupported currency %s", ErrInvalidRide, ride.CurrencyCode)
+	}
+
+	// Misconfigured splits are not invalid rides, they are retried and dead-lettered until the configuration is fixed.
+	if handler.config.PlatformWalletId == "" || handler.config.TaxWalletId == "" {
+		return errors.New("platform and tax wallets are not configured")
+	}
+
+	if handler.config.RideCommissionBps < 0 || handler.config.RideCommissionBps > 10000 || handler.config.RideTaxBps < 0 {
+		return errors.New("ride commission or tax rate is out of range")
+	}
+
+	return nil
+}
+
+// fareEntries returns the transactions of the fare split of a ride. Legs that round to 0 are left out.
+func (handler *EventHandler) fareEntries(ride *rideCompleted, reference string) *walletrepository.Entries {
+	split := fare.NewSplit(ride.Fare, handler.config.RideCommissionBps, handler.config.RideTaxBps)
+
+	legs := []struct {
+		name        string
+		userId      string
+		amount      int64
+		kind        pb.Transaction_Type
+		displayName string
+	}{
+		{"rider", ride.RiderId, split.RiderDebit, pb.Transaction_TYPE_DEBIT, "Ride fare"},
+		{"driver", ride.DriverId, split.DriverCredit, pb.Transaction_TYPE_CREDIT, "Ride earnings"},
+		{"commission", handler.config.PlatformWalletId, split.Commission, pb.Transaction_TYPE_CREDIT, "Ride commission"},
+		{"tax", handler.config.TaxWalletId, split.Tax, pb.Transaction_TYPE_CREDIT, "Ride tax"},
+	}
+
+	entries := walletrepository.Entries{}
+
+	for _, leg := range legs {
+		if leg.amount == 0 {
+			continue
+		}
+
+		entries = append(entries, &walletrepository.Entry{
+			UserId:        leg.userId,
+			TransactionId: fareTransactionId(ride.RideId, leg.name),
+			Transaction: &pb.Transaction{
+				Amount:       leg.amount,
+				Type:         leg.kind,
+				CurrencyCode: ride.CurrencyCode,
+				Details: &pb.Transaction_Details{
+					DisplayName: leg.displayName,
+					Reference:   reference,
+				},
+			},
+		})
+	}
+
+	return &entries
+}
+
+// fareTransactionId returns the id of a leg of the fare split of a ride, so that a ride can only be posted once.
+func fareTransactionId(rideId string, leg string) string {
+	return "ride-" + rideId + "-" + leg
+}
+
+// renewLease renews the lease of a ride id every third of the lease until ctx is done.
+func (handler *EventHandler) renewLease(ctx context.Context, log logger.Logger, key *idempotencyrepository.Key) {
+	if handler.config.IdempotencyLeaseTTL <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(handler.config.IdempotencyLeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := handler.idempotencyRepository.Renew(ctx, log, key); err != nil && ctx.Err() == nil {
+				log.WithError(err).Warn("Failed to renew lease of ride id")
+			}
+		}
+	}
+}
+
+// releaseRide frees the idempotency key of a ride that failed to settle so that a redelivery can settle it.
+func (handler *EventHandler) releaseRide(ctx context.Context, log logger.Logger, key *idempotencyrepository.Key) {
+	log.Info("Releasing ride id of failed settlement")
+	if err := handler.idempotencyRepository.Release(ctx, log, key); err != nil {
+		log.WithError(err).Error("Failed to release ride id")
+	}
+}
@@ -0,0 +1,87 @@
+package eventhandlers_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	eventhandlers "github.com/ride-app/payments-service/internal/event-handlers"
+	idempotencyrepository "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("SettleRide", func() {
+	var (
+		handler *eventhandlers.EventHandler
+		m       *mocks
+		log     logger.Logger
+		data    []byte
+	)
+
+	BeforeEach(func() {
+		handler, m = newHandler()
+		log = logger.New(true, false)
+		data = []byte(`{"ride_id":"ride1","rider_id":"rider1","driver_id":"driver1","fare":10000,"currency_code":"INR"}`)
+	})
+
+	It("posts every leg of the fare with a transaction id derived from the ride id", func() {
+		var entries *walletrepository.Entries
+		batchId := "batch1"
+
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.wallet.EXPECT().CreateTransactions(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ logger.Logger, e *walletrepository.Entries) (*string, error) {
+				entries = e
+				return &batchId, nil
+			},
+		)
+		m.idempotency.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any(), []byte("batch1")).Return(nil)
+
+		Expect(handler.SettleRide(context.Background(), log, data)).To(Succeed())
+
+		ids := []string{}
+		for _, entry := range *entries {
+			ids = append(ids, entry.TransactionId)
+		}
+
+		Expect(ids).To(Equal([]string{"ride-ride1-rider", "ride-ride1-driver", "ride-ride1-commission", "ride-ride1-tax"}))
+	})
+
+	It("acknowledges rides whose transactions already exist without posting again", func() {
+		batchId := "batch1"
+
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.wallet.EXPECT().CreateTransactions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, walletrepository.ErrTransactionExists)
+		m.wallet.EXPECT().GetTransaction(gomock.Any(), gomock.Any(), "rider1", "ride-ride1-rider").Return(&pb.Transaction{BatchId: &batchId}, nil)
+		m.idempotency.EXPECT().Complete(gomock.Any(), gomock.Any(), gomock.Any(), []byte("batch1")).Return(nil)
+
+		Expect(handler.SettleRide(context.Background(), log, data)).To(Succeed())
+	})
+
+	It("releases the ride id when the fare fails to post", func() {
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		m.wallet.EXPECT().CreateTransactions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("deadline exceeded"))
+		m.idempotency.EXPECT().Release(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		Expect(handler.SettleRide(context.Background(), log, data)).NotTo(Succeed())
+	})
+
+	It("retries rides that are still being settled", func() {
+		m.idempotency.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&idempotencyrepository.Record{}, nil)
+
+		err := handler.SettleRide(context.Background(), log, data)
+
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, eventhandlers.ErrInvalidRide)).To(BeFalse())
+	})
+
+	It("rejects rides without a fare", func() {
+		data = []byte(`{"ride_id":"ride1","rider_id":"rider1","driver_id":"driver1","fare":0,"currency_code":"INR"}`)
+
+		Expect(handler.SettleRide(context.Background(), log, data)).To(MatchError(eventhandlers.ErrInvalidRide))
+	})
+})
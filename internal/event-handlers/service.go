@@ -0,0 +1,29 @@
+package eventhandlers
+
+import (
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	ir "github.com/ride-app/payments-service/internal/repositories/idempotency"
+	wr "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+type EventHandler struct {
+	logger                logger.Logger
+	config                *config.Config
+	walletRepository      wr.WalletRepository
+	idempotencyRepository ir.IdempotencyRepository
+}
+
+func New(
+	logger logger.Logger,
+	config *config.Config,
+	walletRepository wr.WalletRepository,
+	idempotencyRepository ir.IdempotencyRepository,
+) *EventHandler {
+	return &EventHandler{
+		logger:                logger,
+		config:                config,
+		walletRepository:      walletRepository,
+		idempotencyRepository: idempotencyRepository,
+	}
+}
@@ -0,0 +1,47 @@
+package eventhandlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+// TransactionCreated applies the balance change of a transaction written by a legacy client to its wallet.
+// The transaction is read from its Firestore document, named by the subject of the event, for example "documents/transactions/transaction1".
+// It responds with a non 2xx status when the event should be redelivered.
+func (handler *EventHandler) TransactionCreated(w http.ResponseWriter, r *http.Request) {
+	log := handler.logger.WithField("method", "TransactionCreated")
+	log.WithField("id", r.Header.Get("Ce-Id")).Debug("Received TransactionCreated event")
+
+	substrings := strings.Split(r.Header.Get("Ce-Subject"), "/")
+
+	if len(substrings) != 3 || substrings[1] != "transactions" {
+		log.Errorf("Invalid event subject: %s", r.Header.Get("Ce-Subject"))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	transactionId := substrings[2]
+	log.Debugf("Transaction id: %s", transactionId)
+
+	log.Info("Reconciling transaction")
+	err := handler.walletRepository.ReconcileTransaction(r.Context(), log, transactionId)
+
+	if errors.Is(err, walletrepository.ErrInvalidTransaction) {
+		// Invalid transactions are never going to apply, so they are dropped instead of redelivered.
+		log.WithError(err).Error("Invalid transaction")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Error("Failed to reconcile transaction")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.Debug("Handled TransactionCreated event")
+	w.WriteHeader(http.StatusOK)
+}
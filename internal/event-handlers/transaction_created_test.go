@@ -0,0 +1,57 @@
+package eventhandlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventhandlers "github.com/ride-app/payments-service/internal/event-handlers"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("TransactionCreated", func() {
+	var (
+		handler *eventhandlers.EventHandler
+		m       *mocks
+	)
+
+	BeforeEach(func() {
+		handler, m = newHandler()
+	})
+
+	// deliver sends a Firestore event about the given document to the handler and returns the status it responded with.
+	deliver := func(subject string) int {
+		r := httptest.NewRequest(http.MethodPost, "/events/transaction-created", nil)
+		r.Header.Set("Ce-Subject", subject)
+
+		w := httptest.NewRecorder()
+		handler.TransactionCreated(w, r)
+
+		return w.Code
+	}
+
+	It("reconciles the transaction named by the subject", func() {
+		m.wallet.EXPECT().ReconcileTransaction(gomock.Any(), gomock.Any(), "transaction1").Return(nil)
+
+		Expect(deliver("documents/transactions/transaction1")).To(Equal(http.StatusOK))
+	})
+
+	It("drops events about other documents", func() {
+		Expect(deliver("documents/wallets/user1")).To(Equal(http.StatusBadRequest))
+	})
+
+	It("acknowledges transactions that can never be reconciled", func() {
+		m.wallet.EXPECT().ReconcileTransaction(gomock.Any(), gomock.Any(), "transaction1").Return(walletrepository.ErrInvalidTransaction)
+
+		Expect(deliver("documents/transactions/transaction1")).To(Equal(http.StatusOK))
+	})
+
+	It("asks for a redelivery when the transaction fails to be reconciled", func() {
+		m.wallet.EXPECT().ReconcileTransaction(gomock.Any(), gomock.Any(), "transaction1").Return(errors.New("aborted"))
+
+		Expect(deliver("documents/transactions/transaction1")).To(Equal(http.StatusInternalServerError))
+	})
+})
@@ -0,0 +1,44 @@
+package eventhandlers
+
+import (
+	"net/http"
+)
+
+type user struct {
+	Uid string `json:"uid"`
+}
+
+// UserCreated provisions the wallet of a new Firebase Auth user.
+// It responds with a non 2xx status when the event should be redelivered.
+func (handler *EventHandler) UserCreated(w http.ResponseWriter, r *http.Request) {
+	log := handler.logger.WithField("method", "UserCreated")
+	log.WithField("id", r.Header.Get("Ce-Id")).Debug("Received UserCreated event")
+
+	var user user
+
+	if err := decodeEvent(r, &user); err != nil {
+		log.WithError(err).Error("Failed to parse event")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if user.Uid == "" {
+		log.Error("Event has no uid")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	log.Debugf("User id: %s", user.Uid)
+
+	log.Info("Creating wallet")
+	wallet, err := handler.walletRepository.CreateWallet(r.Context(), log, user.Uid, "")
+
+	if err != nil {
+		log.WithError(err).Error("Failed to create wallet")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.WithField("wallet", wallet).Debug("Handled UserCreated event")
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,67 @@
+package eventhandlers_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	eventhandlers "github.com/ride-app/payments-service/internal/event-handlers"
+)
+
+var _ = Describe("UserCreated", func() {
+	var (
+		handler *eventhandlers.EventHandler
+		m       *mocks
+	)
+
+	BeforeEach(func() {
+		handler, m = newHandler()
+	})
+
+	// deliver sends an event with the given headers and body to the handler and returns the status it responded with.
+	deliver := func(header http.Header, body string) int {
+		r := httptest.NewRequest(http.MethodPost, "/events/user-created", strings.NewReader(body))
+		for name, values := range header {
+			r.Header[name] = values
+		}
+
+		w := httptest.NewRecorder()
+		handler.UserCreated(w, r)
+
+		return w.Code
+	}
+
+	It("provisions the wallet of the user of a direct event", func() {
+		m.wallet.EXPECT().CreateWallet(gomock.Any(), gomock.Any(), "user1", "").Return(&pb.Wallet{Name: "users/user1/wallet"}, nil)
+
+		Expect(deliver(http.Header{"Ce-Type": {"google.firebase.auth.user.v1.created"}}, `{"uid":"user1"}`)).To(Equal(http.StatusOK))
+	})
+
+	It("provisions the wallet of the user of a Pub/Sub message", func() {
+		data := base64.StdEncoding.EncodeToString([]byte(`{"uid":"user1"}`))
+		m.wallet.EXPECT().CreateWallet(gomock.Any(), gomock.Any(), "user1", "").Return(&pb.Wallet{Name: "users/user1/wallet"}, nil)
+
+		status := deliver(
+			http.Header{"Ce-Type": {"google.cloud.pubsub.topic.v1.messagePublished"}},
+			`{"message":{"data":"`+data+`","messageId":"1"},"subscription":"user-created"}`,
+		)
+
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("drops events without a uid", func() {
+		Expect(deliver(http.Header{}, `{}`)).To(Equal(http.StatusBadRequest))
+	})
+
+	It("asks for a redelivery when the wallet fails to be created", func() {
+		m.wallet.EXPECT().CreateWallet(gomock.Any(), gomock.Any(), "user1", "").Return(nil, errors.New("deadline exceeded"))
+
+		Expect(deliver(http.Header{}, `{"uid":"user1"}`)).To(Equal(http.StatusInternalServerError))
+	})
+})
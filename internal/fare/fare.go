@@ -0,0 +1,36 @@
+// Package fare splits the fare of a ride between the rider, the driver, the platform and the tax authority.
+package fare
+
+// Split is how the fare of a ride moves between wallets. RiderDebit always equals DriverCredit + Commission + Tax.
+type Split struct {
+	// RiderDebit is the fare plus taxes, paid by the rider.
+	RiderDebit int64
+
+	// DriverCredit is the fare less the platform commission, earned by the driver.
+	DriverCredit int64
+
+	// Commission is the share of the fare kept by the platform.
+	Commission int64
+
+	// Tax is charged to the rider on top of the fare.
+	Tax int64
+}
+
+// NewSplit splits a fare, in the smallest currency unit, with a commission and a tax rate in basis points.
+// Shares are rounded half up to the smallest currency unit.
+func NewSplit(fare int64, commissionBps int64, taxBps int64) *Split {
+	commission := share(fare, commissionBps)
+	tax := share(fare, taxBps)
+
+	return &Split{
+		RiderDebit:   fare + tax,
+		DriverCredit: fare - commission,
+		Commission:   commission,
+		Tax:          tax,
+	}
+}
+
+// share returns bps basis points of an amount, rounded half up.
+func share(amount int64, bps int64) int64 {
+	return (amount*bps + 5000) / 10000
+}
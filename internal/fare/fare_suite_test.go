@@ -0,0 +1,13 @@
+package fare_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFare(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fare Suite")
+}
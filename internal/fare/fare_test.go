@@ -0,0 +1,43 @@
+package fare_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/internal/fare"
+)
+
+var _ = Describe("NewSplit", func() {
+	It("splits a fare between the driver, the platform and the tax authority", func() {
+		split := fare.NewSplit(10000, 2000, 500)
+
+		Expect(split.Commission).To(Equal(int64(2000)))
+		Expect(split.Tax).To(Equal(int64(500)))
+		Expect(split.DriverCredit).To(Equal(int64(8000)))
+		Expect(split.RiderDebit).To(Equal(int64(10500)))
+	})
+
+	It("rounds shares half up", func() {
+		split := fare.NewSplit(5, 1000, 3000)
+
+		Expect(split.Commission).To(Equal(int64(1)))
+		Expect(split.Tax).To(Equal(int64(2)))
+	})
+
+	It("does not split fares without commission or tax", func() {
+		split := fare.NewSplit(999, 0, 0)
+
+		Expect(split).To(Equal(&fare.Split{RiderDebit: 999, DriverCredit: 999}))
+	})
+
+	DescribeTable("balances the rider debit with the credits",
+		func(amount int64, commissionBps int64, taxBps int64) {
+			split := fare.NewSplit(amount, commissionBps, taxBps)
+
+			Expect(split.RiderDebit).To(Equal(split.DriverCredit + split.Commission + split.Tax))
+		},
+		Entry("round fare", int64(10000), int64(2000), int64(500)),
+		Entry("odd fare", int64(12345), int64(1750), int64(1800)),
+		Entry("smallest fare", int64(1), int64(2000), int64(500)),
+		Entry("full commission", int64(4321), int64(10000), int64(0)),
+	)
+})
@@ -0,0 +1,184 @@
+// Package filtering parses the subset of AIP-160 filters and AIP-132 orderings supported by list RPCs.
+package filtering
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Restriction is a comparison of a field with a value, for example `type = DEBIT`.
+type Restriction struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+// Order is a field to order by, for example `create_time desc`.
+type Order struct {
+	Field      string
+	Descending bool
+}
+
+var operators = []string{"<=", ">=", "!=", "=", "<", ">"}
+
+// Parse parses a conjunction of restrictions joined by AND, such as
+// `type = DEBIT AND create_time > "2026-01-01T00:00:00Z"`. An empty filter returns no restrictions.
+func Parse(filter string) ([]Restriction, error) {
+	tokens, err := tokenize(filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions := []Restriction{}
+
+	for i := 0; i < len(tokens); {
+		if len(restrictions) > 0 {
+			if tokens[i].kind != identifier || tokens[i].text != "AND" {
+				return nil, fmt.Errorf("expected AND, got %q", tokens[i].text)
+			}
+
+			i++
+		}
+
+		if i+2 >= len(tokens) {
+			return nil, errors.New("incomplete restriction")
+		}
+
+		field, operator, value := tokens[i], tokens[i+1], tokens[i+2]
+
+		if field.kind != identifier {
+			return nil, fmt.Errorf("expected field, got %q", field.text)
+		}
+
+		if operator.kind != comparator {
+			return nil, fmt.Errorf("expected comparator after %s, got %q", field.text, operator.text)
+		}
+
+		if value.kind == comparator {
+			return nil, fmt.Errorf("expected value after %s %s", field.text, operator.text)
+		}
+
+		restrictions = append(restrictions, Restriction{
+			Field:    field.text,
+			Operator: operator.text,
+			Value:    value.text,
+		})
+
+		i += 3
+	}
+
+	return restrictions, nil
+}
+
+// ParseOrderBy parses a comma separated list of fields with an optional asc or desc suffix.
+// An empty order_by returns no orders.
+func ParseOrderBy(orderBy string) ([]Order, error) {
+	orders := []Order{}
+
+	if strings.TrimSpace(orderBy) == "" {
+		return orders, nil
+	}
+
+	for _, part := range strings.Split(orderBy, ",") {
+		words := strings.Fields(part)
+
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("invalid order: %q", strings.TrimSpace(part))
+		}
+
+		order := Order{Field: words[0]}
+
+		if len(words) == 2 {
+			switch words[1] {
+			case "asc":
+			case "desc":
+				order.Descending = true
+			default:
+				return nil, fmt.Errorf("invalid order direction: %q", words[1])
+			}
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+type tokenKind int
+
+const (
+	identifier tokenKind = iota
+	literal
+	comparator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(filter string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '"':
+			var text strings.Builder
+			i++
+
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+
+				text.WriteRune(runes[i])
+			}
+
+			if i == len(runes) {
+				return nil, errors.New("unterminated string")
+			}
+
+			i++
+			tokens = append(tokens, token{kind: literal, text: text.String()})
+
+		case strings.ContainsRune("<>!=", r):
+			matched := false
+
+			for _, operator := range operators {
+				if strings.HasPrefix(string(runes[i:]), operator) {
+					tokens = append(tokens, token{kind: comparator, text: operator})
+					i += len(operator)
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				return nil, fmt.Errorf("invalid operator at %d", i)
+			}
+
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.-:+", r):
+			start := i
+
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || strings.ContainsRune("_.-:+", runes[i])) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: identifier, text: string(runes[start:i])})
+
+		default:
+			return nil, fmt.Errorf("unsupported character %q", r)
+		}
+	}
+
+	return tokens, nil
+}
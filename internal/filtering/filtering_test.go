@@ -0,0 +1,94 @@
+package filtering_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/internal/filtering"
+)
+
+var _ = Describe("Filtering", func() {
+	Describe("Parse", func() {
+		It("returns no restrictions for an empty filter", func() {
+			Expect(filtering.Parse("  ")).To(BeEmpty())
+		})
+
+		It("parses a conjunction of restrictions", func() {
+			restrictions, err := filtering.Parse(`type = DEBIT AND create_time >= "2026-01-01T00:00:00Z" AND details.reference="rides/ride1"`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restrictions).To(Equal([]filtering.Restriction{
+				{Field: "type", Operator: "=", Value: "DEBIT"},
+				{Field: "create_time", Operator: ">=", Value: "2026-01-01T00:00:00Z"},
+				{Field: "details.reference", Operator: "=", Value: "rides/ride1"},
+			}))
+		})
+
+		It("unescapes quoted values", func() {
+			restrictions, err := filtering.Parse(`details.reference = "say \"hi\""`)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restrictions[0].Value).To(Equal(`say "hi"`))
+		})
+
+		DescribeTable("parses every comparator",
+			func(operator string) {
+				restrictions, err := filtering.Parse("create_time " + operator + " 2026-01-01T00:00:00Z")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(restrictions[0].Operator).To(Equal(operator))
+			},
+			Entry("equal", "="),
+			Entry("not equal", "!="),
+			Entry("less than", "<"),
+			Entry("less than or equal", "<="),
+			Entry("greater than", ">"),
+			Entry("greater than or equal", ">="),
+		)
+
+		DescribeTable("rejects filters outside of the supported subset",
+			func(filter string) {
+				_, err := filtering.Parse(filter)
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("disjunction", "type = DEBIT OR type = CREDIT"),
+			Entry("missing value", "type ="),
+			Entry("missing comparator", "type DEBIT"),
+			Entry("comparator as value", "type = ="),
+			Entry("value as field", `"type" = DEBIT`),
+			Entry("unterminated string", `details.reference = "rides/ride1`),
+			Entry("has operator", "details.reference:rides"),
+			Entry("negation", "NOT type = DEBIT"),
+			Entry("parentheses", "(type = DEBIT)"),
+			Entry("invalid operator", "type ! DEBIT"),
+		)
+	})
+
+	Describe("ParseOrderBy", func() {
+		It("returns no orders for an empty order_by", func() {
+			Expect(filtering.ParseOrderBy("")).To(BeEmpty())
+		})
+
+		It("parses fields with optional directions", func() {
+			orders, err := filtering.ParseOrderBy("create_time desc, batch_id, type asc")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(orders).To(Equal([]filtering.Order{
+				{Field: "create_time", Descending: true},
+				{Field: "batch_id"},
+				{Field: "type"},
+			}))
+		})
+
+		DescribeTable("rejects invalid orderings",
+			func(orderBy string) {
+				_, err := filtering.ParseOrderBy(orderBy)
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("unknown direction", "create_time descending"),
+			Entry("too many words", "create_time desc asc"),
+			Entry("empty field", "create_time,"),
+		)
+	})
+})
@@ -0,0 +1,156 @@
+// Package fakegateway is an in-memory payment gateway for running the service locally without a gateway account.
+// Bind FakeImpl to gateway.PaymentGateway in place of a real adapter in wire.go to use it.
+package fakegateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/aidarkhanov/nanoid"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
+)
+
+// Name is the name of the gateway in checkout info and stored recharges.
+const Name = "fake"
+
+// FakeImpl accepts every request and keeps orders and payouts in memory. Orders are paid and payouts are settled by
+// posting webhook events to the service, which are not signed.
+type FakeImpl struct {
+	mu      sync.Mutex
+	orders  map[string]*gateway.Order
+	payouts map[string]*gateway.Payout
+}
+
+func NewFakeGateway() *FakeImpl {
+	return &FakeImpl{
+		orders:  map[string]*gateway.Order{},
+		payouts: map[string]*gateway.Payout{},
+	}
+}
+
+func (g *FakeImpl) Name() string {
+	return Name
+}
+
+func (g *FakeImpl) CreateOrder(ctx context.Context, request *gateway.OrderRequest) (*gateway.Order, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	order := &gateway.Order{
+		Id:           "order_" + nanoid.New(),
+		Gateway:      Name,
+		Amount:       request.Amount,
+		CurrencyCode: request.CurrencyCode,
+	}
+
+	order.CheckoutInfo = map[string]string{"fake_order_id": order.Id}
+	g.orders[order.Id] = order
+
+	return order, nil
+}
+
+// VerifyPayment accepts any signature and reports the payment as captured for the full amount of the order.
+func (g *FakeImpl) VerifyPayment(ctx context.Context, orderId string, paymentId string, signature string) (*gateway.Payment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	order, ok := g.orders[orderId]
+
+	if !ok {
+		return nil, fmt.Errorf("fake: order %s not found", orderId)
+	}
+
+	return &gateway.Payment{
+		Id:           paymentId,
+		OrderId:      orderId,
+		Amount:       order.Amount,
+		CurrencyCode: order.CurrencyCode,
+		Status:       "captured",
+	}, nil
+}
+
+func (g *FakeImpl) CreateRefund(ctx context.Context, paymentId string, amount int64, reference string) (*gateway.Refund, error) {
+	return &gateway.Refund{
+		Id:        "rfnd_" + nanoid.New(),
+		PaymentId: paymentId,
+		Amount:    amount,
+		Status:    "processed",
+	}, nil
+}
+
+func (g *FakeImpl) CreateContact(ctx context.Context, name string, referenceId string) (*gateway.Contact, error) {
+	return &gateway.Contact{Id: "cont_" + nanoid.New()}, nil
+}
+
+func (g *FakeImpl) CreateFundAccount(ctx context.Context, contactId string, payoutAccount *pb.PayoutAccount) (*gateway.FundAccount, error) {
+	if payoutAccount.Destination == nil {
+		return nil, gateway.ErrInvalidDestination
+	}
+
+	return &gateway.FundAccount{Id: "fa_" + nanoid.New()}, nil
+}
+
+func (g *FakeImpl) DeactivateFundAccount(ctx context.Context, id string) error {
+	return nil
+}
+
+// CreatePayout queues a payout, returning the queued payout again for a reference that was already sent.
+func (g *FakeImpl) CreatePayout(ctx context.Context, request *gateway.PayoutRequest) (*gateway.Payout, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, payout := range g.payouts {
+		if payout.Reference == request.Reference {
+			return payout, nil
+		}
+	}
+
+	payout := &gateway.Payout{
+		Id:        "pout_" + nanoid.New(),
+		Status:    "queued",
+		Mode:      request.Mode,
+		Reference: request.Reference,
+	}
+
+	if request.FundAccountId == "" {
+		payout.Mode = pb.Payout_MODE_LINK
+	}
+
+	g.payouts[payout.Id] = payout
+
+	return payout, nil
+}
+
+func (g *FakeImpl) CancelPayout(ctx context.Context, id string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payout, ok := g.payouts[id]
+
+	if !ok {
+		return fmt.Errorf("%w: fake: payout %s not found", gateway.ErrRejected, id)
+	}
+
+	if payout.Status != "queued" {
+		return fmt.Errorf("%w: fake: payout %s is %s", gateway.ErrRejected, id, payout.Status)
+	}
+
+	payout.Status = "cancelled"
+
+	return nil
+}
+
+// ParseWebhook reads a gateway.WebhookEvent encoded as JSON, without checking a signature.
+func (g *FakeImpl) ParseWebhook(header http.Header, body []byte) (*gateway.WebhookEvent, error) {
+	var event gateway.WebhookEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,168 @@
+//go:generate go run github.com/golang/mock/mockgen -destination ./mock/$GOFILE . PaymentGateway
+
+// Package gateway defines the payment gateway wallets are recharged through and payouts are sent with.
+// Adapters for each gateway implement PaymentGateway and are bound to it with wire.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+var (
+	// ErrInvalidSignature is returned when a webhook or a checkout payment is not signed by the gateway.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidDestination is returned when a fund account is created for a payout account without a destination
+	// the gateway supports.
+	ErrInvalidDestination = errors.New("invalid payout account destination")
+
+	// ErrRejected is matched by errors of requests the gateway refused without acting on them. Other errors, like
+	// timeouts, leave the outcome of a request unknown.
+	ErrRejected = errors.New("rejected by payment gateway")
+)
+
+type PaymentGateway interface {
+	// Name identifies the gateway to clients in checkout info and in stored recharges, for example "razorpay".
+	Name() string
+
+	// CreateOrder creates the order a client pays to recharge a wallet.
+	CreateOrder(ctx context.Context, order *OrderRequest) (*Order, error)
+
+	// VerifyPayment checks the signature a client received from checkout for the payment of an order,
+	// and returns the payment.
+	VerifyPayment(ctx context.Context, orderId string, paymentId string, signature string) (*Payment, error)
+
+	// CreateRefund refunds an amount of a captured payment.
+	CreateRefund(ctx context.Context, paymentId string, amount int64, reference string) (*Refund, error)
+
+	// CreateContact creates the contact that fund accounts and payout links of a user belong to.
+	CreateContact(ctx context.Context, name string, referenceId string) (*Contact, error)
+
+	// CreateFundAccount creates a fund account of a contact for the bank account or UPI id of a payout account.
+	CreateFundAccount(ctx context.Context, contactId string, payoutAccount *pb.PayoutAccount) (*FundAccount, error)
+
+	// DeactivateFundAccount stops the gateway from accepting payouts to a fund account.
+	DeactivateFundAccount(ctx context.Context, id string) error
+
+	// CreatePayout sends a payout to a fund account, or as a payout link to a contact when no fund account is set.
+	// Payouts with the same reference are only sent once.
+	CreatePayout(ctx context.Context, payout *PayoutRequest) (*Payout, error)
+
+	// CancelPayout cancels a payout link, or a payout that is still queued.
+	CancelPayout(ctx context.Context, id string) error
+
+	// ParseWebhook verifies the signature of a webhook request and returns its event.
+	// It returns ErrInvalidSignature if the request was not sent by the gateway.
+	ParseWebhook(header http.Header, body []byte) (*WebhookEvent, error)
+}
+
+type OrderRequest struct {
+	// Amount is in the smallest unit of the currency.
+	Amount       int64
+	CurrencyCode string
+
+	// Receipt is a short id of the order on our side, for example "recharge/recharge1".
+	Receipt string
+
+	// Reference is the relative resource name the order pays for, for example "users/user1/wallet/recharges/recharge1".
+	Reference string
+}
+
+type Order struct {
+	Id string
+
+	// Gateway is the name of the gateway the order was created on.
+	Gateway string
+
+	Amount       int64
+	CurrencyCode string
+
+	// CheckoutInfo is what a client needs to open the checkout of the gateway for the order.
+	CheckoutInfo map[string]string
+}
+
+type Payment struct {
+	Id           string
+	OrderId      string
+	Amount       int64
+	CurrencyCode string
+	Status       string
+
+	// FailureReason is set on failed payments.
+	FailureReason string
+}
+
+type Refund struct {
+	Id        string
+	PaymentId string
+	Amount    int64
+	Status    string
+}
+
+type Contact struct {
+	Id string
+}
+
+type FundAccount struct {
+	Id string
+}
+
+type PayoutRequest struct {
+	// FundAccountId is the fund account to send the payout to. Without one, a payout link is sent to ContactId.
+	FundAccountId string
+	ContactId     string
+
+	Mode         pb.Payout_Mode
+	Amount       int64
+	CurrencyCode string
+
+	// Reference is the relative resource name of the payout, for example "users/user1/wallet/payouts/payout1".
+	Reference string
+}
+
+type Payout struct {
+	Id     string
+	Status string
+
+	// Mode is MODE_LINK for payout links.
+	Mode pb.Payout_Mode
+
+	// Reference is the relative resource name of the payout the gateway payout was sent for.
+	Reference string
+
+	// FailureReason is set on failed, reversed and rejected payouts.
+	FailureReason string
+}
+
+type WebhookEventType int
+
+const (
+	// WebhookEventIgnored is an event that does not change recharges or payouts.
+	WebhookEventIgnored WebhookEventType = iota
+
+	WebhookEventPaymentCaptured
+	WebhookEventPaymentFailed
+	WebhookEventPayoutProcessed
+
+	// WebhookEventPayoutFailed is a payout that failed, was reversed or rejected, or a payout link that expired.
+	WebhookEventPayoutFailed
+
+	// WebhookEventPayoutCancelled is a payout or payout link cancelled through CancelPayout.
+	WebhookEventPayoutCancelled
+)
+
+// WebhookEvent is a change to a payment or a payout reported by the gateway. Payment is set on payment events and
+// Payout on payout events.
+type WebhookEvent struct {
+	Type WebhookEventType
+
+	// Name is the name of the event given by the gateway, for example "payment.captured".
+	Name string
+
+	Payment *Payment
+	Payout  *Payout
+}
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ride-app/payments-service/internal/gateway (interfaces: PaymentGateway)
+
+// Package mock_gateway is a generated GoMock package.
+package mock_gateway
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	paymentsv1alpha1 "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	gateway "github.com/ride-app/payments-service/internal/gateway"
+)
+
+// MockPaymentGateway is a mock of PaymentGateway interface.
+type MockPaymentGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentGatewayMockRecorder
+}
+
+// MockPaymentGatewayMockRecorder is the mock recorder for MockPaymentGateway.
+type MockPaymentGatewayMockRecorder struct {
+	mock *MockPaymentGateway
+}
+
+// NewMockPaymentGateway creates a new mock instance.
+func NewMockPaymentGateway(ctrl *gomock.Controller) *MockPaymentGateway {
+	mock := &MockPaymentGateway{ctrl: ctrl}
+	mock.recorder = &MockPaymentGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentGateway) EXPECT() *MockPaymentGatewayMockRecorder {
+	return m.recorder
+}
+
+// CancelPayout mocks base method.
+func (m *MockPaymentGateway) CancelPayout(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPayout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPayout indicates an expected call of CancelPayout.
+func (mr *MockPaymentGatewayMockRecorder) CancelPayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPayout", reflect.TypeOf((*MockPaymentGateway)(nil).CancelPayout), arg0, arg1)
+}
+
+// CreateContact mocks base method.
+func (m *MockPaymentGateway) CreateContact(arg0 context.Context, arg1, arg2 string) (*gateway.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContact", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*gateway.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContact indicates an expected call of CreateContact.
+func (mr *MockPaymentGatewayMockRecorder) CreateContact(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockPaymentGateway)(nil).CreateContact), arg0, arg1, arg2)
+}
+
+// CreateFundAccount mocks base method.
+func (m *MockPaymentGateway) CreateFundAccount(arg0 context.Context, arg1 string, arg2 *paymentsv1alpha1.PayoutAccount) (*gateway.FundAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFundAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*gateway.FundAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFundAccount indicates an expected call of CreateFundAccount.
+func (mr *MockPaymentGatewayMockRecorder) CreateFundAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFundAccount", reflect.TypeOf((*MockPaymentGateway)(nil).CreateFundAccount), arg0, arg1, arg2)
+}
+
+// CreateOrder mocks base method.
+func (m *MockPaymentGateway) CreateOrder(arg0 context.Context, arg1 *gateway.OrderRequest) (*gateway.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", arg0, arg1)
+	ret0, _ := ret[0].(*gateway.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockPaymentGatewayMockRecorder) CreateOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockPaymentGateway)(nil).CreateOrder), arg0, arg1)
+}
+
+// CreatePayout mocks base method.
+func (m *MockPaymentGateway) CreatePayout(arg0 context.Context, arg1 *gateway.PayoutRequest) (*gateway.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayout", arg0, arg1)
+	ret0, _ := ret[0].(*gateway.Payout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayout indicates an expected call of CreatePayout.
+func (mr *MockPaymentGatewayMockRecorder) CreatePayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockPaymentGateway)(nil).CreatePayout), arg0, arg1)
+}
+
+// CreateRefund mocks base method.
+func (m *MockPaymentGateway) CreateRefund(arg0 context.Context, arg1 string, arg2 int64, arg3 string) (*gateway.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*gateway.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockPaymentGatewayMockRecorder) CreateRefund(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockPaymentGateway)(nil).CreateRefund), arg0, arg1, arg2, arg3)
+}
+
+// DeactivateFundAccount mocks base method.
+func (m *MockPaymentGateway) DeactivateFundAccount(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateFundAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateFundAccount indicates an expected call of DeactivateFundAccount.
+func (mr *MockPaymentGatewayMockRecorder) DeactivateFundAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateFundAccount", reflect.TypeOf((*MockPaymentGateway)(nil).DeactivateFundAccount), arg0, arg1)
+}
+
+// Name mocks base method.
+func (m *MockPaymentGateway) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockPaymentGatewayMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockPaymentGateway)(nil).Name))
+}
+
+// ParseWebhook mocks base method.
+func (m *MockPaymentGateway) ParseWebhook(arg0 http.Header, arg1 []byte) (*gateway.WebhookEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*gateway.WebhookEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWebhook indicates an expected call of ParseWebhook.
+func (mr *MockPaymentGatewayMockRecorder) ParseWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWebhook", reflect.TypeOf((*MockPaymentGateway)(nil).ParseWebhook), arg0, arg1)
+}
+
+// VerifyPayment mocks base method.
+func (m *MockPaymentGateway) VerifyPayment(arg0 context.Context, arg1, arg2, arg3 string) (*gateway.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPayment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*gateway.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPayment indicates an expected call of VerifyPayment.
+func (mr *MockPaymentGatewayMockRecorder) VerifyPayment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPayment", reflect.TypeOf((*MockPaymentGateway)(nil).VerifyPayment), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,106 @@
+package razorpaygateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ride-app/payments-service/internal/gateway"
+)
+
+type order struct {
+	Id       string `json:"id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type payment struct {
+	Id               string `json:"id"`
+	OrderId          string `json:"order_id"`
+	Amount           int64  `json:"amount"`
+	Currency         string `json:"currency"`
+	Status           string `json:"status"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (p *payment) toPayment() *gateway.Payment {
+	return &gateway.Payment{
+		Id:            p.Id,
+		OrderId:       p.OrderId,
+		Amount:        p.Amount,
+		CurrencyCode:  p.Currency,
+		Status:        p.Status,
+		FailureReason: p.ErrorDescription,
+	}
+}
+
+type refund struct {
+	Id        string `json:"id"`
+	PaymentId string `json:"payment_id"`
+	Amount    int64  `json:"amount"`
+	Status    string `json:"status"`
+}
+
+func (g *RazorpayImpl) CreateOrder(ctx context.Context, request *gateway.OrderRequest) (*gateway.Order, error) {
+	var response order
+
+	err := g.request(ctx, http.MethodPost, "/orders", map[string]interface{}{
+		"amount":   request.Amount,
+		"currency": request.CurrencyCode,
+		"receipt":  request.Receipt,
+		"notes": map[string]interface{}{
+			"recharge": request.Reference,
+		},
+	}, "", &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &gateway.Order{
+		Id:           response.Id,
+		Gateway:      Name,
+		Amount:       response.Amount,
+		CurrencyCode: response.Currency,
+		CheckoutInfo: map[string]string{
+			"rzp_order_id": response.Id,
+		},
+	}, nil
+}
+
+// VerifyPayment checks the signature Razorpay Checkout returns for a payment, which signs the order id and the payment
+// id with the key secret, and then fetches the payment.
+func (g *RazorpayImpl) VerifyPayment(ctx context.Context, orderId string, paymentId string, signature string) (*gateway.Payment, error) {
+	if !verifySignature([]byte(orderId+"|"+paymentId), signature, g.config.Razorpay_Secret) {
+		return nil, gateway.ErrInvalidSignature
+	}
+
+	var response payment
+
+	if err := g.request(ctx, http.MethodGet, "/payments/"+paymentId, nil, "", &response); err != nil {
+		return nil, err
+	}
+
+	return response.toPayment(), nil
+}
+
+func (g *RazorpayImpl) CreateRefund(ctx context.Context, paymentId string, amount int64, reference string) (*gateway.Refund, error) {
+	var response refund
+
+	err := g.request(ctx, http.MethodPost, "/payments/"+paymentId+"/refund", map[string]interface{}{
+		"amount": amount,
+		"notes": map[string]interface{}{
+			"reference_id": reference,
+		},
+	}, "", &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &gateway.Refund{
+		Id:        response.Id,
+		PaymentId: response.PaymentId,
+		Amount:    response.Amount,
+		Status:    response.Status,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package razorpaygateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/gateway"
+)
+
+// payoutLinkPrefix is the prefix of the ids of payout links.
+const payoutLinkPrefix = "poutlk_"
+
+type entity struct {
+	Id string `json:"id"`
+}
+
+// payout is the common shape of payout and payout link entities.
+type payout struct {
+	Id            string            `json:"id"`
+	Status        string            `json:"status"`
+	FailureReason string            `json:"failure_reason"`
+	Notes         map[string]string `json:"notes"`
+}
+
+func (p *payout) toPayout() *gateway.Payout {
+	result := &gateway.Payout{
+		Id:            p.Id,
+		Status:        p.Status,
+		Reference:     p.Notes["reference_id"],
+		FailureReason: p.FailureReason,
+	}
+
+	if strings.HasPrefix(p.Id, payoutLinkPrefix) {
+		result.Mode = pb.Payout_MODE_LINK
+	}
+
+	return result
+}
+
+func (g *RazorpayImpl) CreateContact(ctx context.Context, name string, referenceId string) (*gateway.Contact, error) {
+	var response entity
+
+	err := g.request(ctx, http.MethodPost, "/contacts", map[string]interface{}{
+		"name":         name,
+		"reference_id": referenceId,
+	}, "", &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &gateway.Contact{Id: response.Id}, nil
+}
+
+func (g *RazorpayImpl) CreateFundAccount(ctx context.Context, contactId string, payoutAccount *pb.PayoutAccount) (*gateway.FundAccount, error) {
+	payload := map[string]interface{}{
+		"contact_id": contactId,
+	}
+
+	switch destination := payoutAccount.Destination.(type) {
+	case *pb.PayoutAccount_BankAccount_:
+		payload["account_type"] = "bank_account"
+		payload["bank_account"] = map[string]interface{}{
+			"name":           destination.BankAccount.HolderName,
+			"ifsc":           destination.BankAccount.IfscCode,
+			"account_number": destination.BankAccount.AccountNumber,
+		}
+	case *pb.PayoutAccount_UpiId:
+		payload["account_type"] = "vpa"
+		payload["vpa"] = map[string]interface{}{
+			"address": destination.UpiId,
+		}
+	default:
+		return nil, gateway.ErrInvalidDestination
+	}
+
+	var response entity
+
+	if err := g.request(ctx, http.MethodPost, "/fund_accounts", payload, "", &response); err != nil {
+		return nil, err
+	}
+
+	return &gateway.FundAccount{Id: response.Id}, nil
+}
+
+func (g *RazorpayImpl) DeactivateFundAccount(ctx context.Context, id string) error {
+	return g.request(ctx, http.MethodPatch, "/fund_accounts/"+id, map[string]interface{}{
+		"active": false,
+	}, "", nil)
+}
+
+// CreatePayout sends a payout to a fund account, keyed by the id of the payout so that retries are not paid twice,
+// or a payout link that the contact claims over SMS.
+func (g *RazorpayImpl) CreatePayout(ctx context.Context, request *gateway.PayoutRequest) (*gateway.Payout, error) {
+	var response payout
+	var err error
+
+	if request.FundAccountId != "" {
+		payoutId := request.Reference[strings.LastIndex(request.Reference, "/")+1:]
+
+		err = g.request(ctx, http.MethodPost, "/payouts", map[string]interface{}{
+			"account_number":       g.config.Razorpay_Account_Number,
+			"fund_account_id":      request.FundAccountId,
+			"amount":               request.Amount,
+			"currency":             request.CurrencyCode,
+			"mode":                 strings.TrimPrefix(request.Mode.String(), "MODE_"),
+			"purpose":              "payout",
+			"queue_if_low_balance": true,
+			"reference_id":         payoutId,
+			"notes": map[string]interface{}{
+				"reference_id": request.Reference,
+			},
+		}, payoutId, &response)
+	} else {
+		err = g.request(ctx, http.MethodPost, "/payout-links", map[string]interface{}{
+			"account_number": g.config.Razorpay_Account_Number,
+			"amount":         request.Amount,
+			"description":    "Payout for " + request.Reference,
+			"contact": map[string]interface{}{
+				"id": request.ContactId,
+			},
+			"currency":             request.CurrencyCode,
+			"purpose":              "payout",
+			"send_sms":             true,
+			"queue_if_low_balance": true,
+			"notes": map[string]interface{}{
+				"reference_id": request.Reference,
+			},
+		}, "", &response)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.toPayout(), nil
+}
+
+func (g *RazorpayImpl) CancelPayout(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: payout has no razorpay id", gateway.ErrRejected)
+	}
+
+	path := "/payouts/" + id + "/cancel"
+
+	if strings.HasPrefix(id, payoutLinkPrefix) {
+		path = "/payout-links/" + id + "/cancel"
+	}
+
+	return g.request(ctx, http.MethodPost, path, nil, "", nil)
+}
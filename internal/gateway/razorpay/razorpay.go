@@ -0,0 +1,139 @@
+// Package razorpaygateway is the Razorpay adapter of the payment gateway. Orders, payments and refunds use the
+// Payments API, and contacts, fund accounts and payouts use RazorpayX.
+package razorpaygateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/gateway"
+)
+
+const (
+	// Name is the name of the gateway in checkout info and stored recharges.
+	Name = "razorpay"
+
+	baseUrl = "https://api.razorpay.com/v1"
+)
+
+// Error is an error response of the Razorpay API.
+type Error struct {
+	StatusCode  int
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("razorpay: %d %s: %s", e.StatusCode, e.Code, e.Description)
+}
+
+// Is matches gateway.ErrRejected for client errors, which Razorpay returns without acting on the request.
+// Timeouts and rate limits are retried by clients, so they are not rejections.
+func (e *Error) Is(target error) bool {
+	return target == gateway.ErrRejected &&
+		e.StatusCode >= http.StatusBadRequest &&
+		e.StatusCode < http.StatusInternalServerError &&
+		e.StatusCode != http.StatusRequestTimeout &&
+		e.StatusCode != http.StatusTooManyRequests
+}
+
+type RazorpayImpl struct {
+	config *config.Config
+	client *http.Client
+}
+
+func NewRazorpayGateway(config *config.Config) *RazorpayImpl {
+	return &RazorpayImpl{
+		config: config,
+		client: &http.Client{Timeout: config.PaymentGatewayTimeout},
+	}
+}
+
+func (g *RazorpayImpl) Name() string {
+	return Name
+}
+
+// request sends a request authenticated with the key of the account to the Razorpay API and decodes the JSON response
+// into response. Razorpay deduplicates payouts sent with the same idempotency key.
+func (g *RazorpayImpl) request(ctx context.Context, method string, path string, payload interface{}, idempotencyKey string, response interface{}) error {
+	var body io.Reader
+
+	if payload != nil {
+		data, err := json.Marshal(payload)
+
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewBuffer(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseUrl+path, body)
+
+	if err != nil {
+		return err
+	}
+
+	req.SetBasicAuth(g.config.Razorpay_Key, g.config.Razorpay_Secret)
+	req.Header.Set("Content-Type", "application/json")
+
+	if idempotencyKey != "" {
+		req.Header.Set("X-Payout-Idempotency", idempotencyKey)
+	}
+
+	resp, err := g.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		errorResponse := struct {
+			Error *Error `json:"error"`
+		}{}
+
+		if err := json.Unmarshal(data, &errorResponse); err != nil || errorResponse.Error == nil {
+			return &Error{StatusCode: resp.StatusCode, Description: string(data)}
+		}
+
+		errorResponse.Error.StatusCode = resp.StatusCode
+
+		return errorResponse.Error
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	return json.Unmarshal(data, response)
+}
+
+// verifySignature checks a hex encoded HMAC-SHA256 signature of data.
+func verifySignature(data []byte, signature string, secret string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
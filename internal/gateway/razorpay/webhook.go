@@ -0,0 +1,86 @@
+package razorpaygateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ride-app/payments-service/internal/gateway"
+)
+
+type webhookEvent struct {
+	Event   string `json:"event"`
+	Payload struct {
+		Payment *struct {
+			Entity payment `json:"entity"`
+		} `json:"payment"`
+		Payout *struct {
+			Entity payout `json:"entity"`
+		} `json:"payout"`
+		PayoutLink *struct {
+			Entity payout `json:"entity"`
+		} `json:"payout_link"`
+	} `json:"payload"`
+}
+
+// ParseWebhook verifies the X-Razorpay-Signature of a webhook with the webhook secret and maps its event.
+// Payout links report every status change as a payout_link event, so they are mapped by their status.
+func (g *RazorpayImpl) ParseWebhook(header http.Header, body []byte) (*gateway.WebhookEvent, error) {
+	if !verifySignature(body, header.Get("X-Razorpay-Signature"), g.config.Razorpay_Webhook_Secret) {
+		return nil, gateway.ErrInvalidSignature
+	}
+
+	var event webhookEvent
+
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+
+	result := &gateway.WebhookEvent{Name: event.Event}
+
+	switch {
+	case event.Payload.Payment != nil:
+		result.Payment = event.Payload.Payment.Entity.toPayment()
+
+		switch event.Event {
+		case "payment.captured":
+			result.Type = gateway.WebhookEventPaymentCaptured
+		case "payment.failed":
+			result.Type = gateway.WebhookEventPaymentFailed
+		}
+	case event.Payload.Payout != nil:
+		result.Payout = event.Payload.Payout.Entity.toPayout()
+
+		switch event.Event {
+		case "payout.processed":
+			result.Type = gateway.WebhookEventPayoutProcessed
+		case "payout.reversed", "payout.failed", "payout.rejected":
+			result.Type = payoutFailure(result.Payout)
+		}
+	case strings.HasPrefix(event.Event, "payout_link.") && event.Payload.PayoutLink != nil:
+		result.Payout = event.Payload.PayoutLink.Entity.toPayout()
+
+		switch result.Payout.Status {
+		case "processed":
+			result.Type = gateway.WebhookEventPayoutProcessed
+		case "cancelled", "expired", "rejected":
+			result.Type = payoutFailure(result.Payout)
+		}
+	}
+
+	return result, nil
+}
+
+// payoutFailure returns the type of the event of a payout that did not go through, and fills in a failure reason
+// for payouts reported without one.
+func payoutFailure(payout *gateway.Payout) gateway.WebhookEventType {
+	if payout.FailureReason == "" {
+		payout.FailureReason = "payout " + payout.Status
+	}
+
+	if payout.Status == "cancelled" {
+		return gateway.WebhookEventPayoutCancelled
+	}
+
+	return gateway.WebhookEventPayoutFailed
+}
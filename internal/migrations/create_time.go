@@ -0,0 +1,57 @@
+// Package migrations backfills fields that documents written by earlier versions of the service are missing.
+// Migrations only write fields that are missing, so they can be run again after a failure.
+package migrations
+
+import (
+	"context"
+
+	"cloud.google.com/go/firestore"
+	"github.com/dragonfish/go/v2/pkg/logger"
+)
+
+// batchSize is the number of documents read and written at a time, which is the most a Firestore batch can write.
+const batchSize = 500
+
+// BackfillCreateTime sets create_time to the time the document was created on the documents of a collection group
+// that have none. Lists are ordered by create_time, and Firestore leaves documents without it out of ordered queries.
+// Documents are only updated if they were not changed since they were read. If dryRun is set, nothing is written.
+// It returns the number of documents that were missing create_time.
+func BackfillCreateTime(ctx context.Context, log logger.Logger, client *firestore.Client, collectionGroup string, dryRun bool) (int, error) {
+	query := client.CollectionGroup(collectionGroup).OrderBy(firestore.DocumentID, firestore.Asc).Limit(batchSize)
+	backfilled := 0
+
+	for {
+		docs, err := query.Documents(ctx).GetAll()
+
+		if err != nil {
+			return backfilled, err
+		}
+
+		batch := client.Batch()
+		writes := 0
+
+		for _, doc := range docs {
+			if _, ok := doc.Data()["create_time"]; ok {
+				continue
+			}
+
+			log.Debugf("Backfilling create time of %s", doc.Ref.Path)
+			batch.Update(doc.Ref, []firestore.Update{{Path: "create_time", Value: doc.CreateTime}}, firestore.LastUpdateTime(doc.UpdateTime))
+			writes++
+		}
+
+		if writes > 0 && !dryRun {
+			if _, err := batch.Commit(ctx); err != nil {
+				return backfilled, err
+			}
+		}
+
+		backfilled += writes
+
+		if len(docs) < batchSize {
+			return backfilled, nil
+		}
+
+		query = query.StartAfter(docs[len(docs)-1])
+	}
+}
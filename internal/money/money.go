@@ -0,0 +1,69 @@
+// Package money reads and writes amounts of money in Firestore documents.
+// Amounts are int64 counts of the smallest unit of their currency, for example Rs. 1.75 is 175 with currency code "INR".
+package money
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultCurrencyCode is the currency of amounts stored without a currency code.
+const DefaultCurrencyCode = "INR"
+
+// Amount reads an amount from a Firestore value. Integers come back from Firestore as int64,
+// while documents written by the old Cloud Functions may store amounts as doubles.
+func Amount(value interface{}) (int64, bool) {
+	switch amount := value.(type) {
+	case int64:
+		return amount, true
+	case float64:
+		return int64(math.Round(amount)), true
+	default:
+		return 0, false
+	}
+}
+
+// CurrencyCode reads a currency code from a Firestore value, defaulting to DefaultCurrencyCode for documents stored without one.
+func CurrencyCode(value interface{}) string {
+	if currencyCode, ok := value.(string); ok && currencyCode != "" {
+		return currencyCode
+	}
+
+	return DefaultCurrencyCode
+}
+
+// minorUnits lists the currencies whose smallest unit is not a hundredth of the major unit.
+var minorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+// Format formats an amount in the major unit of its currency, for example 175 INR as "1.75".
+func Format(amount int64, currencyCode string) string {
+	digits, ok := minorUnits[currencyCode]
+
+	if !ok {
+		digits = 2
+	}
+
+	sign := ""
+
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	if digits == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+
+	scale := int64(math.Pow10(digits))
+
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, digits, amount%scale)
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"strings"
+
+	eventsv1 "github.com/ride-app/payments-service/api/ride/payments/events/v1"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/pkg/events"
+)
+
+// The statuses and types of the event messages are numbered like the ones of the resources, so they are converted by value.
+
+// TransactionCreated returns the event of a committed transaction.
+func TransactionCreated(transaction *pb.Transaction) *Event {
+	wallet := walletOf(transaction.Name)
+
+	return &Event{
+		Type:        events.TypeTransactionCreated,
+		Subject:     transaction.Name,
+		OrderingKey: wallet,
+		Data: &eventsv1.TransactionCreated{
+			Transaction:  transaction.Name,
+			Wallet:       wallet,
+			Type:         eventsv1.TransactionCreated_Type(transaction.Type),
+			Amount:       transaction.Amount,
+			CurrencyCode: transaction.CurrencyCode,
+			BalanceAfter: transaction.GetBalanceAfter(),
+			BatchId:      transaction.GetBatchId(),
+			ReversalOf:   transaction.GetReversalOf(),
+			Reference:    transaction.GetDetails().GetReference(),
+			CreateTime:   transaction.CreateTime,
+		},
+	}
+}
+
+// TransferCreated returns the event of a created transfer, ordered with the events of its source wallet.
+func TransferCreated(transfer *pb.Transfer) *Event {
+	return &Event{
+		Type:        events.TypeTransferCreated,
+		Subject:     transfer.Name,
+		OrderingKey: transfer.Source,
+		Data: &eventsv1.TransferCreated{
+			Transfer:     transfer.Name,
+			Source:       transfer.Source,
+			Destination:  transfer.Destination,
+			Amount:       transfer.Amount,
+			CurrencyCode: transfer.CurrencyCode,
+			Reference:    transfer.GetDetails().GetReference(),
+			CreateTime:   transfer.CreateTime,
+		},
+	}
+}
+
+// PayoutStatusChanged returns the event of a payout in its current status.
+func PayoutStatusChanged(payout *pb.Payout) *Event {
+	wallet := walletOf(payout.Name)
+
+	return &Event{
+		Type:        events.TypePayoutStatusChanged,
+		Subject:     payout.Name,
+		OrderingKey: wallet,
+		Data: &eventsv1.PayoutStatusChanged{
+			Payout:        payout.Name,
+			Wallet:        wallet,
+			Amount:        payout.Amount,
+			CurrencyCode:  payout.CurrencyCode,
+			Status:        eventsv1.PayoutStatusChanged_Status(payout.Status),
+			TransactionId: payout.GetTransactionId(),
+			FailureReason: payout.GetFailureReason(),
+			UpdateTime:    payout.UpdateTime,
+		},
+	}
+}
+
+// RechargeStatusChanged returns the event of a recharge in its current status.
+func RechargeStatusChanged(recharge *pb.Recharge) *Event {
+	wallet := walletOf(recharge.Name)
+
+	return &Event{
+		Type:        events.TypeRechargeStatusChanged,
+		Subject:     recharge.Name,
+		OrderingKey: wallet,
+		Data: &eventsv1.RechargeStatusChanged{
+			Recharge:      recharge.Name,
+			Wallet:        wallet,
+			Amount:        recharge.Amount,
+			CurrencyCode:  recharge.CurrencyCode,
+			Status:        eventsv1.RechargeStatusChanged_Status(recharge.Status),
+			TransactionId: recharge.GetTransactionId(),
+			FailureReason: recharge.GetFailureReason(),
+			UpdateTime:    recharge.UpdateTime,
+		},
+	}
+}
+
+// PayoutAccountUpdated returns the event of a payout account moved to a new destination. The destination itself is
+// left out of the event.
+func PayoutAccountUpdated(payoutAccount *pb.PayoutAccount) *Event {
+	wallet := walletOf(payoutAccount.Name)
+
+	data := &eventsv1.PayoutAccountUpdated{
+		PayoutAccount:         payoutAccount.Name,
+		Wallet:                wallet,
+		DestinationUpdateTime: payoutAccount.DestinationUpdateTime,
+	}
+
+	switch payoutAccount.Destination.(type) {
+	case *pb.PayoutAccount_BankAccount_:
+		data.DestinationType = eventsv1.PayoutAccountUpdated_DESTINATION_TYPE_BANK_ACCOUNT
+	case *pb.PayoutAccount_UpiId:
+		data.DestinationType = eventsv1.PayoutAccountUpdated_DESTINATION_TYPE_UPI
+	}
+
+	return &Event{
+		Type:        events.TypePayoutAccountUpdated,
+		Subject:     payoutAccount.Name,
+		OrderingKey: wallet,
+		Data:        data,
+	}
+}
+
+// walletOf returns the name of the wallet of a resource, for example "users/user1/wallet" for "users/user1/wallet/payouts/payout1".
+func walletOf(name string) string {
+	substrings := strings.SplitN(name, "/", 4)
+
+	if len(substrings) < 3 {
+		return name
+	}
+
+	return strings.Join(substrings[:3], "/")
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/pkg/events"
+)
+
+var ErrHeldBack = errHeldBack
+
+// Publisher is the publisher of a relay, and PublishResult the result of a published message.
+type (
+	Publisher     = publisher
+	PublishResult = publishResult
+)
+
+// PendingEvent is an event of the outbox waiting to be published.
+type PendingEvent = pendingEvent
+
+// NewPendingEvent returns a pending event of an outbox document.
+func NewPendingEvent(event *events.Event, orderingKey string, payload []byte) *PendingEvent {
+	return &pendingEvent{orderingKey: orderingKey, payload: payload, event: event}
+}
+
+// NewTestRelay returns a relay that publishes to a publisher and does not use Firestore.
+func NewTestRelay(config *config.Config, publisher Publisher) *Relay {
+	return &Relay{logger: logger.New(true, false), config: config, publisher: publisher, holder: "relay1"}
+}
+
+func (r *Relay) Publish(ctx context.Context, pending []*PendingEvent) []error {
+	return r.publish(ctx, pending)
+}
+
+func (r *Relay) Failure(doc map[string]interface{}, err error, now time.Time) (map[string]interface{}, bool) {
+	return r.failure(doc, err, now)
+}
+
+var LeasedByOther = leasedByOther
@@ -0,0 +1,79 @@
+// Package outbox stores domain events in Firestore in the same transaction or batch as the change they describe,
+// so that an event is recorded if and only if its change is committed. A Relay publishes the stored events to Pub/Sub.
+package outbox
+
+import (
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ride-app/payments-service/pkg/events"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Collection is the Firestore collection events are stored in until they are published.
+	Collection = "outbox"
+
+	// DeadLetterCollection is the Firestore collection events that failed to be published too many times are moved to.
+	// They are kept there until they are added back to the outbox by hand.
+	DeadLetterCollection = "outbox-dead-letter"
+)
+
+// Event is a change to a resource of a wallet.
+type Event struct {
+	// Type is one of the event types of the events package.
+	Type string
+
+	// Subject is the relative resource name of the changed resource, for example "users/user1/wallet/payouts/payout1".
+	Subject string
+
+	// OrderingKey groups events that are published in the order they were added, usually the name of the wallet.
+	OrderingKey string
+
+	// Data is the message of the ride.payments.events.v1 package of the type.
+	Data proto.Message
+}
+
+// doc returns the outbox document of an event.
+func (e *Event) doc() (map[string]interface{}, error) {
+	data, err := proto.Marshal(e.Data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"type":           e.Type,
+		"subject":        e.Subject,
+		"ordering_key":   e.OrderingKey,
+		"data":           data,
+		"schema_version": events.SchemaVersion,
+		"published":      false,
+		"attempts":       0,
+		"create_time":    time.Now(),
+	}, nil
+}
+
+// Add adds an event to the outbox in a transaction.
+func Add(tx *firestore.Transaction, client *firestore.Client, event *Event) error {
+	doc, err := event.doc()
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(client.Collection(Collection).NewDoc(), doc)
+}
+
+// AddToBatch adds an event to the outbox in a write batch.
+func AddToBatch(batch *firestore.WriteBatch, client *firestore.Client, event *Event) error {
+	doc, err := event.doc()
+
+	if err != nil {
+		return err
+	}
+
+	batch.Create(client.Collection(Collection).NewDoc(), doc)
+
+	return nil
+}
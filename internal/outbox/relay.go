@@ -0,0 +1,403 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/pubsub"
+	firebase "firebase.google.com/go/v4"
+	"github.com/aidarkhanov/nanoid"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/pkg/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// batchSize is the largest number of events published in one round, which is also the write limit of a batch.
+	batchSize = 500
+
+	// maxBackoff is the longest wait between rounds after rounds that failed.
+	maxBackoff = time.Minute
+
+	// retention is how long published events are kept before the Firestore TTL policy on expire_time deletes them.
+	retention = 7 * 24 * time.Hour
+
+	// leasePath is the Firestore document of the lease a relay holds while it publishes events.
+	leasePath = "leases/outbox-relay"
+)
+
+// errHeldBack is the result of an event that was not published because an earlier event of its ordering key failed.
+var errHeldBack = errors.New("held back by an earlier event of its ordering key")
+
+// publisher publishes messages to a topic with message ordering enabled, like *pubsub.Topic. Once a message of an
+// ordering key fails, later messages of the key fail until publishing is resumed for the key.
+type publisher interface {
+	Publish(ctx context.Context, message *pubsub.Message) publishResult
+	ResumePublish(orderingKey string)
+	Stop()
+}
+
+// publishResult is the result of a published message, like *pubsub.PublishResult.
+type publishResult interface {
+	Get(ctx context.Context) (string, error)
+}
+
+// topicPublisher publishes messages to a Pub/Sub topic.
+type topicPublisher struct {
+	*pubsub.Topic
+}
+
+func (t topicPublisher) Publish(ctx context.Context, message *pubsub.Message) publishResult {
+	return t.Topic.Publish(ctx, message)
+}
+
+// pendingEvent is an event of the outbox waiting to be published.
+type pendingEvent struct {
+	ref         *firestore.DocumentRef
+	data        map[string]interface{}
+	orderingKey string
+	payload     []byte
+	event       *events.Event
+}
+
+// pendingEventFromDoc returns the pending event of an outbox document.
+func pendingEventFromDoc(doc *firestore.DocumentSnapshot) *pendingEvent {
+	data := doc.Data()
+	pending := &pendingEvent{
+		ref:   doc.Ref,
+		data:  data,
+		event: eventFromDoc(doc),
+	}
+
+	pending.orderingKey, _ = data["ordering_key"].(string)
+	pending.payload, _ = data["data"].([]byte)
+
+	return pending
+}
+
+// Relay publishes the events of the outbox to a Pub/Sub topic in the order they were added, encoded by the events
+// package. Events with the same ordering key are delivered in order. Events are published at least once, since an
+// event is published again if marking it as published fails, so consumers deduplicate them by their id.
+// Only one relay may publish at a time, since concurrent relays publish events twice and can reorder them, so a relay
+// only publishes while it holds a lease in Firestore, for example while a new revision replaces the running one.
+// An event that fails to be published OutboxMaxAttempts times is moved to the dead letter collection, so that it no
+// longer holds back the later events of its ordering key.
+type Relay struct {
+	logger    logger.Logger
+	config    *config.Config
+	firestore *firestore.Client
+	publisher publisher
+	holder    string
+}
+
+func NewRelay(logger logger.Logger, config *config.Config, firebaseApp *firebase.App, pubsubClient *pubsub.Client) (*Relay, error) {
+	firestore, err := firebaseApp.Firestore(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	topic := pubsubClient.Topic(config.EventsTopic)
+	topic.EnableMessageOrdering = true
+
+	return &Relay{
+		logger:    logger,
+		config:    config,
+		firestore: firestore,
+		publisher: topicPublisher{topic},
+		holder:    nanoid.New(),
+	}, nil
+}
+
+// Run publishes pending events every poll interval until ctx is done. After a round fails to publish an event,
+// the wait before the next round doubles up to maxBackoff.
+func (r *Relay) Run(ctx context.Context) error {
+	defer r.releaseLease()
+	defer r.publisher.Stop()
+
+	wait := r.config.OutboxPollInterval
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		leased, err := r.acquireLease(ctx)
+
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to acquire relay lease")
+			wait = min(2*wait, maxBackoff)
+			continue
+		}
+
+		if !leased {
+			r.logger.Debug("Relay lease is held by another relay")
+			wait = r.config.OutboxPollInterval
+			continue
+		}
+
+		failed, err := r.publishPending(ctx)
+
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to relay events")
+		}
+
+		if err != nil || failed > 0 {
+			wait = min(2*wait, maxBackoff)
+			continue
+		}
+
+		wait = r.config.OutboxPollInterval
+	}
+}
+
+// acquireLease takes or renews the relay lease. It returns false if another relay holds a lease that has not expired.
+func (r *Relay) acquireLease(ctx context.Context) (bool, error) {
+	ref := r.firestore.Doc(leasePath)
+	leased := false
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		now := time.Now()
+
+		if doc.Exists() && leasedByOther(doc.Data(), r.holder, now) {
+			leased = false
+			return nil
+		}
+
+		leased = true
+
+		return tx.Set(ref, map[string]interface{}{
+			"holder":      r.holder,
+			"expire_time": now.Add(r.config.OutboxLeaseTTL),
+		})
+	})
+
+	return leased, err
+}
+
+// leasedByOther reports whether a lease document is held by another relay than holder at now.
+func leasedByOther(lease map[string]interface{}, holder string, now time.Time) bool {
+	leaseHolder, _ := lease["holder"].(string)
+	expireTime, _ := lease["expire_time"].(time.Time)
+
+	return leaseHolder != holder && now.Before(expireTime)
+}
+
+// releaseLease gives up the relay lease if this relay holds it, so that the relay replacing it does not wait for the
+// lease to expire.
+func (r *Relay) releaseLease() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ref := r.firestore.Doc(leasePath)
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if holder, _ := doc.Data()["holder"].(string); holder != r.holder {
+			return nil
+		}
+
+		return tx.Delete(ref)
+	})
+
+	if err != nil {
+		r.logger.WithError(err).Warn("Failed to release relay lease")
+	}
+}
+
+// fail records a failed attempt to publish an event, or moves the event to the dead letter collection once it failed
+// OutboxMaxAttempts times.
+func (r *Relay) fail(batch *firestore.WriteBatch, pending *pendingEvent, err error) {
+	data, deadLettered := r.failure(pending.data, err, time.Now())
+
+	if !deadLettered {
+		batch.Update(pending.ref, []firestore.Update{
+			{Path: "attempts", Value: data["attempts"]},
+			{Path: "last_error", Value: data["last_error"]},
+		})
+
+		return
+	}
+
+	r.logger.WithError(err).Errorf("Moving event %s to the dead letter collection after %d attempts", pending.ref.ID, data["attempts"])
+
+	batch.Set(r.firestore.Collection(DeadLetterCollection).Doc(pending.ref.ID), data)
+	batch.Delete(pending.ref)
+}
+
+// failure returns the outbox document of an event after a failed attempt at now, and whether the event has to be
+// moved to the dead letter collection with it.
+func (r *Relay) failure(doc map[string]interface{}, err error, now time.Time) (map[string]interface{}, bool) {
+	data := make(map[string]interface{}, len(doc)+3)
+
+	for key, value := range doc {
+		data[key] = value
+	}
+
+	attempts, _ := doc["attempts"].(int64)
+	data["attempts"] = attempts + 1
+	data["last_error"] = err.Error()
+
+	if attempts+1 < r.config.OutboxMaxAttempts {
+		return data, false
+	}
+
+	data["dead_letter_time"] = now
+
+	return data, true
+}
+
+// publishPending publishes a round of pending events, oldest first, and marks the published ones. It returns the
+// number of events that failed or were held back.
+func (r *Relay) publishPending(ctx context.Context) (int, error) {
+	docs, err := r.firestore.Collection(Collection).
+		Where("published", "==", false).
+		OrderBy("create_time", firestore.Asc).
+		Limit(batchSize).
+		Documents(ctx).
+		GetAll()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	pending := make([]*pendingEvent, len(docs))
+
+	for i, doc := range docs {
+		pending[i] = pendingEventFromDoc(doc)
+	}
+
+	results := r.publish(ctx, pending)
+
+	batch := r.firestore.Batch()
+	publishTime := time.Now()
+	failed := 0
+
+	for i, err := range results {
+		if errors.Is(err, errHeldBack) {
+			failed++
+			continue
+		}
+
+		if err != nil {
+			failed++
+			r.fail(batch, pending[i], err)
+			continue
+		}
+
+		batch.Update(pending[i].ref, []firestore.Update{
+			{Path: "published", Value: true},
+			{Path: "publish_time", Value: publishTime},
+			{Path: "expire_time", Value: publishTime.Add(retention)},
+		})
+	}
+
+	r.logger.Infof("Published %d of %d events", len(docs)-failed, len(docs))
+
+	if _, err := batch.Commit(ctx); err != nil {
+		return failed, err
+	}
+
+	return failed, nil
+}
+
+// publish publishes events in order and returns the result of each of them. Once an event of an ordering key fails,
+// Pub/Sub rejects the later events of the key until publishing is resumed, so only the first failure of a key is
+// returned and the later events of the key are held back for the next round, which publishing is resumed for.
+// Events without an ordering key do not hold back each other.
+// An event that can not be encoded fails like a failed publish, holding back the later events of its key.
+func (r *Relay) publish(ctx context.Context, pending []*pendingEvent) []error {
+	results := make([]publishResult, len(pending))
+	errs := make([]error, len(pending))
+	failedKeys := map[string]bool{}
+
+	for i, event := range pending {
+		if event.orderingKey != "" && failedKeys[event.orderingKey] {
+			errs[i] = errHeldBack
+			continue
+		}
+
+		attributes, err := events.Attributes(event.event)
+
+		if err != nil {
+			failedKeys[event.orderingKey] = true
+			r.logger.WithError(err).Errorf("Failed to encode event %s", event.event.Id)
+			errs[i] = err
+
+			continue
+		}
+
+		results[i] = r.publisher.Publish(ctx, &pubsub.Message{
+			Data:        event.payload,
+			OrderingKey: event.orderingKey,
+			Attributes:  attributes,
+		})
+	}
+
+	for i, event := range pending {
+		if results[i] == nil {
+			continue
+		}
+
+		if _, err := results[i].Get(ctx); err != nil {
+			if event.orderingKey != "" && failedKeys[event.orderingKey] {
+				errs[i] = errHeldBack
+				continue
+			}
+
+			failedKeys[event.orderingKey] = true
+			r.logger.WithError(err).Warnf("Failed to publish event %s", event.event.Id)
+			errs[i] = err
+		}
+	}
+
+	for orderingKey := range failedKeys {
+		if orderingKey != "" {
+			r.publisher.ResumePublish(orderingKey)
+		}
+	}
+
+	return errs
+}
+
+// eventFromDoc returns the envelope of an outbox document, identified by the id of the document.
+func eventFromDoc(doc *firestore.DocumentSnapshot) *events.Event {
+	data := doc.Data()
+
+	event := &events.Event{
+		Id:     doc.Ref.ID,
+		Source: events.Source,
+	}
+
+	event.Type, _ = data["type"].(string)
+	event.Subject, _ = data["subject"].(string)
+	event.Time, _ = data["create_time"].(time.Time)
+	event.SchemaVersion, _ = data["schema_version"].(string)
+
+	return event
+}
@@ -0,0 +1,208 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/outbox"
+	"github.com/ride-app/payments-service/pkg/events"
+)
+
+// result is the result of a message published to a fakePublisher.
+type result struct {
+	err error
+}
+
+func (r *result) Get(context.Context) (string, error) {
+	if r.err != nil {
+		return "", r.err
+	}
+
+	return "message1", nil
+}
+
+// fakePublisher publishes messages like a topic with message ordering enabled. Messages of the keys in failing fail,
+// and so do the later messages of a failed key until publishing is resumed for it.
+type fakePublisher struct {
+	failing   map[string]bool
+	paused    map[string]bool
+	published []*pubsub.Message
+	resumed   []string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, message *pubsub.Message) outbox.PublishResult {
+	if p.paused[message.OrderingKey] || p.failing[message.Attributes["ce-id"]] {
+		if message.OrderingKey != "" {
+			p.paused[message.OrderingKey] = true
+		}
+
+		return &result{err: errors.New("publish failed")}
+	}
+
+	p.published = append(p.published, message)
+
+	return &result{}
+}
+
+func (p *fakePublisher) ResumePublish(orderingKey string) {
+	delete(p.paused, orderingKey)
+	p.resumed = append(p.resumed, orderingKey)
+}
+
+func (p *fakePublisher) Stop() {}
+
+var _ = Describe("Relay", func() {
+	var (
+		relay     *outbox.Relay
+		publisher *fakePublisher
+	)
+
+	BeforeEach(func() {
+		publisher = &fakePublisher{failing: map[string]bool{}, paused: map[string]bool{}}
+		relay = outbox.NewTestRelay(&config.Config{OutboxMaxAttempts: 3}, publisher)
+	})
+
+	// pending returns a pending event of the given id and ordering key.
+	pending := func(id string, orderingKey string) *outbox.PendingEvent {
+		return outbox.NewPendingEvent(&events.Event{
+			Id:     id,
+			Type:   events.TypePayoutStatusChanged,
+			Source: events.Source,
+			Time:   time.Now(),
+		}, orderingKey, []byte(id))
+	}
+
+	// ids returns the event ids of published messages in the order they were published.
+	ids := func(messages []*pubsub.Message) []string {
+		published := []string{}
+
+		for _, message := range messages {
+			published = append(published, message.Attributes["ce-id"])
+		}
+
+		return published
+	}
+
+	Describe("publish", func() {
+		It("publishes events in order with their ordering keys", func() {
+			errs := relay.Publish(context.Background(), []*outbox.PendingEvent{
+				pending("event1", "users/user1/wallet"),
+				pending("event2", "users/user2/wallet"),
+				pending("event3", "users/user1/wallet"),
+			})
+
+			Expect(errs).To(Equal([]error{nil, nil, nil}))
+			Expect(ids(publisher.published)).To(Equal([]string{"event1", "event2", "event3"}))
+			Expect(publisher.published[2].OrderingKey).To(Equal("users/user1/wallet"))
+			Expect(publisher.resumed).To(BeEmpty())
+		})
+
+		It("holds back the later events of a key that failed and resumes publishing for the key", func() {
+			publisher.failing["event1"] = true
+
+			errs := relay.Publish(context.Background(), []*outbox.PendingEvent{
+				pending("event1", "users/user1/wallet"),
+				pending("event2", "users/user2/wallet"),
+				pending("event3", "users/user1/wallet"),
+			})
+
+			Expect(errs[0]).To(HaveOccurred())
+			Expect(errs[0]).NotTo(MatchError(outbox.ErrHeldBack))
+			Expect(errs[1]).NotTo(HaveOccurred())
+			Expect(errs[2]).To(MatchError(outbox.ErrHeldBack))
+			Expect(ids(publisher.published)).To(Equal([]string{"event2"}))
+			Expect(publisher.resumed).To(Equal([]string{"users/user1/wallet"}))
+		})
+
+		It("publishes the held back events of a key in the next round", func() {
+			publisher.failing["event1"] = true
+
+			relay.Publish(context.Background(), []*outbox.PendingEvent{
+				pending("event1", "users/user1/wallet"),
+				pending("event2", "users/user1/wallet"),
+			})
+
+			delete(publisher.failing, "event1")
+
+			errs := relay.Publish(context.Background(), []*outbox.PendingEvent{
+				pending("event1", "users/user1/wallet"),
+				pending("event2", "users/user1/wallet"),
+			})
+
+			Expect(errs).To(Equal([]error{nil, nil}))
+			Expect(ids(publisher.published)).To(Equal([]string{"event1", "event2"}))
+		})
+
+		It("holds back the later events of a key after an event that can not be encoded", func() {
+			invalid := outbox.NewPendingEvent(&events.Event{
+				Id:   "event1",
+				Type: "ride.payments.wallet.deleted",
+				Time: time.Now(),
+			}, "users/user1/wallet", []byte("event1"))
+
+			errs := relay.Publish(context.Background(), []*outbox.PendingEvent{
+				invalid,
+				pending("event2", "users/user1/wallet"),
+			})
+
+			Expect(errs[0]).To(MatchError(events.ErrUnknownType))
+			Expect(errs[1]).To(MatchError(outbox.ErrHeldBack))
+			Expect(publisher.published).To(BeEmpty())
+		})
+
+		It("does not hold back events without an ordering key", func() {
+			publisher.failing["event1"] = true
+
+			errs := relay.Publish(context.Background(), []*outbox.PendingEvent{
+				pending("event1", ""),
+				pending("event2", ""),
+			})
+
+			Expect(errs[0]).To(HaveOccurred())
+			Expect(errs[1]).NotTo(HaveOccurred())
+			Expect(publisher.resumed).To(BeEmpty())
+		})
+	})
+
+	Describe("failure", func() {
+		now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+		It("records a failed attempt", func() {
+			data, deadLettered := relay.Failure(map[string]interface{}{"type": events.TypePayoutStatusChanged, "attempts": int64(0)}, errors.New("publish failed"), now)
+
+			Expect(deadLettered).To(BeFalse())
+			Expect(data).To(HaveKeyWithValue("attempts", int64(1)))
+			Expect(data).To(HaveKeyWithValue("last_error", "publish failed"))
+		})
+
+		It("dead-letters an event once it failed the maximum number of attempts", func() {
+			doc := map[string]interface{}{"type": events.TypePayoutStatusChanged, "attempts": int64(2)}
+
+			data, deadLettered := relay.Failure(doc, errors.New("publish failed"), now)
+
+			Expect(deadLettered).To(BeTrue())
+			Expect(data).To(HaveKeyWithValue("type", events.TypePayoutStatusChanged))
+			Expect(data).To(HaveKeyWithValue("attempts", int64(3)))
+			Expect(data).To(HaveKeyWithValue("dead_letter_time", now))
+			Expect(doc).To(HaveKeyWithValue("attempts", int64(2)))
+		})
+	})
+
+	Describe("lease", func() {
+		now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+		DescribeTable("is held by another relay only while another holder's lease has not expired",
+			func(holder string, expireTime time.Time, leased bool) {
+				Expect(outbox.LeasedByOther(map[string]interface{}{"holder": holder, "expire_time": expireTime}, "relay1", now)).To(Equal(leased))
+			},
+			Entry("lease of another relay", "relay2", now.Add(time.Minute), true),
+			Entry("expired lease of another relay", "relay2", now.Add(-time.Second), false),
+			Entry("lease of the relay", "relay1", now.Add(time.Minute), false),
+		)
+	})
+})
@@ -0,0 +1,13 @@
+package pagination
+
+import "time"
+
+// SetNow replaces the clock tokens are stamped and checked with, and returns a function that restores it.
+func SetNow(clock func() time.Time) func() {
+	previous := now
+	now = clock
+
+	return func() {
+		now = previous
+	}
+}
@@ -0,0 +1,159 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+)
+
+const (
+	// DefaultPageSize is used when the client does not set a page size.
+	DefaultPageSize = 50
+
+	// MaxPageSize is the largest page returned. Larger page sizes are coerced to it.
+	MaxPageSize = 100
+
+	// TokenTTL is how long a page token can be used after it was returned.
+	TokenTTL = 24 * time.Hour
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+
+	// ErrExpiredPageToken is returned for page tokens older than TokenTTL. It is an ErrInvalidPageToken.
+	ErrExpiredPageToken = fmt.Errorf("%w: expired", ErrInvalidPageToken)
+)
+
+// now returns the current time. It is replaced in specs.
+var now = time.Now
+
+// Cursor is the position of the last item of a page in a list ordered by create time and document id.
+type Cursor struct {
+	CreateTime time.Time `json:"t"`
+	Id         string    `json:"i"`
+}
+
+// Page selects a page of a list. A nil Page selects the whole list.
+type Page struct {
+	Size  int
+	After *Cursor
+}
+
+// NewPage returns the page selected by the page size and the cursor of the previous page, if any.
+func NewPage(pageSize int32, after *Cursor) *Page {
+	size := int(pageSize)
+
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	return &Page{Size: size, After: after}
+}
+
+// Query orders the query by create time and document id in the given direction and limits it to the page.
+// One extra document is fetched to find out if there is a next page.
+func (p *Page) Query(query firestore.Query, direction firestore.Direction) firestore.Query {
+	query = query.OrderBy("create_time", direction).OrderBy(firestore.DocumentID, direction)
+
+	if p == nil {
+		return query
+	}
+
+	if p.After != nil {
+		query = query.StartAfter(p.After.CreateTime, p.After.Id)
+	}
+
+	return query.Limit(p.Size + 1)
+}
+
+// Next trims the extra document fetched by Query and returns the cursor of the next page, or nil if there are no more documents.
+func (p *Page) Next(docs []*firestore.DocumentSnapshot) ([]*firestore.DocumentSnapshot, *Cursor) {
+	if p == nil || len(docs) <= p.Size {
+		return docs, nil
+	}
+
+	docs = docs[:p.Size]
+	last := docs[len(docs)-1]
+
+	createTime, _ := last.Data()["create_time"].(time.Time)
+
+	return docs, &Cursor{CreateTime: createTime, Id: last.Ref.ID}
+}
+
+// tokenPayload is the signed payload of a page token.
+type tokenPayload struct {
+	Cursor
+
+	// ExpireTime is when the token expires, in seconds since the Unix epoch.
+	ExpireTime int64 `json:"e"`
+}
+
+// EncodeToken returns an opaque page token for a cursor. The token is signed with the secret and bound to the scope,
+// for example the parent of the list, so that it can neither be modified nor reused for a different list, and it
+// expires after TokenTTL.
+func EncodeToken(secret string, scope string, cursor *Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	payload, _ := json.Marshal(&tokenPayload{Cursor: *cursor, ExpireTime: now().Add(TokenTTL).Unix()})
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(sign(secret, scope, payload))
+}
+
+// DecodeToken verifies a page token created by EncodeToken and returns its cursor. An empty token returns a nil cursor.
+func DecodeToken(secret string, scope string, token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(token, ".")
+
+	if len(parts) != 2 {
+		return nil, ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+
+	if err != nil || !hmac.Equal(signature, sign(secret, scope, payload)) {
+		return nil, ErrInvalidPageToken
+	}
+
+	var t tokenPayload
+
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	if now().Unix() >= t.ExpireTime {
+		return nil, ErrExpiredPageToken
+	}
+
+	return &t.Cursor, nil
+}
+
+func sign(secret string, scope string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(scope))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
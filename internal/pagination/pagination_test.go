@@ -0,0 +1,98 @@
+package pagination_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/ride-app/payments-service/internal/pagination"
+)
+
+var _ = Describe("Pagination", func() {
+	const (
+		secret = "secret1"
+		scope  = "users/user1/wallet?filter1"
+	)
+
+	cursor := &pagination.Cursor{CreateTime: time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), Id: "transaction1"}
+
+	Describe("NewPage", func() {
+		DescribeTable("coerces page sizes",
+			func(pageSize int32, size int) {
+				Expect(pagination.NewPage(pageSize, nil).Size).To(Equal(size))
+			},
+			Entry("unset", int32(0), pagination.DefaultPageSize),
+			Entry("negative", int32(-1), pagination.DefaultPageSize),
+			Entry("within the maximum", int32(20), 20),
+			Entry("above the maximum", int32(1000), pagination.MaxPageSize),
+		)
+	})
+
+	Describe("tokens", func() {
+		It("decodes the cursor of a token", func() {
+			decoded, err := pagination.DecodeToken(secret, scope, pagination.EncodeToken(secret, scope, cursor))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.Id).To(Equal(cursor.Id))
+			Expect(decoded.CreateTime.Equal(cursor.CreateTime)).To(BeTrue())
+		})
+
+		It("returns no token for the last page and no cursor for the first page", func() {
+			Expect(pagination.EncodeToken(secret, scope, nil)).To(BeEmpty())
+			Expect(pagination.DecodeToken(secret, scope, "")).To(BeNil())
+		})
+
+		It("rejects tokens whose cursor was tampered with", func() {
+			token := pagination.EncodeToken(secret, scope, cursor)
+			parts := strings.Split(token, ".")
+			payload, _ := base64.RawURLEncoding.DecodeString(parts[0])
+			tampered := strings.Replace(string(payload), "transaction1", "transaction2", 1)
+
+			_, err := pagination.DecodeToken(secret, scope, base64.RawURLEncoding.EncodeToString([]byte(tampered))+"."+parts[1])
+
+			Expect(err).To(MatchError(pagination.ErrInvalidPageToken))
+		})
+
+		DescribeTable("rejects malformed tokens",
+			func(token string) {
+				_, err := pagination.DecodeToken(secret, scope, token)
+
+				Expect(err).To(MatchError(pagination.ErrInvalidPageToken))
+			},
+			Entry("without a signature", "eyJpIjoidHJhbnNhY3Rpb24xIn0"),
+			Entry("with a payload that is not base64", "!!!.c2lnbmF0dXJl"),
+			Entry("with a forged signature", "eyJpIjoidHJhbnNhY3Rpb24xIn0.c2lnbmF0dXJl"),
+		)
+
+		It("rejects tokens of another list", func() {
+			_, err := pagination.DecodeToken(secret, "users/user2/wallet?filter1", pagination.EncodeToken(secret, scope, cursor))
+
+			Expect(err).To(MatchError(pagination.ErrInvalidPageToken))
+		})
+
+		It("rejects tokens signed with another secret", func() {
+			_, err := pagination.DecodeToken(secret, scope, pagination.EncodeToken("secret2", scope, cursor))
+
+			Expect(err).To(MatchError(pagination.ErrInvalidPageToken))
+		})
+
+		It("rejects tokens once they expired", func() {
+			issueTime := time.Now()
+			restore := pagination.SetNow(func() time.Time { return issueTime })
+			DeferCleanup(restore)
+
+			token := pagination.EncodeToken(secret, scope, cursor)
+
+			pagination.SetNow(func() time.Time { return issueTime.Add(pagination.TokenTTL - time.Second) })
+			_, err := pagination.DecodeToken(secret, scope, token)
+			Expect(err).NotTo(HaveOccurred())
+
+			pagination.SetNow(func() time.Time { return issueTime.Add(pagination.TokenTTL) })
+			_, err = pagination.DecodeToken(secret, scope, token)
+			Expect(err).To(MatchError(pagination.ErrExpiredPageToken))
+			Expect(err).To(MatchError(pagination.ErrInvalidPageToken))
+		})
+	})
+})
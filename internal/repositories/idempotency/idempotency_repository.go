@@ -0,0 +1,174 @@
+//go:generate go run github.com/golang/mock/mockgen -destination ./mock/$GOFILE . IdempotencyRepository
+
+package idempotencyrepository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	firebase "firebase.google.com/go/v4"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	"github.com/ride-app/payments-service/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Key identifies a request made by a caller to a method.
+type Key struct {
+	Method    string
+	Caller    string
+	RequestId string
+}
+
+// Record is the stored state of a request. Response is nil while the request is still being processed.
+type Record struct {
+	RequestHash string
+	Response    []byte
+
+	// Failed is set when the request failed after it may have been applied, so it can neither be replayed nor retried.
+	Failed bool
+}
+
+type IdempotencyRepository interface {
+	// Reserve claims the key for a request. It returns nil if the key was claimed and the existing record if the key is already in use.
+	// A key is claimed with a lease that the request renews while it runs, and a request that stopped renewing it,
+	// for example because its server crashed, loses the key once the lease lapses.
+	Reserve(ctx context.Context, log logger.Logger, key *Key, requestHash string) (*Record, error)
+
+	// Renew extends the lease of a key claimed by a request that is still running.
+	Renew(ctx context.Context, log logger.Logger, key *Key) error
+
+	// Complete stores the response of a request so that it can be replayed.
+	Complete(ctx context.Context, log logger.Logger, key *Key, response []byte) error
+
+	// Release frees the key of a failed request so that the client can retry it.
+	Release(ctx context.Context, log logger.Logger, key *Key) error
+
+	// Fail keeps the key of a request that failed after it may have been applied, so that it is not applied twice.
+	Fail(ctx context.Context, log logger.Logger, key *Key) error
+}
+
+type FirestoreImpl struct {
+	config    *config.Config
+	firestore *firestore.Client
+}
+
+func NewFirestoreIdempotencyRepository(config *config.Config, firebaseApp *firebase.App) (*FirestoreImpl, error) {
+	firestore, err := firebaseApp.Firestore(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirestoreImpl{config: config, firestore: firestore}, nil
+}
+
+func (r *FirestoreImpl) Reserve(ctx context.Context, log logger.Logger, key *Key, requestHash string) (*Record, error) {
+	ref := r.doc(key)
+
+	var record *Record
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		record = nil
+
+		doc, err := tx.Get(ref)
+
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		// Expired keys may not have been deleted by the TTL policy yet.
+		if doc.Exists() && doc.Data()["expire_time"].(time.Time).After(time.Now()) {
+			record = &Record{
+				RequestHash: doc.Data()["request_hash"].(string),
+			}
+
+			record.Response, _ = doc.Data()["response"].([]byte)
+			record.Failed, _ = doc.Data()["failed"].(bool)
+
+			leaseExpireTime, leased := doc.Data()["lease_expire_time"].(time.Time)
+
+			if record.Response != nil || record.Failed || !leased || leaseExpireTime.After(time.Now()) {
+				return nil
+			}
+
+			log.Warn("Lease of request id lapsed, claiming it")
+			record = nil
+		}
+
+		createTime := time.Now()
+
+		return tx.Set(ref, map[string]interface{}{
+			"method":            key.Method,
+			"caller":            key.Caller,
+			"request_id":        key.RequestId,
+			"request_hash":      requestHash,
+			"create_time":       createTime,
+			"lease_expire_time": createTime.Add(r.config.IdempotencyLeaseTTL),
+			"expire_time":       createTime.Add(r.config.IdempotencyKeyTTL),
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *FirestoreImpl) Renew(ctx context.Context, log logger.Logger, key *Key) error {
+	_, err := r.doc(key).Update(ctx, []firestore.Update{
+		{
+			Path:  "lease_expire_time",
+			Value: time.Now().Add(r.config.IdempotencyLeaseTTL),
+		},
+	})
+
+	return err
+}
+
+func (r *FirestoreImpl) Complete(ctx context.Context, log logger.Logger, key *Key, response []byte) error {
+	_, err := r.doc(key).Update(ctx, []firestore.Update{
+		{
+			Path:  "response",
+			Value: response,
+		},
+		{
+			Path:  "lease_expire_time",
+			Value: firestore.Delete,
+		},
+	})
+
+	return err
+}
+
+func (r *FirestoreImpl) Release(ctx context.Context, log logger.Logger, key *Key) error {
+	_, err := r.doc(key).Delete(ctx)
+
+	return err
+}
+
+func (r *FirestoreImpl) Fail(ctx context.Context, log logger.Logger, key *Key) error {
+	_, err := r.doc(key).Update(ctx, []firestore.Update{
+		{
+			Path:  "failed",
+			Value: true,
+		},
+		{
+			Path:  "lease_expire_time",
+			Value: firestore.Delete,
+		},
+	})
+
+	return err
+}
+
+// doc returns the document of a key. Request ids are chosen by clients so they are hashed into a valid document id.
+func (r *FirestoreImpl) doc(key *Key) *firestore.DocumentRef {
+	hash := sha256.Sum256([]byte(key.Method + "/" + key.Caller + "/" + key.RequestId))
+
+	return r.firestore.Collection("idempotency-keys").Doc(hex.EncodeToString(hash[:]))
+}
@@ -12,6 +12,8 @@ import (
 	logger "github.com/dragonfish/go/v2/pkg/logger"
 	gomock "github.com/golang/mock/gomock"
 	paymentsv1alpha1 "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	pagination "github.com/ride-app/payments-service/internal/pagination"
+	payoutrepository "github.com/ride-app/payments-service/internal/repositories/payout"
 )
 
 // MockPayoutRepository is a mock of PayoutRepository interface.
@@ -38,10 +40,10 @@ func (m *MockPayoutRepository) EXPECT() *MockPayoutRepositoryMockRecorder {
 }
 
 // CancelPayout mocks base method.
-func (m *MockPayoutRepository) CancelPayout(arg0 context.Context, arg1 logger.Logger, arg2 string) (*time.Time, error) {
+func (m *MockPayoutRepository) CancelPayout(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Payout) (*paymentsv1alpha1.Payout, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CancelPayout", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*time.Time)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Payout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -53,18 +55,18 @@ func (mr *MockPayoutRepositoryMockRecorder) CancelPayout(arg0, arg1, arg2 interf
 }
 
 // CreatePayout mocks base method.
-func (m *MockPayoutRepository) CreatePayout(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.PayoutAccount, arg3 *paymentsv1alpha1.Payout) (*paymentsv1alpha1.Payout, error) {
+func (m *MockPayoutRepository) CreatePayout(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.PayoutAccount, arg3 *paymentsv1alpha1.Payout, arg4 string) (*paymentsv1alpha1.Payout, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePayout", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "CreatePayout", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(*paymentsv1alpha1.Payout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePayout indicates an expected call of CreatePayout.
-func (mr *MockPayoutRepositoryMockRecorder) CreatePayout(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockPayoutRepositoryMockRecorder) CreatePayout(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockPayoutRepository)(nil).CreatePayout), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayout", reflect.TypeOf((*MockPayoutRepository)(nil).CreatePayout), arg0, arg1, arg2, arg3, arg4)
 }
 
 // CreatePayoutAccount mocks base method.
@@ -113,16 +115,62 @@ func (mr *MockPayoutRepositoryMockRecorder) GetPayoutAccount(arg0, arg1, arg2 in
 }
 
 // GetPayouts mocks base method.
-func (m *MockPayoutRepository) GetPayouts(arg0 context.Context, arg1 logger.Logger, arg2 string) ([]*paymentsv1alpha1.Payout, error) {
+func (m *MockPayoutRepository) GetPayouts(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *payoutrepository.Filter, arg4 *pagination.Page) ([]*paymentsv1alpha1.Payout, *pagination.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPayouts", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetPayouts", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*paymentsv1alpha1.Payout)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPayouts indicates an expected call of GetPayouts.
+func (mr *MockPayoutRepositoryMockRecorder) GetPayouts(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*MockPayoutRepository)(nil).GetPayouts), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SendPayout mocks base method.
+func (m *MockPayoutRepository) SendPayout(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.PayoutAccount, arg3 *paymentsv1alpha1.Payout) (*paymentsv1alpha1.Payout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPayout", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Payout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPayouts indicates an expected call of GetPayouts.
-func (mr *MockPayoutRepositoryMockRecorder) GetPayouts(arg0, arg1, arg2 interface{}) *gomock.Call {
+// SendPayout indicates an expected call of SendPayout.
+func (mr *MockPayoutRepositoryMockRecorder) SendPayout(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPayout", reflect.TypeOf((*MockPayoutRepository)(nil).SendPayout), arg0, arg1, arg2, arg3)
+}
+
+// UpdatePayout mocks base method.
+func (m *MockPayoutRepository) UpdatePayout(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Payout, arg3 []paymentsv1alpha1.Payout_Status) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayout", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayout indicates an expected call of UpdatePayout.
+func (mr *MockPayoutRepositoryMockRecorder) UpdatePayout(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayout", reflect.TypeOf((*MockPayoutRepository)(nil).UpdatePayout), arg0, arg1, arg2, arg3)
+}
+
+// UpdatePayoutAccount mocks base method.
+func (m *MockPayoutRepository) UpdatePayoutAccount(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.PayoutAccount) (*paymentsv1alpha1.PayoutAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayoutAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*paymentsv1alpha1.PayoutAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayoutAccount indicates an expected call of UpdatePayoutAccount.
+func (mr *MockPayoutRepositoryMockRecorder) UpdatePayoutAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayouts", reflect.TypeOf((*MockPayoutRepository)(nil).GetPayouts), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayoutAccount", reflect.TypeOf((*MockPayoutRepository)(nil).UpdatePayoutAccount), arg0, arg1, arg2)
 }
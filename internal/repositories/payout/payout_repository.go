@@ -3,12 +3,9 @@
 package payoutrepository
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
-	"io"
-	"net/http"
+	"fmt"
 	"strings"
 	"time"
 
@@ -18,122 +15,180 @@ import (
 	"github.com/dragonfish/go/v2/pkg/logger"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
 	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/gateway"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/ride-app/payments-service/internal/outbox"
+	"github.com/ride-app/payments-service/internal/pagination"
 	"github.com/thoas/go-funk"
-	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PayoutRepository interface {
-	CreatePayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout) (*pb.Payout, error)
+	CreatePayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout, transactionId string) (*pb.Payout, error)
+
+	SendPayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout) (*pb.Payout, error)
 
 	GetPayout(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Payout, error)
 
-	GetPayouts(ctx context.Context, log logger.Logger, userId string) ([]*pb.Payout, error)
+	GetPayouts(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Payout, *pagination.Cursor, error)
 
-	// UpdatePayout(ctx context.Context, log logger.Logger, payout *pb.Payout) (createTime *time.Time, err error)
+	UpdatePayout(ctx context.Context, log logger.Logger, payout *pb.Payout, from []pb.Payout_Status) (updateTime *time.Time, err error)
 
-	CancelPayout(ctx context.Context, log logger.Logger, id string) (createTime *time.Time, err error)
+	CancelPayout(ctx context.Context, log logger.Logger, payout *pb.Payout) (*pb.Payout, error)
 
 	CreatePayoutAccount(ctx context.Context, log logger.Logger, name string, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error)
 
 	GetPayoutAccount(ctx context.Context, log logger.Logger, userId string) (*pb.PayoutAccount, error)
 
-	// UpdatePayoutAccount(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount) (updateTime *time.Time, err error)
+	UpdatePayoutAccount(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error)
+}
+
+var (
+	// ErrPayoutNotPending is returned when cancelling a payout that is no longer pending.
+	ErrPayoutNotPending = errors.New("payout is not pending")
+
+	// ErrCancelInProgress is returned when cancelling a payout whose cancellation is not yet confirmed by the gateway.
+	ErrCancelInProgress = errors.New("payout cancellation is in progress")
+
+	// ErrStatusChanged is returned when updating a payout that is no longer in any of the statuses the update expects.
+	ErrStatusChanged = errors.New("payout status changed")
+)
+
+// Filter narrows down the payouts returned by GetPayouts. Unset fields match all payouts.
+type Filter struct {
+	Status *pb.Payout_Status
+
+	// CreateTimeStart is inclusive and CreateTimeEnd is exclusive.
+	CreateTimeStart *time.Time
+	CreateTimeEnd   *time.Time
+
+	TransactionId *string
+}
+
+// apply adds the conditions of the filter to a query. A nil filter leaves the query unchanged.
+func (f *Filter) apply(query firestore.Query) firestore.Query {
+	if f == nil {
+		return query
+	}
+
+	if f.Status != nil {
+		query = query.Where("status", "==", f.Status.String())
+	}
+
+	if f.CreateTimeStart != nil {
+		query = query.Where("create_time", ">=", *f.CreateTimeStart)
+	}
+
+	if f.CreateTimeEnd != nil {
+		query = query.Where("create_time", "<", *f.CreateTimeEnd)
+	}
+
+	if f.TransactionId != nil {
+		query = query.Where("transaction_id", "==", *f.TransactionId)
+	}
+
+	return query
 }
 
 type FirestoreImpl struct {
 	config    *config.Config
 	firestore *firestore.Client
+	gateway   gateway.PaymentGateway
 }
 
-func NewFirestorePayoutRepository(config *config.Config, firebaseApp *firebase.App) (*FirestoreImpl, error) {
+func NewFirestorePayoutRepository(config *config.Config, firebaseApp *firebase.App, paymentGateway gateway.PaymentGateway) (*FirestoreImpl, error) {
 	firestore, err := firebaseApp.Firestore(context.Background())
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &FirestoreImpl{config: config, firestore: firestore}, nil
+	return &FirestoreImpl{config: config, firestore: firestore, gateway: paymentGateway}, nil
 }
 
-func (r *FirestoreImpl) CreatePayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout) (*pb.Payout, error) {
+// CreatePayout stores a pending payout, named by the caller, in the mode of the payout, or as a payout link when the
+// payout account has no fund account. transactionId is the debit of the payout. The payout is only sent by SendPayout,
+// so that the gateway is never paying out a payout that is not stored.
+func (r *FirestoreImpl) CreatePayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout, transactionId string) (*pb.Payout, error) {
 	substrings := strings.Split(payout.Name, "/")
 	userId := substrings[1]
-	payoutId := nanoid.New()
+	payoutId := substrings[4]
 
-	payout.Name = "users/" + userId + "/wallet/payouts/" + payoutId
+	payout.Status = pb.Payout_STATUS_PENDING
 
-	doc := map[string]interface{}{
-		"status": pb.Payout_STATUS_PENDING.String(),
-		"amount": payout.Amount,
+	if payout.CurrencyCode == "" {
+		payout.CurrencyCode = payoutAccount.CurrencyCode
 	}
 
-	payoutResponse, err := sendPayoutLink(r.config.Razorpay_Account_Number, payout.Amount, payoutAccount.RazorpayContactId, payout.Name)
-
-	if err != nil {
-		return nil, err
+	if payoutAccount.RazorpayFundAccountId == "" {
+		payout.Mode = pb.Payout_MODE_LINK
 	}
 
-	doc["payout_id"] = payoutResponse["id"]
+	createTime := time.Now()
 
-	// TODO: Implement the following code when implementing direct bank and upi transfer
-	// if upiId := payoutAccount.GetUpiId(); upiId != "" {
-	// } else if bankAccount := payoutAccount.GetBankAccount(); bankAccount != nil {
-	// }
+	doc := map[string]interface{}{
+		"status":         payout.Status.String(),
+		"amount":         payout.Amount,
+		"currency_code":  payout.CurrencyCode,
+		"mode":           payout.Mode.String(),
+		"transaction_id": transactionId,
+		"create_time":    createTime,
+	}
 
-	writeResult, err := r.firestore.Collection("wallets").Doc(userId).Collection("payouts").Doc(payoutId).Set(ctx, doc)
+	payout.CreateTime = timestamppb.New(createTime)
+	payout.UpdateTime = timestamppb.New(createTime)
 
-	if err != nil {
-		log.Fatalf("Failed to create payout: %v", err)
+	batch := r.firestore.Batch()
+	batch.Create(r.firestore.Collection("wallets").Doc(userId).Collection("payouts").Doc(payoutId), doc)
+
+	if err := outbox.AddToBatch(batch, r.firestore, outbox.PayoutStatusChanged(payout)); err != nil {
 		return nil, err
 	}
 
-	payout.CreateTime = timestamppb.New(writeResult.UpdateTime)
-	payout.UpdateTime = timestamppb.New(writeResult.UpdateTime)
+	if _, err := batch.Commit(ctx); err != nil {
+		return nil, err
+	}
 
 	return payout, nil
 }
 
-func sendPayoutLink(accountNumber string, amount int32, contactId string, payoutName string) (map[string]interface{}, error) {
-	body, _ := json.Marshal(map[string]interface{}{
-		"account_number": accountNumber,
-		"amount":         amount,
-		"description":    "Payout for " + payoutName,
-		"contact": map[string]interface{}{
-			"id": contactId,
-		},
-		"currency":             "INR",
-		"purpose":              "payout",
-		"send_sms":             true,
-		"queue_if_low_balance": true,
-		"notes": map[string]interface{}{
-			"reference_id": payoutName,
-		},
-	})
-
-	resp, err := http.Post("https://api.razorpay.com/v1/payout-links", "application/json", bytes.NewBuffer(body))
+// SendPayout sends a stored payout to the fund account of the payout account, or as a payout link, and records the id
+// of the gateway payout. The name of the payout is the reference of the gateway payout, so sending it again does not
+// pay it twice. Errors that do not match gateway.ErrRejected leave it unknown whether the payout was sent.
+func (r *FirestoreImpl) SendPayout(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount, payout *pb.Payout) (*pb.Payout, error) {
+	substrings := strings.Split(payout.Name, "/")
 
-	if err != nil {
-		return nil, err
+	if payout.Mode == pb.Payout_MODE_LINK {
+		log.Info("Sending payout link")
+	} else {
+		log.Infof("Sending payout over %s", payout.Mode)
 	}
 
-	defer resp.Body.Close()
-
-	data, err := io.ReadAll(resp.Body)
+	gatewayPayout, err := r.gateway.CreatePayout(ctx, &gateway.PayoutRequest{
+		FundAccountId: payoutAccount.RazorpayFundAccountId,
+		ContactId:     payoutAccount.RazorpayContactId,
+		Mode:          payout.Mode,
+		Amount:        payout.Amount,
+		CurrencyCode:  payout.CurrencyCode,
+		Reference:     payout.Name,
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	var response map[string]interface{}
-
-	err = json.Unmarshal(data, &response)
+	_, err = r.firestore.Collection("wallets").Doc(substrings[1]).Collection("payouts").Doc(substrings[4]).Update(ctx, []firestore.Update{
+		{Path: "payout_id", Value: gatewayPayout.Id},
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return response, nil
+	return r.GetPayout(ctx, log, substrings[1], substrings[4])
 }
 
 func (r *FirestoreImpl) GetPayout(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Payout, error) {
@@ -156,167 +211,298 @@ func (r *FirestoreImpl) GetPayout(ctx context.Context, log logger.Logger, userId
 	return payout, nil
 }
 
-func (r *FirestoreImpl) GetPayouts(ctx context.Context, log logger.Logger, userId string) ([]*pb.Payout, error) {
-	iter := r.firestore.Collection("wallets").Doc(userId).Collection("payout").Documents(ctx)
+func (r *FirestoreImpl) GetPayouts(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Payout, *pagination.Cursor, error) {
+	query := filter.apply(r.firestore.Collection("wallets").Doc(userId).Collection("payouts").Query)
 
-	payouts := []*pb.Payout{}
+	docs, err := page.Query(query, firestore.Desc).Documents(ctx).GetAll()
 
-	for {
-		doc, err := iter.Next()
-		if err == iterator.Done {
-			break
-		}
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	docs, next := page.Next(docs)
 
+	payouts := make([]*pb.Payout, 0, len(docs))
+
+	for _, doc := range docs {
 		payout := docToPayout(doc)
 
-		if payout != nil {
-			return nil, errors.New("invalid payout")
+		if payout == nil {
+			return nil, nil, errors.New("invalid payout")
 		}
 
 		payouts = append(payouts, payout)
 	}
 
-	return payouts, nil
+	return payouts, next, nil
 }
 
-// func (r *FirestoreImpl) UpdatePayout(ctx context.Context, log logger.Logger, payout *pb.Payout) (createTime *time.Time, err error) {
-// 	return nil, nil
-// }
-
-func (r *FirestoreImpl) CancelPayout(ctx context.Context, log logger.Logger, id string) (createTime *time.Time, err error) {
-	return nil, nil
-}
+// UpdatePayout writes the status and metadata of a payout along with a payout.status_changed event, failing with
+// ErrStatusChanged unless the payout is in one of the from statuses. The transaction id is stored as soon as the
+// wallet is debited, but is only surfaced by docToPayout once the payout succeeds. Payouts are only updated as
+// cancelled once the gateway reports the cancellation, so the cancellation is recorded as confirmed.
+func (r *FirestoreImpl) UpdatePayout(ctx context.Context, log logger.Logger, payout *pb.Payout, from []pb.Payout_Status) (updateTime *time.Time, err error) {
+	substrings := strings.Split(payout.Name, "/")
+	ref := r.firestore.Collection("wallets").Doc(substrings[1]).Collection("payouts").Doc(substrings[4])
 
-// docToPayout is a helper function that converts a Firestore document to a Payout struct.
-func docToPayout(doc *firestore.DocumentSnapshot) *pb.Payout {
+	updates := []firestore.Update{
+		{Path: "status", Value: payout.Status.String()},
+	}
 
-	if !(funk.Contains(doc.Data(), "amount") && funk.Contains(doc.Data(), "status")) {
-		return nil
+	switch metadata := payout.Metadata.(type) {
+	case *pb.Payout_TransactionId:
+		updates = append(updates, firestore.Update{Path: "transaction_id", Value: metadata.TransactionId})
+	case *pb.Payout_FailureReason:
+		updates = append(updates, firestore.Update{Path: "failure_reason", Value: metadata.FailureReason})
 	}
 
-	return &pb.Payout{
-		Name:       "users/" + doc.Ref.ID + "/wallet/payouts/" + doc.Ref.ID,
-		Amount:     doc.Data()["amount"].(int32),
-		Status:     *pb.Payout_Status(pb.Payout_Status_value["STATUS_"+doc.Data()["status"].(string)]).Enum(),
-		CreateTime: timestamppb.New(doc.CreateTime),
-		UpdateTime: timestamppb.New(doc.UpdateTime),
+	if payout.Status == pb.Payout_STATUS_CANCELLED {
+		updates = append(updates, cancelUpdates(substrings[4])...)
 	}
-}
 
-func (r *FirestoreImpl) CreatePayoutAccount(ctx context.Context, log logger.Logger, name string, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error) {
-	userId := strings.Split(payoutAccount.Name, "/")[1]
+	now := time.Now()
+
+	err = r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil {
+			return err
+		}
 
-	body, _ := json.Marshal(map[string]interface{}{
-		"name":          name,
-		"referenced_id": userId,
-	}) // marshal the payout API payload to JSON
+		updated := docToPayout(doc)
 
-	resp, err := http.Post("https://api.razorpay.com/v1/contacts", "application/json", bytes.NewBuffer(body))
+		if updated == nil {
+			return errors.New("invalid payout")
+		}
+
+		if !funk.Contains(from, updated.Status) {
+			return fmt.Errorf("%w: payout is %v", ErrStatusChanged, updated.Status)
+		}
+
+		updated.Status = payout.Status
+		updated.Metadata = payout.Metadata
+		updated.UpdateTime = timestamppb.New(now)
+
+		if err := tx.Update(ref, updates); err != nil {
+			return err
+		}
+
+		return outbox.Add(tx, r.firestore, outbox.PayoutStatusChanged(updated))
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	defer resp.Body.Close()
+	return &now, nil
+}
+
+// CancelPayout marks a pending payout as cancelled and then cancels it on the payment gateway.
+// The payout is marked first so that webhooks of the payout wait for the cancellation, and is restored to pending
+// if the gateway does not confirm it. Once confirmed, the cancel time and the refund transaction of the payout are
+// recorded, and cancelling the payout again returns it, so that the caller can retry the refund.
+// Cancelling a payout whose cancellation is still unconfirmed fails with ErrCancelInProgress.
+func (r *FirestoreImpl) CancelPayout(ctx context.Context, log logger.Logger, payout *pb.Payout) (*pb.Payout, error) {
+	substrings := strings.Split(payout.Name, "/")
+	ref := r.firestore.Collection("wallets").Doc(substrings[1]).Collection("payouts").Doc(substrings[4])
+
+	var gatewayPayoutId string
+	var cancelled *pb.Payout
+	confirmed := false
 
-	data, err := io.ReadAll(resp.Body)
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil {
+			return err
+		}
+
+		if doc.Data()["status"] == pb.Payout_STATUS_CANCELLED.String() {
+			if !funk.Contains(doc.Data(), "cancel_time") {
+				return ErrCancelInProgress
+			}
+
+			confirmed = true
+			return nil
+		}
+
+		if doc.Data()["status"] != pb.Payout_STATUS_PENDING.String() {
+			return ErrPayoutNotPending
+		}
+
+		gatewayPayoutId, _ = doc.Data()["payout_id"].(string)
+
+		cancelled = docToPayout(doc)
+
+		if cancelled == nil {
+			return errors.New("invalid payout")
+		}
+
+		cancelled.Status = pb.Payout_STATUS_CANCELLED
+		cancelled.UpdateTime = timestamppb.Now()
+
+		if err := tx.Update(ref, []firestore.Update{
+			{Path: "status", Value: pb.Payout_STATUS_CANCELLED.String()},
+		}); err != nil {
+			return err
+		}
+
+		return outbox.Add(tx, r.firestore, outbox.PayoutStatusChanged(cancelled))
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	var contactResponse map[string]interface{}
+	if confirmed {
+		log.Info("Payout already cancelled")
+		return r.GetPayout(ctx, log, substrings[1], substrings[4])
+	}
+
+	log.Info("Cancelling payout on payment gateway")
+	if err := r.gateway.CancelPayout(ctx, gatewayPayoutId); err != nil {
+		log.WithError(err).Warn("Payment gateway did not confirm cancellation, restoring pending status")
+
+		if err := r.restorePendingPayout(ctx, ref, cancelled); err != nil {
+			log.WithError(err).Error("Failed to restore pending status")
+		}
+
+		return nil, err
+	}
 
-	err = json.Unmarshal(data, &contactResponse)
+	_, err = ref.Update(ctx, cancelUpdates(substrings[4]))
 
 	if err != nil {
 		return nil, err
 	}
 
-	doc := map[string]interface{}{
-		"currency":            "INR",
-		"razorpay_contact_id": contactResponse["id"],
+	return r.GetPayout(ctx, log, substrings[1], substrings[4])
+}
+
+// cancelUpdates records the confirmed cancellation of a payout and the transaction its refund is credited by.
+func cancelUpdates(payoutId string) []firestore.Update {
+	return []firestore.Update{
+		{Path: "cancel_time", Value: time.Now()},
+		{Path: "refund_transaction_id", Value: RefundTransactionId(payoutId)},
 	}
+}
+
+// RefundTransactionId is the id of the transaction crediting a failed or cancelled payout back to its wallet.
+func RefundTransactionId(payoutId string) string {
+	return "payout-refund-" + payoutId
+}
+
+// restorePendingPayout puts a payout that failed to cancel back to pending, along with a payout.status_changed event.
+// A payout whose cancellation was confirmed by a webhook in the meantime stays cancelled.
+func (r *FirestoreImpl) restorePendingPayout(ctx context.Context, ref *firestore.DocumentRef, payout *pb.Payout) error {
+	payout.Status = pb.Payout_STATUS_PENDING
+	payout.UpdateTime = timestamppb.Now()
+
+	return r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil {
+			return err
+		}
+
+		if doc.Data()["status"] != pb.Payout_STATUS_CANCELLED.String() || funk.Contains(doc.Data(), "cancel_time") {
+			return nil
+		}
+
+		if err := tx.Update(ref, []firestore.Update{{Path: "status", Value: pb.Payout_STATUS_PENDING.String()}}); err != nil {
+			return err
+		}
+
+		return outbox.Add(tx, r.firestore, outbox.PayoutStatusChanged(payout))
+	})
+}
 
-	// TODO: Implement the following code when implementing direct bank and upi transfer
-	// if payoutAccount.Destination != nil {
-	// 	payload := map[string]interface{}{
-	// 		"contact_id": contactResponse["id"],
-	// 	}
-	// 	switch payoutAccount.Destination.(type) {
-	// 	case *pb.PayoutAccount_BankAccount_:
-	// 		bankAccount := payoutAccount.GetBankAccount()
+// docToPayout is a helper function that converts a Firestore document to a Payout struct.
+func docToPayout(doc *firestore.DocumentSnapshot) *pb.Payout {
 
-	// 		payload["account_type"] = "bank_account"
-	// 		payload["bank_account"] = map[string]interface{}{
-	// 			"name":           bankAccount.HolderName,
-	// 			"ifsc":           bankAccount.IfscCode,
-	// 			"account_number": bankAccount.AccountNumber,
-	// 		}
-	// 	case *pb.PayoutAccount_UpiId:
-	// 		upiId := payoutAccount.GetUpiId()
+	amount, ok := money.Amount(doc.Data()["amount"])
 
-	// 		payload["account_type"] = "vpa"
-	// 		payload["vpa"] = map[string]interface{}{
-	// 			"address": upiId,
-	// 		}
+	if !(ok && funk.Contains(doc.Data(), "status")) {
+		return nil
+	}
 
-	// 	default:
-	// 		return nil, errors.New("invalid payout account")
-	// 	}
+	payout := &pb.Payout{
+		Name:         "users/" + doc.Ref.Parent.Parent.ID + "/wallet/payouts/" + doc.Ref.ID,
+		Amount:       amount,
+		CurrencyCode: money.CurrencyCode(doc.Data()["currency_code"]),
+		Status:       pb.Payout_Status(pb.Payout_Status_value[doc.Data()["status"].(string)]),
+		Mode:         pb.Payout_MODE_LINK,
+		CreateTime:   timestamppb.New(doc.CreateTime),
+		UpdateTime:   timestamppb.New(doc.UpdateTime),
+	}
 
-	// 	body, err = json.Marshal(payload)
+	// Payouts created before direct payouts were always sent as links.
+	if mode, ok := doc.Data()["mode"].(string); ok {
+		payout.Mode = pb.Payout_Mode(pb.Payout_Mode_value[mode])
+	}
 
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
+	if transactionId, ok := doc.Data()["transaction_id"].(string); ok && payout.Status == pb.Payout_STATUS_SUCCESS {
+		payout.Metadata = &pb.Payout_TransactionId{TransactionId: transactionId}
+	} else if failureReason, ok := doc.Data()["failure_reason"].(string); ok && payout.Status == pb.Payout_STATUS_FAILED {
+		payout.Metadata = &pb.Payout_FailureReason{FailureReason: failureReason}
+	}
 
-	// 	resp, err = http.Post("https://api.razorpay.com/v1/fund_accounts", "application/json", bytes.NewBuffer(body))
+	return payout
+}
 
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
+// CreatePayoutAccount creates the gateway contact of a user and, when a destination is set, a fund account for it.
+func (r *FirestoreImpl) CreatePayoutAccount(ctx context.Context, log logger.Logger, name string, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error) {
+	userId := strings.Split(payoutAccount.Name, "/")[1]
 
-	// 	defer resp.Body.Close()
+	log.Info("Creating gateway contact")
+	contact, err := r.gateway.CreateContact(ctx, name, userId)
 
-	// 	data, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
 
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
+	payoutAccount.RazorpayContactId = contact.Id
+	payoutAccount.CurrencyCode = money.CurrencyCode(payoutAccount.CurrencyCode)
 
-	// 	var fundAccountResponse map[string]interface{}
+	doc := map[string]interface{}{
+		"currency":            payoutAccount.CurrencyCode,
+		"razorpay_contact_id": contact.Id,
+	}
 
-	// 	err = json.Unmarshal(data, &fundAccountResponse)
+	if payoutAccount.Destination != nil {
+		log.Info("Creating gateway fund account")
+		fundAccount, err := r.gateway.CreateFundAccount(ctx, contact.Id, payoutAccount)
 
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
+		if err != nil {
+			return nil, err
+		}
 
-	// 	doc["razorpayFundAccountId"] = fundAccountResponse["id"]
-	// }
+		payoutAccount.RazorpayFundAccountId = fundAccount.Id
+		doc["razorpay_fund_account_id"] = fundAccount.Id
+		setDestination(doc, payoutAccount)
+	}
 
 	writeResult, err := r.firestore.Collection("payout-accounts").Doc(userId).Set(ctx, doc)
 
+	if err != nil {
+		return nil, err
+	}
+
 	payoutAccount.CreateTime = timestamppb.New(writeResult.UpdateTime)
 	payoutAccount.UpdateTime = timestamppb.New(writeResult.UpdateTime)
 
-	return payoutAccount, err
+	return payoutAccount, nil
 }
 
 func (r *FirestoreImpl) GetPayoutAccount(ctx context.Context, log logger.Logger, userId string) (*pb.PayoutAccount, error) {
 	doc, err := r.firestore.Collection("payout-accounts").Doc(userId).Get(ctx)
 
-	if err != nil {
-		return nil, err
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
 	}
 
-	if !doc.Exists() {
-		return nil, nil
+	if err != nil {
+		return nil, err
 	}
 
 	payoutAccount := docToPayoutAccount(doc)
@@ -328,39 +514,137 @@ func (r *FirestoreImpl) GetPayoutAccount(ctx context.Context, log logger.Logger,
 	return payoutAccount, nil
 }
 
-// TODO: Update the function when implementing the api endpoint
-// func (r *FirestoreImpl) UpdatePayoutAccount(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount) (updateTime *time.Time, err error) {
-// 	userId := strings.Split(payoutAccount.Name, "/")[1]
+// UpdatePayoutAccount moves a payout account to the destination set on it. A fund account is created for the
+// new destination before the old one is deactivated, and the replaced account is kept in the history subcollection.
+// A payout_account.updated event is added in the same transaction.
+func (r *FirestoreImpl) UpdatePayoutAccount(ctx context.Context, log logger.Logger, payoutAccount *pb.PayoutAccount) (*pb.PayoutAccount, error) {
+	userId := strings.Split(payoutAccount.Name, "/")[1]
+	ref := r.firestore.Collection("payout-accounts").Doc(userId)
+
+	if payoutAccount.RazorpayContactId == "" {
+		return nil, errors.New("payout account has no gateway contact")
+	}
+
+	log.Info("Creating gateway fund account")
+	fundAccount, err := r.gateway.CreateFundAccount(ctx, payoutAccount.RazorpayContactId, payoutAccount)
 
-// 	doc := map[string]interface{}{
-// 		"currency":   "INR",
-// 		"accountNo":  payoutAccount.GetBankAccount().AccountNumber,
-// 		"holderName": payoutAccount.GetBankAccount().HolderName,
-// 		"ifsc":       payoutAccount.GetBankAccount().IfscCode,
-// 	}
+	if err != nil {
+		return nil, err
+	}
 
-// 	writeResult, err := r.firestore.Collection("payout-accounts").Doc(userId).Set(ctx, doc, firestore.MergeAll)
+	fundAccountId := fundAccount.Id
 
-// 	return &writeResult.UpdateTime, err
-// }
+	var oldFundAccountId string
 
-func docToPayoutAccount(doc *firestore.DocumentSnapshot) *pb.PayoutAccount {
+	err = r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
 
-	if !(funk.Contains(doc.Data(), "accountNo") && funk.Contains(doc.Data(), "holderName") && funk.Contains(doc.Data(), "ifsc")) {
-		return nil
+		if err != nil {
+			return err
+		}
+
+		oldFundAccountId, _ = doc.Data()["razorpay_fund_account_id"].(string)
+
+		history := doc.Data()
+		history["replace_time"] = time.Now()
+
+		if err := tx.Create(ref.Collection("history").Doc(nanoid.New()), history); err != nil {
+			return err
+		}
+
+		update := map[string]interface{}{
+			"currency":                 money.CurrencyCode(doc.Data()["currency"]),
+			"razorpay_contact_id":      payoutAccount.RazorpayContactId,
+			"razorpay_fund_account_id": fundAccountId,
+			"destination_update_time":  time.Now(),
+		}
+
+		setDestination(update, payoutAccount)
+
+		if err := tx.Set(ref, update); err != nil {
+			return err
+		}
+
+		updated := proto.Clone(payoutAccount).(*pb.PayoutAccount)
+		updated.CurrencyCode = money.CurrencyCode(doc.Data()["currency"])
+		updated.RazorpayFundAccountId = fundAccountId
+		updated.CreateTime = timestamppb.New(doc.CreateTime)
+		updated.UpdateTime = timestamppb.Now()
+		updated.DestinationUpdateTime = updated.UpdateTime
+
+		return outbox.Add(tx, r.firestore, outbox.PayoutAccountUpdated(updated))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if oldFundAccountId != "" {
+		log.Info("Deactivating old gateway fund account")
+		if err := r.gateway.DeactivateFundAccount(ctx, oldFundAccountId); err != nil {
+			// The payout account no longer references the old fund account, so payouts can not reach it.
+			log.WithError(err).Error("Failed to deactivate old fund account")
+		}
 	}
 
-	return &pb.PayoutAccount{
+	return r.GetPayoutAccount(ctx, log, userId)
+}
+
+// setDestination adds the destination of a payout account to its document.
+func setDestination(doc map[string]interface{}, payoutAccount *pb.PayoutAccount) {
+	switch destination := payoutAccount.Destination.(type) {
+	case *pb.PayoutAccount_BankAccount_:
+		doc["bank_account"] = map[string]interface{}{
+			"holder_name":    destination.BankAccount.HolderName,
+			"account_number": destination.BankAccount.AccountNumber,
+			"ifsc_code":      destination.BankAccount.IfscCode,
+		}
+	case *pb.PayoutAccount_UpiId:
+		doc["upi_id"] = destination.UpiId
+	}
+}
+
+// docToPayoutAccount is a helper function that converts a Firestore document to a PayoutAccount struct.
+// Bank accounts stored by the legacy accountNo, holderName and ifsc fields are read as well.
+func docToPayoutAccount(doc *firestore.DocumentSnapshot) *pb.PayoutAccount {
+	data := doc.Data()
+
+	payoutAccount := &pb.PayoutAccount{
 		Name:         "users/" + doc.Ref.ID + "/wallet/payout-account",
-		CurrencyCode: "INR",
-		// Destination: &pb.PayoutAccount_BankAccount_{
-		// 	BankAccount: &pb.PayoutAccount_BankAccount{
-		// 		AccountNumber: doc.Data()["accountNo"].(string),
-		// 		HolderName:    doc.Data()["holderName"].(string),
-		// 		IfscCode:      doc.Data()["ifsc"].(string),
-		// 	},
-		// },
-		CreateTime: timestamppb.New(doc.CreateTime),
-		UpdateTime: timestamppb.New(doc.UpdateTime),
+		CurrencyCode: money.CurrencyCode(data["currency"]),
+		CreateTime:   timestamppb.New(doc.CreateTime),
+		UpdateTime:   timestamppb.New(doc.UpdateTime),
+	}
+
+	payoutAccount.RazorpayContactId, _ = data["razorpay_contact_id"].(string)
+	payoutAccount.RazorpayFundAccountId, _ = data["razorpay_fund_account_id"].(string)
+
+	if destinationUpdateTime, ok := data["destination_update_time"].(time.Time); ok {
+		payoutAccount.DestinationUpdateTime = timestamppb.New(destinationUpdateTime)
+	}
+
+	if bankAccount, ok := data["bank_account"].(map[string]interface{}); ok {
+		destination := &pb.PayoutAccount_BankAccount{}
+		destination.HolderName, _ = bankAccount["holder_name"].(string)
+		destination.AccountNumber, _ = bankAccount["account_number"].(string)
+		destination.IfscCode, _ = bankAccount["ifsc_code"].(string)
+
+		payoutAccount.Destination = &pb.PayoutAccount_BankAccount_{BankAccount: destination}
+	} else if upiId, ok := data["upi_id"].(string); ok {
+		payoutAccount.Destination = &pb.PayoutAccount_UpiId{UpiId: upiId}
+	} else if funk.Contains(data, "accountNo") && funk.Contains(data, "holderName") && funk.Contains(data, "ifsc") {
+		payoutAccount.Destination = &pb.PayoutAccount_BankAccount_{
+			BankAccount: &pb.PayoutAccount_BankAccount{
+				AccountNumber: fmt.Sprint(data["accountNo"]),
+				HolderName:    fmt.Sprint(data["holderName"]),
+				IfscCode:      fmt.Sprint(data["ifsc"]),
+			},
+		}
+	}
+
+	if payoutAccount.RazorpayContactId == "" && payoutAccount.Destination == nil {
+		return nil
 	}
+
+	return payoutAccount
 }
@@ -12,6 +12,9 @@ import (
 	logger "github.com/dragonfish/go/v2/pkg/logger"
 	gomock "github.com/golang/mock/gomock"
 	paymentsv1alpha1 "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	gateway "github.com/ride-app/payments-service/internal/gateway"
+	pagination "github.com/ride-app/payments-service/internal/pagination"
+	rechargerepository "github.com/ride-app/payments-service/internal/repositories/recharge"
 )
 
 // MockRechargeRepository is a mock of RechargeRepository interface.
@@ -38,7 +41,7 @@ func (m *MockRechargeRepository) EXPECT() *MockRechargeRepositoryMockRecorder {
 }
 
 // CreateRecharge mocks base method.
-func (m *MockRechargeRepository) CreateRecharge(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Recharge, arg3 *map[string]interface{}) (*time.Time, error) {
+func (m *MockRechargeRepository) CreateRecharge(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Recharge, arg3 *gateway.Order) (*time.Time, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateRecharge", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*time.Time)
@@ -67,17 +70,48 @@ func (mr *MockRechargeRepositoryMockRecorder) GetRecharge(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecharge", reflect.TypeOf((*MockRechargeRepository)(nil).GetRecharge), arg0, arg1, arg2, arg3)
 }
 
+// GetRechargeByReference mocks base method.
+func (m *MockRechargeRepository) GetRechargeByReference(arg0 context.Context, arg1 logger.Logger, arg2 string) (*paymentsv1alpha1.Recharge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRechargeByReference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Recharge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRechargeByReference indicates an expected call of GetRechargeByReference.
+func (mr *MockRechargeRepositoryMockRecorder) GetRechargeByReference(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRechargeByReference", reflect.TypeOf((*MockRechargeRepository)(nil).GetRechargeByReference), arg0, arg1, arg2)
+}
+
 // GetRecharges mocks base method.
-func (m *MockRechargeRepository) GetRecharges(arg0 context.Context, arg1 logger.Logger, arg2 string) ([]*paymentsv1alpha1.Recharge, error) {
+func (m *MockRechargeRepository) GetRecharges(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *rechargerepository.Filter, arg4 *pagination.Page) ([]*paymentsv1alpha1.Recharge, *pagination.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecharges", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetRecharges", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*paymentsv1alpha1.Recharge)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRecharges indicates an expected call of GetRecharges.
+func (mr *MockRechargeRepositoryMockRecorder) GetRecharges(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecharges", reflect.TypeOf((*MockRechargeRepository)(nil).GetRecharges), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateRecharge mocks base method.
+func (m *MockRechargeRepository) UpdateRecharge(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Recharge, arg3 []paymentsv1alpha1.Recharge_Status) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecharge", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*time.Time)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRecharges indicates an expected call of GetRecharges.
-func (mr *MockRechargeRepositoryMockRecorder) GetRecharges(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdateRecharge indicates an expected call of UpdateRecharge.
+func (mr *MockRechargeRepositoryMockRecorder) UpdateRecharge(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecharges", reflect.TypeOf((*MockRechargeRepository)(nil).GetRecharges), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecharge", reflect.TypeOf((*MockRechargeRepository)(nil).UpdateRecharge), arg0, arg1, arg2, arg3)
 }
@@ -5,38 +5,83 @@ package rechargerepository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
-	"cloud.google.com/go/pubsub"
 	firebase "firebase.google.com/go/v4"
 	"github.com/dragonfish/go/v2/pkg/logger"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
 	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/gateway"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/ride-app/payments-service/internal/outbox"
+	"github.com/ride-app/payments-service/internal/pagination"
 	"github.com/thoas/go-funk"
 	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // RechargeRepository is an interface that defines the methods to be implemented by the repository
 type RechargeRepository interface {
-	CreateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, checkout_response *map[string]interface{}) (createTime *time.Time, err error)
+	CreateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, order *gateway.Order) (createTime *time.Time, err error)
 	GetRecharge(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Recharge, error)
-	GetRecharges(ctx context.Context, log logger.Logger, userId string) ([]*pb.Recharge, error)
+	GetRecharges(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Recharge, *pagination.Cursor, error)
+	GetRechargeByReference(ctx context.Context, log logger.Logger, reference string) (*pb.Recharge, error)
+	UpdateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, from []pb.Recharge_Status) (updateTime *time.Time, err error)
+}
+
+// ErrStatusChanged is returned when updating a recharge that is no longer in any of the statuses the update expects.
+var ErrStatusChanged = errors.New("recharge status changed")
+
+// Filter narrows down the recharges returned by GetRecharges. Unset fields match all recharges.
+type Filter struct {
+	Status *pb.Recharge_Status
+
+	// CreateTimeStart is inclusive and CreateTimeEnd is exclusive.
+	CreateTimeStart *time.Time
+	CreateTimeEnd   *time.Time
+
+	TransactionId *string
+}
+
+// apply adds the conditions of the filter to a query. A nil filter leaves the query unchanged.
+func (f *Filter) apply(query firestore.Query) firestore.Query {
+	if f == nil {
+		return query
+	}
+
+	if f.Status != nil {
+		query = query.Where("status", "==", f.Status.String())
+	}
+
+	if f.CreateTimeStart != nil {
+		query = query.Where("create_time", ">=", *f.CreateTimeStart)
+	}
+
+	if f.CreateTimeEnd != nil {
+		query = query.Where("create_time", "<", *f.CreateTimeEnd)
+	}
+
+	if f.TransactionId != nil {
+		query = query.Where("transaction_id", "==", *f.TransactionId)
+	}
+
+	return query
 }
 
 // FirestoreImpl is a struct that implements the RechargeRepository interface
 type FirestoreImpl struct {
-	config              *config.Config
-	firestore           *firestore.Client
-	createRechargeTopic *pubsub.Topic
+	config    *config.Config
+	firestore *firestore.Client
 }
 
 // NewFirestoreRechargeRepository is a function that returns a new instance of FirestoreImpl
-// It takes in a firebase app and a pubsub client as parameters
+// It takes in a firebase app as a parameter
 // It returns a pointer to a FirestoreImpl instance and an error
-func NewFirestoreRechargeRepository(config *config.Config, firebaseApp *firebase.App, pubsubClient *pubsub.Client) (*FirestoreImpl, error) {
+func NewFirestoreRechargeRepository(config *config.Config, firebaseApp *firebase.App) (*FirestoreImpl, error) {
 	// Get a firestore client from the firebase app
 	firestore, err := firebaseApp.Firestore(context.Background())
 
@@ -44,50 +89,54 @@ func NewFirestoreRechargeRepository(config *config.Config, firebaseApp *firebase
 		return nil, err
 	}
 
-	// Get a pubsub topic for recharge creation
-	createRechargeTopic := pubsubClient.Topic("recharge/created")
-
-	// Return a pointer to a new instance of FirestoreImpl with the firestore client and pubsub topic
-	return &FirestoreImpl{config: config, firestore: firestore, createRechargeTopic: createRechargeTopic}, nil
+	// Return a pointer to a new instance of FirestoreImpl with the firestore client
+	return &FirestoreImpl{config: config, firestore: firestore}, nil
 }
 
 // CreateRecharge is a method that creates a new recharge in the firestore database
-// It takes in a context, a pointer to a pb.Recharge struct and a pointer to a map[string]interface{} struct as parameters
+// along with a recharge.status_changed event for the pending recharge
+// It takes in a context, a pointer to a pb.Recharge struct and the payment gateway order paying for it as parameters
 // It returns a pointer to a time.Time struct and an error
-func (r *FirestoreImpl) CreateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, checkout_response *map[string]interface{}) (createTime *time.Time, err error) {
-	// Split the recharge name by "/" and get the last element as the document ID
+func (r *FirestoreImpl) CreateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, order *gateway.Order) (createTime *time.Time, err error) {
+	// Split the recharge name by "/" to get the user ID and the document ID
 	substrings := strings.Split(recharge.Name, "/")
+	userId := substrings[1]
+
+	if recharge.CurrencyCode == "" {
+		recharge.CurrencyCode = money.DefaultCurrencyCode
+	}
+
+	now := time.Now()
 
 	// Create a map of fields to be added to the firestore document
 	doc := map[string]interface{}{
-		"status":    pb.Recharge_STATUS_PENDING.String(),
-		"amount":    recharge.Amount,
-		"reference": (*checkout_response)["id"].(string),
-		"method":    "razorpay",
+		"status":        pb.Recharge_STATUS_PENDING.String(),
+		"amount":        recharge.Amount,
+		"currency_code": recharge.CurrencyCode,
+		"reference":     order.Id,
+		"method":        order.Gateway,
+		"create_time":   now,
 	}
 
-	// Add the document to the firestore collection with the document ID as the last element of the substrings array
-	writeResult, err := r.firestore.Collection("recharges").Doc(substrings[len(substrings)-1]).Set(ctx, doc)
+	event := proto.Clone(recharge).(*pb.Recharge)
+	event.Status = pb.Recharge_STATUS_PENDING
+	event.CreateTime = timestamppb.New(now)
+	event.UpdateTime = timestamppb.New(now)
 
-	if err != nil {
+	// Add the document to the wallet's recharges collection with the document ID as the last element of the substrings array
+	batch := r.firestore.Batch()
+	batch.Set(r.firestore.Collection("wallets").Doc(userId).Collection("recharges").Doc(substrings[len(substrings)-1]), doc)
+
+	if err := outbox.AddToBatch(batch, r.firestore, outbox.RechargeStatusChanged(event)); err != nil {
 		return nil, err
 	}
 
-	// Publish a message to the pubsub topic for recharge creation
-	result := r.createRechargeTopic.Publish(ctx, &pubsub.Message{
-		Data: []byte(recharge.String()),
-	})
-
-	// Block until the result is returned and a server-generated ID is returned for the published message.
-	_, err = result.Get(ctx)
-
-	if err != nil {
-		log.WithError(err).Info("pubsub: recharge/created: failed to publish message")
+	if _, err := batch.Commit(ctx); err != nil {
 		return nil, err
 	}
 
-	// Return a pointer to the update time of the write result and nil for the error
-	return &writeResult.UpdateTime, nil
+	// Return a pointer to the create time and nil for the error
+	return &now, nil
 }
 
 // GetRecharge is a method that retrieves a single recharge from the firestore database
@@ -113,45 +162,139 @@ func (r *FirestoreImpl) GetRecharge(ctx context.Context, log logger.Logger, user
 	return recharge, nil
 }
 
-func (r *FirestoreImpl) GetRecharges(ctx context.Context, log logger.Logger, userId string) ([]*pb.Recharge, error) {
-	iter := r.firestore.Collection("wallets").Doc(userId).Collection("recharges").Documents(ctx)
+// GetRecharges is a method that retrieves a page of recharges of a wallet, newest first
+// It returns the recharges and the cursor of the next page, or nil if there are no more recharges
+func (r *FirestoreImpl) GetRecharges(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Recharge, *pagination.Cursor, error) {
+	query := filter.apply(r.firestore.Collection("wallets").Doc(userId).Collection("recharges").Query)
+
+	docs, err := page.Query(query, firestore.Desc).Documents(ctx).GetAll()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docs, next := page.Next(docs)
 
-	recharges := []*pb.Recharge{}
+	recharges := make([]*pb.Recharge, 0, len(docs))
 
-	for {
-		doc, err := iter.Next()
-		if err == iterator.Done {
-			break
+	for _, doc := range docs {
+		recharge := docToRecharge(doc)
+
+		if recharge == nil {
+			return nil, nil, errors.New("invalid recharge")
 		}
 
+		recharges = append(recharges, recharge)
+	}
+
+	return recharges, next, nil
+}
+
+// GetRechargeByReference is a method that retrieves the recharge created for a payment gateway order
+// It takes in a context and the gateway order ID as parameters
+// It returns a pointer to a pb.Recharge struct, or nil if no recharge references the order
+func (r *FirestoreImpl) GetRechargeByReference(ctx context.Context, log logger.Logger, reference string) (*pb.Recharge, error) {
+	iter := r.firestore.CollectionGroup("recharges").Where("reference", "==", reference).Limit(1).Documents(ctx)
+	defer iter.Stop()
+
+	doc, err := iter.Next()
+
+	if err == iterator.Done {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	recharge := docToRecharge(doc)
+
+	if recharge == nil {
+		return nil, errors.New("invalid recharge")
+	}
+
+	return recharge, nil
+}
+
+// UpdateRecharge is a method that writes the status and metadata of a recharge to the firestore database
+// along with a recharge.status_changed event in the same transaction
+// It takes in a context, a pointer to a pb.Recharge struct and the statuses the recharge is expected to be in as parameters
+// It returns a pointer to the update time, or ErrStatusChanged if the recharge is in none of the expected statuses
+func (r *FirestoreImpl) UpdateRecharge(ctx context.Context, log logger.Logger, recharge *pb.Recharge, from []pb.Recharge_Status) (updateTime *time.Time, err error) {
+	substrings := strings.Split(recharge.Name, "/")
+
+	updates := []firestore.Update{
+		{Path: "status", Value: recharge.Status.String()},
+	}
+
+	switch metadata := recharge.Metadata.(type) {
+	case *pb.Recharge_TransactionId:
+		updates = append(updates, firestore.Update{Path: "transaction_id", Value: metadata.TransactionId})
+	case *pb.Recharge_FailureReason:
+		updates = append(updates, firestore.Update{Path: "failure_reason", Value: metadata.FailureReason})
+	}
+
+	ref := r.firestore.Collection("wallets").Doc(substrings[1]).Collection("recharges").Doc(substrings[4])
+	now := time.Now()
+
+	err = r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		recharge := docToRecharge(doc)
+		updated := docToRecharge(doc)
 
-		if recharge != nil {
-			return nil, errors.New("invalid recharge")
+		if updated == nil {
+			return errors.New("invalid recharge")
 		}
 
-		recharges = append(recharges, recharge)
+		if !funk.Contains(from, updated.Status) {
+			return fmt.Errorf("%w: recharge is %v", ErrStatusChanged, updated.Status)
+		}
+
+		updated.Status = recharge.Status
+		updated.Metadata = recharge.Metadata
+		updated.UpdateTime = timestamppb.New(now)
+
+		if err := tx.Update(ref, updates); err != nil {
+			return err
+		}
+
+		return outbox.Add(tx, r.firestore, outbox.RechargeStatusChanged(updated))
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	return recharges, nil
+	return &now, nil
 }
 
 // docToRecharge is a helper function that converts a firestore document to a pb.Recharge object
 func docToRecharge(doc *firestore.DocumentSnapshot) *pb.Recharge {
 
-	if !(funk.Contains(doc.Data(), "amount") && funk.Contains(doc.Data(), "status")) {
+	amount, ok := money.Amount(doc.Data()["amount"])
+
+	if !(ok && funk.Contains(doc.Data(), "status")) {
 		return nil
 	}
 
-	return &pb.Recharge{
-		Name:       "users/" + doc.Ref.Parent.Parent.ID + "/wallet/recharges/" + doc.Ref.ID,
-		Amount:     doc.Data()["amount"].(int32),
-		Status:     *pb.Recharge_Status(pb.Recharge_Status_value["STATUS_"+doc.Data()["status"].(string)]).Enum(),
-		CreateTime: timestamppb.New(doc.CreateTime),
-		UpdateTime: timestamppb.New(doc.UpdateTime),
+	recharge := &pb.Recharge{
+		Name:         "users/" + doc.Ref.Parent.Parent.ID + "/wallet/recharges/" + doc.Ref.ID,
+		Amount:       amount,
+		CurrencyCode: money.CurrencyCode(doc.Data()["currency_code"]),
+		Status:       pb.Recharge_Status(pb.Recharge_Status_value[doc.Data()["status"].(string)]),
+		CreateTime:   timestamppb.New(doc.CreateTime),
+		UpdateTime:   timestamppb.New(doc.UpdateTime),
 	}
+
+	if transactionId, ok := doc.Data()["transaction_id"].(string); ok && recharge.Status == pb.Recharge_STATUS_SUCCESS {
+		recharge.Metadata = &pb.Recharge_TransactionId{TransactionId: transactionId}
+	} else if failureReason, ok := doc.Data()["failure_reason"].(string); ok && recharge.Status == pb.Recharge_STATUS_FAILED {
+		recharge.Metadata = &pb.Recharge_FailureReason{FailureReason: failureReason}
+	}
+
+	return recharge
 }
@@ -7,10 +7,13 @@ package mock_transfer
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	logger "github.com/dragonfish/go/v2/pkg/logger"
 	gomock "github.com/golang/mock/gomock"
 	paymentsv1alpha1 "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	pagination "github.com/ride-app/payments-service/internal/pagination"
+	transferrepository "github.com/ride-app/payments-service/internal/repositories/transfer"
 )
 
 // MockTransferRepository is a mock of TransferRepository interface.
@@ -67,16 +70,32 @@ func (mr *MockTransferRepositoryMockRecorder) GetTransfer(arg0, arg1, arg2, arg3
 }
 
 // GetTransfers mocks base method.
-func (m *MockTransferRepository) GetTransfers(arg0 context.Context, arg1 logger.Logger, arg2 string) ([]*paymentsv1alpha1.Transfer, error) {
+func (m *MockTransferRepository) GetTransfers(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *transferrepository.Filter, arg4 *pagination.Page) ([]*paymentsv1alpha1.Transfer, *pagination.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfers", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetTransfers", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*paymentsv1alpha1.Transfer)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransfers indicates an expected call of GetTransfers.
+func (mr *MockTransferRepositoryMockRecorder) GetTransfers(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfers", reflect.TypeOf((*MockTransferRepository)(nil).GetTransfers), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateTransfers mocks base method.
+func (m *MockTransferRepository) UpdateTransfers(arg0 context.Context, arg1 logger.Logger, arg2 *[]*paymentsv1alpha1.Transfer) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransfers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*time.Time)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfers indicates an expected call of GetTransfers.
-func (mr *MockTransferRepositoryMockRecorder) GetTransfers(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdateTransfers indicates an expected call of UpdateTransfers.
+func (mr *MockTransferRepositoryMockRecorder) UpdateTransfers(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfers", reflect.TypeOf((*MockTransferRepository)(nil).GetTransfers), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransfers", reflect.TypeOf((*MockTransferRepository)(nil).UpdateTransfers), arg0, arg1, arg2)
 }
@@ -15,15 +15,48 @@ import (
 	"github.com/dragonfish/go/v2/pkg/logger"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
 	"github.com/ride-app/payments-service/config"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/ride-app/payments-service/internal/outbox"
+	"github.com/ride-app/payments-service/internal/pagination"
 	"github.com/thoas/go-funk"
-	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TransferRepository interface {
 	CreateTransfers(ctx context.Context, log logger.Logger, transfers *[]*pb.Transfer) (*[]*pb.Transfer, error)
+	UpdateTransfers(ctx context.Context, log logger.Logger, transfers *[]*pb.Transfer) (updateTime *time.Time, err error)
 	GetTransfer(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Transfer, error)
-	GetTransfers(ctx context.Context, log logger.Logger, userId string) ([]*pb.Transfer, error)
+	GetTransfers(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Transfer, *pagination.Cursor, error)
+}
+
+// Filter narrows down the transfers returned by GetTransfers. A nil filter matches transfers in both directions.
+type Filter struct {
+	Direction pb.ListTransfersRequest_Direction
+}
+
+// apply restricts a query on the transfers collection to the transfers of a wallet in the direction of the filter.
+func (f *Filter) apply(query firestore.Query, userId string) firestore.Query {
+	direction := pb.ListTransfersRequest_DIRECTION_UNSPECIFIED
+
+	if f != nil {
+		direction = f.Direction
+	}
+
+	switch direction {
+	case pb.ListTransfersRequest_DIRECTION_OUTGOING:
+		return query.Where("source", "==", userId)
+	case pb.ListTransfersRequest_DIRECTION_INCOMING:
+		return query.Where("destination", "==", userId)
+	default:
+		return query.WhereEntity(firestore.OrFilter{
+			Filters: []firestore.EntityFilter{
+				firestore.PropertyFilter{Path: "source", Operator: "==", Value: userId},
+				firestore.PropertyFilter{Path: "destination", Operator: "==", Value: userId},
+			},
+		})
+	}
 }
 
 type FirestoreImpl struct {
@@ -41,63 +74,106 @@ func NewFirestoreTransferRepository(config *config.Config, firebaseApp *firebase
 	return &FirestoreImpl{config: config, firestore: firestore}, nil
 }
 
+// CreateTransfers stores pending transfers in the top level transfers collection, where they can be
+// queried by both the source and the destination wallet. Transfers are named under their source wallet.
+// The transfers and their transfer.created events are written in a single transaction.
 func (r *FirestoreImpl) CreateTransfers(ctx context.Context, log logger.Logger, transfers *[]*pb.Transfer) (*[]*pb.Transfer, error) {
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		for _, transfer := range *transfers {
+			source := strings.Split(transfer.Source, "/")[1]
+			transferId := nanoid.New()
+
+			transfer.Name = fmt.Sprintf("users/%v/wallet/transfers/%v", source, transferId)
+			transfer.Status = pb.Transfer_STATUS_PENDING
+
+			if transfer.CurrencyCode == "" {
+				transfer.CurrencyCode = money.DefaultCurrencyCode
+			}
+
+			createTime := time.Now()
+
+			doc := map[string]interface{}{
+				"status":        transfer.Status.String(),
+				"source":        source,
+				"destination":   strings.Split(transfer.Destination, "/")[1],
+				"amount":        transfer.Amount,
+				"currency_code": transfer.CurrencyCode,
+				"details": map[string]interface{}{
+					"display_name": transfer.Details.DisplayName,
+					"description":  transfer.Details.Description,
+					"reference":    transfer.Details.Reference,
+				},
+				"create_time": createTime,
+			}
+
+			transfer.CreateTime = timestamppb.New(createTime)
+			transfer.UpdateTime = timestamppb.New(createTime)
+
+			if err := tx.Set(r.firestore.Doc(fmt.Sprintf("transfers/%v", transferId)), doc); err != nil {
+				return err
+			}
+
+			if err := outbox.Add(tx, r.firestore, outbox.TransferCreated(transfer)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 
-	batch := r.firestore.BulkWriter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// UpdateTransfers writes the status of the transfers in a single batch.
+func (r *FirestoreImpl) UpdateTransfers(ctx context.Context, log logger.Logger, transfers *[]*pb.Transfer) (updateTime *time.Time, err error) {
+	batch := r.firestore.Batch()
 
 	for _, transfer := range *transfers {
-		substrings := strings.Split(transfer.Name, "/")
-		userId := substrings[1]
-		transferId := nanoid.New()
-
-		transfer.Name = "users/" + userId + "/wallet/payouts/" + transferId
-
-		createTime := time.Now()
-
-		doc := map[string]interface{}{
-			"status":      pb.Payout_STATUS_PENDING.String(),
-			"source":      strings.Split(transfer.Source, "/")[1],
-			"destination": strings.Split(transfer.Destination, "/")[1],
-			"amount":      transfer.Amount,
-			"details": map[string]interface{}{
-				"display_name": transfer.Details.DisplayName,
-				"description":  transfer.Details.Description,
-				"reference":    transfer.Details.Reference,
-			},
-			"create_time": createTime,
-		}
+		transferId := strings.Split(transfer.Name, "/")[4]
 
-		transfer.CreateTime = timestamppb.New(createTime)
-		transfer.UpdateTime = timestamppb.New(createTime)
+		batch.Update(r.firestore.Doc(fmt.Sprintf("transfers/%v", transferId)), []firestore.Update{
+			{
+				Path:  "status",
+				Value: transfer.Status.String(),
+			},
+		})
+	}
 
-		_, err := batch.Set(r.firestore.Doc(fmt.Sprintf("transfers/%v", transferId)), doc)
+	writeResults, err := batch.Commit(ctx)
 
-		if err != nil {
-			log.Fatalf("Failed to create transfer: %v", err)
-			return nil, err
-		}
+	if err != nil {
+		return nil, err
 	}
 
-	batch.End()
+	for _, transfer := range *transfers {
+		transfer.UpdateTime = timestamppb.New(writeResults[0].UpdateTime)
+	}
 
-	return transfers, nil
+	return &writeResults[0].UpdateTime, nil
 }
 
 // GetTransfer is a method that retrieves a single transfer from the firestore database
-// It takes in a context and a string ID as parameters
-// It returns a pointer to a pb.Transfer struct and an error
+// It returns nil if the transfer does not exist or the wallet is neither its source nor its destination
 func (r *FirestoreImpl) GetTransfer(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Transfer, error) {
-	doc, err := r.firestore.Collection("wallets").Doc(userId).Collection("transfers").Doc(id).Get(ctx)
+	doc, err := r.firestore.Collection("transfers").Doc(id).Get(ctx)
+
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if !doc.Exists() {
+	if doc.Data()["source"] != userId && doc.Data()["destination"] != userId {
 		return nil, nil
 	}
 
-	transfer := docToTransfer(doc)
+	transfer := docToTransfer(doc, userId)
 
 	if transfer == nil {
 		return nil, errors.New("invalid transfer")
@@ -106,45 +182,69 @@ func (r *FirestoreImpl) GetTransfer(ctx context.Context, log logger.Logger, user
 	return transfer, nil
 }
 
-func (r *FirestoreImpl) GetTransfers(ctx context.Context, log logger.Logger, userId string) ([]*pb.Transfer, error) {
-	iter := r.firestore.Collection("wallets").Doc(userId).Collection("transfers").Documents(ctx)
+// GetTransfers is a method that retrieves a page of transfers sent from or received by a wallet, newest first
+// It returns the transfers and the cursor of the next page, or nil if there are no more transfers
+func (r *FirestoreImpl) GetTransfers(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Transfer, *pagination.Cursor, error) {
+	query := filter.apply(r.firestore.Collection("transfers").Query, userId)
 
-	transfers := []*pb.Transfer{}
+	docs, err := page.Query(query, firestore.Desc).Documents(ctx).GetAll()
 
-	for {
-		doc, err := iter.Next()
-		if err == iterator.Done {
-			break
-		}
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if err != nil {
-			return nil, err
-		}
+	docs, next := page.Next(docs)
+
+	transfers := make([]*pb.Transfer, 0, len(docs))
 
-		transfer := docToTransfer(doc)
+	for _, doc := range docs {
+		transfer := docToTransfer(doc, userId)
 
-		if transfer != nil {
-			return nil, errors.New("invalid transfer")
+		if transfer == nil {
+			return nil, nil, errors.New("invalid transfer")
 		}
 
 		transfers = append(transfers, transfer)
 	}
 
-	return transfers, nil
+	return transfers, next, nil
 }
 
 // docToTransfer is a helper function that converts a firestore document to a pb.Transfer object
-func docToTransfer(doc *firestore.DocumentSnapshot) *pb.Transfer {
+// named under the wallet of the given user
+func docToTransfer(doc *firestore.DocumentSnapshot, userId string) *pb.Transfer {
+	data := doc.Data()
 
-	if !(funk.Contains(doc.Data(), "amount") && funk.Contains(doc.Data(), "status")) {
+	amount, ok := money.Amount(data["amount"])
+
+	if !(ok && funk.Contains(data, "status")) {
 		return nil
 	}
 
-	return &pb.Transfer{
-		Name:       "users/" + doc.Ref.Parent.Parent.ID + "/wallet/transfers/" + doc.Ref.ID,
-		Amount:     doc.Data()["amount"].(int32),
-		Status:     *pb.Transfer_Status(pb.Transfer_Status_value["STATUS_"+doc.Data()["status"].(string)]).Enum(),
-		CreateTime: timestamppb.New(doc.CreateTime),
-		UpdateTime: timestamppb.New(doc.UpdateTime),
+	transfer := &pb.Transfer{
+		Name:         "users/" + userId + "/wallet/transfers/" + doc.Ref.ID,
+		Amount:       amount,
+		CurrencyCode: money.CurrencyCode(data["currency_code"]),
+		Status:       pb.Transfer_Status(pb.Transfer_Status_value[data["status"].(string)]),
+		Source:       "users/" + data["source"].(string) + "/wallet",
+		Destination:  "users/" + data["destination"].(string) + "/wallet",
+		CreateTime:   timestamppb.New(doc.CreateTime),
+		UpdateTime:   timestamppb.New(doc.UpdateTime),
+	}
+
+	if createTime, ok := data["create_time"].(time.Time); ok {
+		transfer.CreateTime = timestamppb.New(createTime)
+	}
+
+	if details, ok := data["details"].(map[string]interface{}); ok {
+		transfer.Details = &pb.Transfer_Details{}
+		transfer.Details.DisplayName, _ = details["display_name"].(string)
+		transfer.Details.Reference, _ = details["reference"].(string)
+
+		if description, ok := details["description"].(string); ok {
+			transfer.Details.Description = &description
+		}
 	}
+
+	return transfer
 }
@@ -0,0 +1,50 @@
+package walletrepository
+
+import (
+	"sort"
+
+	"cloud.google.com/go/firestore"
+	"github.com/ride-app/payments-service/internal/money"
+)
+
+// balanceField returns the path of the balance in a currency in a wallet document. Balances in the default currency are
+// kept in the balance field that predates multi-currency wallets, and balances in other currencies in the balances map.
+func balanceField(currencyCode string) string {
+	if currencyCode == "" || currencyCode == money.DefaultCurrencyCode {
+		return "balance"
+	}
+
+	return "balances." + currencyCode
+}
+
+// walletBalances returns the balances of a wallet document by currency. The default currency is always included.
+func walletBalances(doc *firestore.DocumentSnapshot) map[string]int64 {
+	balance, _ := money.Amount(doc.Data()["balance"])
+	balances := map[string]int64{money.DefaultCurrencyCode: balance}
+
+	if others, ok := doc.Data()["balances"].(map[string]interface{}); ok {
+		for currencyCode, value := range others {
+			balances[currencyCode], _ = money.Amount(value)
+		}
+	}
+
+	return balances
+}
+
+// walletCurrency returns the primary currency of a wallet document, which its balance floor applies to.
+func walletCurrency(doc *firestore.DocumentSnapshot) string {
+	return money.CurrencyCode(doc.Data()["currency_code"])
+}
+
+// sortedCurrencies returns the currencies of a map of balances in alphabetical order.
+func sortedCurrencies(balances map[string]int64) []string {
+	currencyCodes := make([]string, 0, len(balances))
+
+	for currencyCode := range balances {
+		currencyCodes = append(currencyCodes, currencyCode)
+	}
+
+	sort.Strings(currencyCodes)
+
+	return currencyCodes
+}
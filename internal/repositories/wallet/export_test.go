@@ -0,0 +1,23 @@
+package walletrepository
+
+import (
+	"cloud.google.com/go/firestore"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+// LedgerTally sums the transactions of a wallet to compare them with its recorded balances.
+type LedgerTally = ledgerTally
+
+var NewLedgerTally = newLedgerTally
+
+func (t *ledgerTally) Add(transactionId string, data map[string]interface{}) {
+	t.add(transactionId, data)
+}
+
+func (t *ledgerTally) Discrepancies() []*pb.LedgerDiscrepancy {
+	return t.discrepancies()
+}
+
+func RepairUpdates(balances map[string]int64, discrepancies []*pb.LedgerDiscrepancy) []firestore.Update {
+	return repairUpdates(balances, discrepancies)
+}
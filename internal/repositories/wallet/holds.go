@@ -0,0 +1,245 @@
+package walletrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/aidarkhanov/nanoid"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrHoldNotActive is returned when capturing or releasing a hold that was already captured, released or has expired.
+var ErrHoldNotActive = errors.New("hold is not active")
+
+// activeHolds queries the holds of a wallet that still reserve funds.
+func (r *FirestoreImpl) activeHolds(userId string) firestore.Query {
+	return r.firestore.Collection("wallets").Doc(userId).Collection("holds").
+		Where("status", "==", pb.Hold_STATUS_ACTIVE.String()).
+		Where("expire_time", ">", time.Now())
+}
+
+// heldAmount sums the amounts of active hold documents in a currency, leaving out the hold with the excluded id.
+func heldAmount(holds []*firestore.DocumentSnapshot, excludedId string, currencyCode string) int64 {
+	var amount int64
+
+	for _, hold := range holds {
+		if hold.Ref.ID != excludedId && money.CurrencyCode(hold.Data()["currency_code"]) == currencyCode {
+			held, _ := money.Amount(hold.Data()["amount"])
+			amount += held
+		}
+	}
+
+	return amount
+}
+
+func (r *FirestoreImpl) holdRef(hold *pb.Hold) *firestore.DocumentRef {
+	substrings := strings.Split(hold.Name, "/")
+
+	return r.firestore.Collection("wallets").Doc(substrings[1]).Collection("holds").Doc(substrings[4])
+}
+
+// CreateHold reserves the amount of a hold, named by the caller, if the available balance of the wallet allows it.
+func (r *FirestoreImpl) CreateHold(ctx context.Context, log logger.Logger, hold *pb.Hold) (*pb.Hold, error) {
+	userId := strings.Split(hold.Name, "/")[1]
+
+	if hold.CurrencyCode == "" {
+		hold.CurrencyCode = money.DefaultCurrencyCode
+	}
+
+	hold.Status = pb.Hold_STATUS_ACTIVE
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		entries := Entries{
+			{
+				UserId: userId,
+				Transaction: &pb.Transaction{
+					Type:         pb.Transaction_TYPE_DEBIT,
+					Amount:       hold.Amount,
+					CurrencyCode: hold.CurrencyCode,
+				},
+			},
+		}
+
+		if err := r.checkWallets(tx, &entries, ""); err != nil {
+			return err
+		}
+
+		createTime := time.Now()
+		hold.CreateTime = timestamppb.New(createTime)
+		hold.UpdateTime = timestamppb.New(createTime)
+
+		return tx.Create(r.holdRef(hold), map[string]interface{}{
+			"amount":        hold.Amount,
+			"currency_code": hold.CurrencyCode,
+			"status":        hold.Status.String(),
+			"reference":     hold.Reference,
+			"expire_time":   hold.ExpireTime.AsTime(),
+			"create_time":   createTime,
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (r *FirestoreImpl) GetHold(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Hold, error) {
+	doc, err := r.firestore.Collection("wallets").Doc(userId).Collection("holds").Doc(id).Get(ctx)
+
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	hold := docToHold(doc)
+
+	if hold == nil {
+		return nil, errors.New("invalid hold")
+	}
+
+	return hold, nil
+}
+
+// CaptureHold creates the transactions of a capture and marks the hold as captured in a single transaction.
+// The entries debiting the wallet of the hold may spend its held funds.
+func (r *FirestoreImpl) CaptureHold(ctx context.Context, log logger.Logger, hold *pb.Hold, entries *Entries) (*pb.Hold, *string, error) {
+	ref := r.holdRef(hold)
+	batchId := nanoid.New()
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := r.checkActive(tx, ref); err != nil {
+			return err
+		}
+
+		if err := r.checkWallets(tx, entries, ref.ID); err != nil {
+			return err
+		}
+
+		var capturedAmount int64
+
+		for _, entry := range *entries {
+			if entry.Transaction.Type == pb.Transaction_TYPE_DEBIT && entry.UserId == ref.Parent.Parent.ID {
+				capturedAmount += entry.Transaction.Amount
+			}
+		}
+
+		if err := r.writeTransactions(tx, batchId, entries); err != nil {
+			return err
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "status", Value: pb.Hold_STATUS_CAPTURED.String()},
+			{Path: "captured_amount", Value: capturedAmount},
+			{Path: "capture_batch_id", Value: batchId},
+		})
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hold, err = r.GetHold(ctx, log, ref.Parent.Parent.ID, ref.ID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hold, &batchId, nil
+}
+
+// ReleaseHold returns the funds of an active hold to the available balance.
+func (r *FirestoreImpl) ReleaseHold(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Hold, error) {
+	ref := r.firestore.Collection("wallets").Doc(userId).Collection("holds").Doc(id)
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := r.checkActive(tx, ref); err != nil {
+			return err
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "status", Value: pb.Hold_STATUS_RELEASED.String()},
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetHold(ctx, log, userId, id)
+}
+
+// checkActive fails with ErrHoldNotActive unless the hold still reserves funds.
+func (r *FirestoreImpl) checkActive(tx *firestore.Transaction, ref *firestore.DocumentRef) error {
+	doc, err := tx.Get(ref)
+
+	if err != nil {
+		return err
+	}
+
+	hold := docToHold(doc)
+
+	if hold == nil {
+		return errors.New("invalid hold")
+	}
+
+	if hold.Status != pb.Hold_STATUS_ACTIVE {
+		return fmt.Errorf("%w: %s", ErrHoldNotActive, hold.Status)
+	}
+
+	return nil
+}
+
+// docToHold is a helper function that converts a Firestore document to a Hold struct.
+// Active holds past their expire time are reported as expired.
+func docToHold(doc *firestore.DocumentSnapshot) *pb.Hold {
+	data := doc.Data()
+
+	amount, ok := money.Amount(data["amount"])
+
+	if !(ok && funk.Contains(data, "status")) {
+		return nil
+	}
+
+	hold := &pb.Hold{
+		Name:       "users/" + doc.Ref.Parent.Parent.ID + "/wallet/holds/" + doc.Ref.ID,
+		Amount:     amount,
+		Status:     pb.Hold_Status(pb.Hold_Status_value[data["status"].(string)]),
+		CreateTime: timestamppb.New(doc.CreateTime),
+		UpdateTime: timestamppb.New(doc.UpdateTime),
+	}
+
+	hold.CurrencyCode = money.CurrencyCode(data["currency_code"])
+	hold.Reference, _ = data["reference"].(string)
+	hold.CaptureBatchId, _ = data["capture_batch_id"].(string)
+
+	hold.CapturedAmount, _ = money.Amount(data["captured_amount"])
+
+	if expireTime, ok := data["expire_time"].(time.Time); ok {
+		hold.ExpireTime = timestamppb.New(expireTime)
+
+		if hold.Status == pb.Hold_STATUS_ACTIVE && !expireTime.After(time.Now()) {
+			hold.Status = pb.Hold_STATUS_EXPIRED
+		}
+	}
+
+	if createTime, ok := data["create_time"].(time.Time); ok {
+		hold.CreateTime = timestamppb.New(createTime)
+	}
+
+	return hold
+}
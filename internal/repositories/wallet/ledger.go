@@ -0,0 +1,278 @@
+package walletrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/ride-app/payments-service/internal/pagination"
+)
+
+// ErrInvalidTransaction is returned when reconciling a transaction document that is not a valid legacy transaction.
+var ErrInvalidTransaction = errors.New("invalid transaction")
+
+// GetWalletIds returns a page of wallet ids ordered by id.
+func (r *FirestoreImpl) GetWalletIds(ctx context.Context, log logger.Logger, page *pagination.Page) ([]string, *pagination.Cursor, error) {
+	query := r.firestore.Collection("wallets").OrderBy(firestore.DocumentID, firestore.Asc)
+
+	if page.After != nil {
+		query = query.StartAfter(page.After.Id)
+	}
+
+	docs, err := query.Limit(page.Size + 1).Documents(ctx).GetAll()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docs, next := page.Next(docs)
+
+	ids := make([]string, 0, len(docs))
+
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+
+	return ids, next, nil
+}
+
+// verifyPageSize is the number of transactions read at a time when verifying a wallet.
+const verifyPageSize = 500
+
+// VerifyWallet recomputes the balances of a wallet from its transactions and returns a discrepancy for every currency
+// whose balance does not match or whose transactions record inconsistent running balances. The transactions are read
+// in pages at the time the wallet was read, so that concurrent batches are not reported as drift and large wallets do
+// not exceed the limits of a transaction. If repair is set, the differences are subtracted from the current balances
+// in a transaction that only reads the wallet. Running balances of transactions are not repaired.
+func (r *FirestoreImpl) VerifyWallet(ctx context.Context, log logger.Logger, userId string, repair bool) ([]*pb.LedgerDiscrepancy, error) {
+	ref := r.firestore.Collection("wallets").Doc(userId)
+	doc, err := ref.Get(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	discrepancies, err := r.computeDiscrepancies(ctx, doc, userId)
+
+	if err != nil || len(discrepancies) == 0 || !repair {
+		return discrepancies, err
+	}
+
+	log.Warnf("Repairing balances of wallet %s", userId)
+
+	err = r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil {
+			return err
+		}
+
+		updates := repairUpdates(walletBalances(doc), discrepancies)
+
+		if len(updates) == 0 {
+			return nil
+		}
+
+		return tx.Update(ref, updates)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, discrepancy := range discrepancies {
+		discrepancy.Repaired = discrepancy.Difference != 0
+	}
+
+	return discrepancies, nil
+}
+
+// repairUpdates returns the updates that subtract the differences of discrepancies from the current balances of a
+// wallet. Batches written since the scan changed the recorded and the computed balances alike, so the differences
+// still hold for the current balances.
+func repairUpdates(balances map[string]int64, discrepancies []*pb.LedgerDiscrepancy) []firestore.Update {
+	var updates []firestore.Update
+
+	for _, discrepancy := range discrepancies {
+		if discrepancy.Difference != 0 {
+			updates = append(updates, firestore.Update{Path: balanceField(discrepancy.CurrencyCode), Value: balances[discrepancy.CurrencyCode] - discrepancy.Difference})
+		}
+	}
+
+	return updates
+}
+
+// computeDiscrepancies pages through the transactions of a wallet at the time its document was read, including legacy
+// transactions that store the wallet id as walletId, and tallies them against the recorded balances.
+func (r *FirestoreImpl) computeDiscrepancies(ctx context.Context, doc *firestore.DocumentSnapshot, userId string) ([]*pb.LedgerDiscrepancy, error) {
+	tally := newLedgerTally(userId, walletBalances(doc))
+
+	for _, field := range []string{"wallet_id", "walletId"} {
+		query := r.firestore.Collection("transactions").Where(field, "==", userId).OrderBy(firestore.DocumentID, firestore.Asc).Limit(verifyPageSize)
+
+		for {
+			transactions, err := query.WithReadOptions(firestore.ReadTime(doc.ReadTime)).Documents(ctx).GetAll()
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, transaction := range transactions {
+				// Transactions written with both fields are counted once, under wallet_id.
+				if _, ok := transaction.Data()["wallet_id"]; ok && field == "walletId" {
+					continue
+				}
+
+				tally.add(transaction.Ref.ID, transaction.Data())
+			}
+
+			if len(transactions) < verifyPageSize {
+				break
+			}
+
+			query = query.StartAfter(transactions[len(transactions)-1])
+		}
+	}
+
+	return tally.discrepancies(), nil
+}
+
+// ledgerTally sums the transactions of a wallet in every currency to compare them with its recorded balances.
+type ledgerTally struct {
+	userId   string
+	computed map[string]*pb.LedgerDiscrepancy
+}
+
+func newLedgerTally(userId string, recorded map[string]int64) *ledgerTally {
+	computed := map[string]*pb.LedgerDiscrepancy{}
+
+	for currencyCode, balance := range recorded {
+		computed[currencyCode] = &pb.LedgerDiscrepancy{RecordedBalance: balance}
+	}
+
+	return &ledgerTally{userId: userId, computed: computed}
+}
+
+// add counts a transaction document of the wallet. Transactions that record running balances are checked on their own
+// as well, since their balance after must equal their balance before plus their amount.
+func (t *ledgerTally) add(transactionId string, data map[string]interface{}) {
+	currencyCode := money.CurrencyCode(data["currency_code"])
+
+	if t.computed[currencyCode] == nil {
+		t.computed[currencyCode] = &pb.LedgerDiscrepancy{}
+	}
+
+	discrepancy := t.computed[currencyCode]
+
+	if _, ok := data["wallet_id"]; !ok {
+		discrepancy.LegacyTransactionCount++
+	}
+
+	amount, _ := money.Amount(data["amount"])
+
+	if isDebit(data) {
+		amount = -amount
+	}
+
+	discrepancy.ComputedBalance += amount
+	discrepancy.TransactionCount++
+
+	balanceBefore, hasBefore := money.Amount(data["balance_before"])
+	balanceAfter, hasAfter := money.Amount(data["balance_after"])
+
+	if hasBefore && hasAfter && balanceAfter != balanceBefore+amount {
+		discrepancy.InconsistentTransactions = append(discrepancy.InconsistentTransactions, "users/"+t.userId+"/wallet/transactions/"+transactionId)
+	}
+}
+
+// discrepancies returns a discrepancy for every currency whose recorded balance does not match the sum of its
+// transactions or whose transactions record inconsistent running balances.
+func (t *ledgerTally) discrepancies() []*pb.LedgerDiscrepancy {
+	var discrepancies []*pb.LedgerDiscrepancy
+
+	for currencyCode, discrepancy := range t.computed {
+		discrepancy.Wallet = "users/" + t.userId + "/wallet"
+		discrepancy.CurrencyCode = currencyCode
+		discrepancy.Difference = discrepancy.RecordedBalance - discrepancy.ComputedBalance
+
+		if discrepancy.Difference != 0 || len(discrepancy.InconsistentTransactions) > 0 {
+			discrepancies = append(discrepancies, discrepancy)
+		}
+	}
+
+	return discrepancies
+}
+
+// ReconcileTransaction applies a transaction written by a legacy client, which stores the wallet id as walletId and
+// leaves the balance to be updated afterwards. Transactions that store wallet_id were applied when they were written
+// and are skipped. The transaction is marked as reconciled so that redelivered events do not apply it twice, and records
+// the balance of its wallet before and after it.
+func (r *FirestoreImpl) ReconcileTransaction(ctx context.Context, log logger.Logger, transactionId string) error {
+	ref := r.firestore.Collection("transactions").Doc(transactionId)
+
+	return r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if err != nil {
+			return err
+		}
+
+		data := doc.Data()
+
+		if _, ok := data["wallet_id"]; ok {
+			log.Debug("Transaction was applied when it was written")
+			return nil
+		}
+
+		if reconciled, _ := data["reconciled"].(bool); reconciled {
+			log.Info("Transaction is already reconciled")
+			return nil
+		}
+
+		walletId, ok := data["walletId"].(string)
+
+		if !ok {
+			return fmt.Errorf("%w: missing wallet id", ErrInvalidTransaction)
+		}
+
+		amount, ok := money.Amount(data["amount"])
+
+		if !ok {
+			return fmt.Errorf("%w: missing amount", ErrInvalidTransaction)
+		}
+
+		switch data["type"] {
+		case "CREDIT", pb.Transaction_TYPE_CREDIT.String():
+		case "DEBIT", pb.Transaction_TYPE_DEBIT.String():
+			amount = -amount
+		default:
+			return fmt.Errorf("%w: unknown type %v", ErrInvalidTransaction, data["type"])
+		}
+
+		walletRef := r.firestore.Collection("wallets").Doc(walletId)
+		wallet, err := tx.Get(walletRef)
+
+		if err != nil {
+			return err
+		}
+
+		currencyCode := money.CurrencyCode(data["currency_code"])
+		balanceBefore := walletBalances(wallet)[currencyCode]
+
+		if err := tx.Update(walletRef, []firestore.Update{
+			{Path: balanceField(currencyCode), Value: firestore.Increment(amount)},
+		}); err != nil {
+			return err
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "reconciled", Value: true},
+			{Path: "balance_before", Value: balanceBefore},
+			{Path: "balance_after", Value: balanceBefore + amount},
+		})
+	})
+}
@@ -0,0 +1,104 @@
+package walletrepository_test
+
+import (
+	"cloud.google.com/go/firestore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
+)
+
+var _ = Describe("Ledger verification", func() {
+	Describe("LedgerTally", func() {
+		It("reports no discrepancies when the balances match the transactions", func() {
+			tally := walletrepository.NewLedgerTally("user1", map[string]int64{"INR": 300})
+
+			tally.Add("transaction1", map[string]interface{}{"wallet_id": "user1", "type": "TYPE_CREDIT", "amount": int64(500), "currency_code": "INR"})
+			tally.Add("transaction2", map[string]interface{}{"wallet_id": "user1", "type": "TYPE_DEBIT", "amount": int64(200), "currency_code": "INR"})
+
+			Expect(tally.Discrepancies()).To(BeEmpty())
+		})
+
+		It("reports the difference between the recorded and the computed balance", func() {
+			tally := walletrepository.NewLedgerTally("user1", map[string]int64{"INR": 800})
+
+			tally.Add("transaction1", map[string]interface{}{"wallet_id": "user1", "type": "TYPE_CREDIT", "amount": int64(500), "currency_code": "INR"})
+
+			discrepancies := tally.Discrepancies()
+
+			Expect(discrepancies).To(HaveLen(1))
+			Expect(discrepancies[0].Wallet).To(Equal("users/user1/wallet"))
+			Expect(discrepancies[0].CurrencyCode).To(Equal("INR"))
+			Expect(discrepancies[0].RecordedBalance).To(Equal(int64(800)))
+			Expect(discrepancies[0].ComputedBalance).To(Equal(int64(500)))
+			Expect(discrepancies[0].Difference).To(Equal(int64(300)))
+			Expect(discrepancies[0].TransactionCount).To(Equal(int32(1)))
+		})
+
+		It("counts legacy transactions, whose debits have no type prefix", func() {
+			tally := walletrepository.NewLedgerTally("user1", map[string]int64{"INR": 0})
+
+			tally.Add("transaction1", map[string]interface{}{"walletId": "user1", "type": "CREDIT", "amount": int64(500)})
+			tally.Add("transaction2", map[string]interface{}{"walletId": "user1", "type": "DEBIT", "amount": int64(200)})
+
+			discrepancies := tally.Discrepancies()
+
+			Expect(discrepancies).To(HaveLen(1))
+			Expect(discrepancies[0].ComputedBalance).To(Equal(int64(300)))
+			Expect(discrepancies[0].LegacyTransactionCount).To(Equal(int32(2)))
+		})
+
+		It("reports transactions in currencies the wallet has no balance in", func() {
+			tally := walletrepository.NewLedgerTally("user1", map[string]int64{"INR": 0})
+
+			tally.Add("transaction1", map[string]interface{}{"wallet_id": "user1", "type": "TYPE_CREDIT", "amount": int64(100), "currency_code": "USD"})
+
+			discrepancies := tally.Discrepancies()
+
+			Expect(discrepancies).To(HaveLen(1))
+			Expect(discrepancies[0].CurrencyCode).To(Equal("USD"))
+			Expect(discrepancies[0].Difference).To(Equal(int64(-100)))
+		})
+
+		It("reports transactions whose running balances are inconsistent", func() {
+			tally := walletrepository.NewLedgerTally("user1", map[string]int64{"INR": 500})
+
+			tally.Add("transaction1", map[string]interface{}{
+				"wallet_id":      "user1",
+				"type":           "TYPE_CREDIT",
+				"amount":         int64(500),
+				"currency_code":  "INR",
+				"balance_before": int64(0),
+				"balance_after":  int64(600),
+			})
+
+			discrepancies := tally.Discrepancies()
+
+			Expect(discrepancies).To(HaveLen(1))
+			Expect(discrepancies[0].Difference).To(BeZero())
+			Expect(discrepancies[0].InconsistentTransactions).To(Equal([]string{"users/user1/wallet/transactions/transaction1"}))
+		})
+	})
+
+	Describe("RepairUpdates", func() {
+		It("subtracts the differences from the current balances", func() {
+			updates := walletrepository.RepairUpdates(map[string]int64{"INR": 900, "USD": 50}, []*pb.LedgerDiscrepancy{
+				{CurrencyCode: "INR", Difference: 300},
+				{CurrencyCode: "USD", Difference: -20},
+			})
+
+			Expect(updates).To(Equal([]firestore.Update{
+				{Path: "balance", Value: int64(600)},
+				{Path: "balances.USD", Value: int64(70)},
+			}))
+		})
+
+		It("does not touch balances of discrepancies that only have inconsistent transactions", func() {
+			updates := walletrepository.RepairUpdates(map[string]int64{"INR": 900}, []*pb.LedgerDiscrepancy{
+				{CurrencyCode: "INR", InconsistentTransactions: []string{"users/user1/wallet/transactions/transaction1"}},
+			})
+
+			Expect(updates).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package walletrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrWalletNotFound is returned when changing the state of a wallet that does not exist.
+	ErrWalletNotFound = errors.New("wallet not found")
+
+	// ErrInvalidStateTransition is returned when a wallet can not move from its state to the requested one,
+	// for example when reopening a closed wallet.
+	ErrInvalidStateTransition = errors.New("invalid wallet state transition")
+
+	// ErrWalletNotEmpty is returned when closing a wallet that has a balance or active holds.
+	ErrWalletNotEmpty = errors.New("wallet balance must be zero to close it")
+)
+
+// walletState returns the state of a wallet document. Wallets created before states were introduced are active.
+func walletState(doc *firestore.DocumentSnapshot) pb.Wallet_State {
+	if state, ok := doc.Data()["state"].(string); ok {
+		return pb.Wallet_State(pb.Wallet_State_value[state])
+	}
+
+	return pb.Wallet_STATE_ACTIVE
+}
+
+// SetWalletState moves a wallet in one of the from states to a state with the reason for the change. Closed wallets
+// can not change state, and only wallets with a zero balance in every currency and no active holds can be closed.
+func (r *FirestoreImpl) SetWalletState(ctx context.Context, log logger.Logger, userId string, state pb.Wallet_State, from []pb.Wallet_State, reason string) (*pb.Wallet, error) {
+	ref := r.firestore.Collection("wallets").Doc(userId)
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if status.Code(err) == codes.NotFound {
+			return ErrWalletNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		current := walletState(doc)
+
+		if current == pb.Wallet_STATE_CLOSED || !slices.Contains(from, current) {
+			return fmt.Errorf("%w: wallet %v is %v", ErrInvalidStateTransition, userId, current)
+		}
+
+		if state == pb.Wallet_STATE_CLOSED {
+			holds, err := tx.Documents(r.activeHolds(userId)).GetAll()
+
+			if err != nil {
+				return err
+			}
+
+			if len(holds) > 0 {
+				return fmt.Errorf("%w: wallet %v has %d active holds", ErrWalletNotEmpty, userId, len(holds))
+			}
+
+			for currencyCode, balance := range walletBalances(doc) {
+				if balance != 0 {
+					return fmt.Errorf("%w: wallet %v has a balance of %d %v", ErrWalletNotEmpty, userId, balance, currencyCode)
+				}
+			}
+		}
+
+		log.Infof("Moving wallet from %s to %s", current, state)
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "state", Value: state.String()},
+			{Path: "state_reason", Value: reason},
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetWallet(ctx, log, userId)
+}
+
+// SetBalanceFloor sets the balance floor of a wallet, or removes it so that the default floor applies if balanceFloor
+// is nil. Closed wallets can not be changed.
+func (r *FirestoreImpl) SetBalanceFloor(ctx context.Context, log logger.Logger, userId string, balanceFloor *int64) (*pb.Wallet, error) {
+	ref := r.firestore.Collection("wallets").Doc(userId)
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if status.Code(err) == codes.NotFound {
+			return ErrWalletNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if walletState(doc) == pb.Wallet_STATE_CLOSED {
+			return fmt.Errorf("%w: wallet %v is closed", ErrInvalidStateTransition, userId)
+		}
+
+		var value interface{} = firestore.Delete
+
+		if balanceFloor != nil {
+			log.Infof("Setting balance floor to %d", *balanceFloor)
+			value = *balanceFloor
+		} else {
+			log.Info("Removing balance floor")
+		}
+
+		return tx.Update(ref, []firestore.Update{{Path: "balance_floor", Value: value}})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetWallet(ctx, log, userId)
+}
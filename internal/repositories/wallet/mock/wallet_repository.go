@@ -11,6 +11,7 @@ import (
 	logger "github.com/dragonfish/go/v2/pkg/logger"
 	gomock "github.com/golang/mock/gomock"
 	paymentsv1alpha1 "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	pagination "github.com/ride-app/payments-service/internal/pagination"
 	walletrepository "github.com/ride-app/payments-service/internal/repositories/wallet"
 )
 
@@ -37,6 +38,37 @@ func (m *MockWalletRepository) EXPECT() *MockWalletRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CaptureHold mocks base method.
+func (m *MockWalletRepository) CaptureHold(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Hold, arg3 *[]*walletrepository.Entry) (*paymentsv1alpha1.Hold, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Hold)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockWalletRepositoryMockRecorder) CaptureHold(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockWalletRepository)(nil).CaptureHold), arg0, arg1, arg2, arg3)
+}
+
+// CreateHold mocks base method.
+func (m *MockWalletRepository) CreateHold(arg0 context.Context, arg1 logger.Logger, arg2 *paymentsv1alpha1.Hold) (*paymentsv1alpha1.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockWalletRepositoryMockRecorder) CreateHold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockWalletRepository)(nil).CreateHold), arg0, arg1, arg2)
+}
+
 // CreateTransactions mocks base method.
 func (m *MockWalletRepository) CreateTransactions(arg0 context.Context, arg1 logger.Logger, arg2 *[]*walletrepository.Entry) (*string, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +84,36 @@ func (mr *MockWalletRepositoryMockRecorder) CreateTransactions(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactions", reflect.TypeOf((*MockWalletRepository)(nil).CreateTransactions), arg0, arg1, arg2)
 }
 
+// CreateWallet mocks base method.
+func (m *MockWalletRepository) CreateWallet(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string) (*paymentsv1alpha1.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWallet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWallet indicates an expected call of CreateWallet.
+func (mr *MockWalletRepositoryMockRecorder) CreateWallet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWallet", reflect.TypeOf((*MockWalletRepository)(nil).CreateWallet), arg0, arg1, arg2, arg3)
+}
+
+// GetHold mocks base method.
+func (m *MockWalletRepository) GetHold(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string) (*paymentsv1alpha1.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockWalletRepositoryMockRecorder) GetHold(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockWalletRepository)(nil).GetHold), arg0, arg1, arg2, arg3)
+}
+
 // GetTransaction mocks base method.
 func (m *MockWalletRepository) GetTransaction(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string) (*paymentsv1alpha1.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -68,18 +130,19 @@ func (mr *MockWalletRepositoryMockRecorder) GetTransaction(arg0, arg1, arg2, arg
 }
 
 // GetTransactions mocks base method.
-func (m *MockWalletRepository) GetTransactions(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *string) ([]*paymentsv1alpha1.Transaction, error) {
+func (m *MockWalletRepository) GetTransactions(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *walletrepository.Filter, arg4 *pagination.Page) ([]*paymentsv1alpha1.Transaction, *pagination.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactions", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetTransactions", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].([]*paymentsv1alpha1.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetTransactions indicates an expected call of GetTransactions.
-func (mr *MockWalletRepositoryMockRecorder) GetTransactions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockWalletRepositoryMockRecorder) GetTransactions(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockWalletRepository)(nil).GetTransactions), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockWalletRepository)(nil).GetTransactions), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetWallet mocks base method.
@@ -96,3 +159,139 @@ func (mr *MockWalletRepositoryMockRecorder) GetWallet(arg0, arg1, arg2 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockWalletRepository)(nil).GetWallet), arg0, arg1, arg2)
 }
+
+// GetWalletIds mocks base method.
+func (m *MockWalletRepository) GetWalletIds(arg0 context.Context, arg1 logger.Logger, arg2 *pagination.Page) ([]string, *pagination.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletIds", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(*pagination.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWalletIds indicates an expected call of GetWalletIds.
+func (mr *MockWalletRepositoryMockRecorder) GetWalletIds(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletIds", reflect.TypeOf((*MockWalletRepository)(nil).GetWalletIds), arg0, arg1, arg2)
+}
+
+// ReconcileTransaction mocks base method.
+func (m *MockWalletRepository) ReconcileTransaction(arg0 context.Context, arg1 logger.Logger, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileTransaction indicates an expected call of ReconcileTransaction.
+func (mr *MockWalletRepositoryMockRecorder) ReconcileTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTransaction", reflect.TypeOf((*MockWalletRepository)(nil).ReconcileTransaction), arg0, arg1, arg2)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockWalletRepository) ReleaseHold(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string) (*paymentsv1alpha1.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockWalletRepositoryMockRecorder) ReleaseHold(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockWalletRepository)(nil).ReleaseHold), arg0, arg1, arg2, arg3)
+}
+
+// ReverseBatch mocks base method.
+func (m *MockWalletRepository) ReverseBatch(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string) ([]*paymentsv1alpha1.Transaction, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseBatch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*paymentsv1alpha1.Transaction)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReverseBatch indicates an expected call of ReverseBatch.
+func (mr *MockWalletRepositoryMockRecorder) ReverseBatch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseBatch", reflect.TypeOf((*MockWalletRepository)(nil).ReverseBatch), arg0, arg1, arg2, arg3)
+}
+
+// ReverseTransaction mocks base method.
+func (m *MockWalletRepository) ReverseTransaction(arg0 context.Context, arg1 logger.Logger, arg2, arg3 string, arg4 int64, arg5 string) (*paymentsv1alpha1.Transaction, *string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransaction", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Transaction)
+	ret1, _ := ret[1].(*string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReverseTransaction indicates an expected call of ReverseTransaction.
+func (mr *MockWalletRepositoryMockRecorder) ReverseTransaction(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransaction", reflect.TypeOf((*MockWalletRepository)(nil).ReverseTransaction), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetBalanceFloor mocks base method.
+func (m *MockWalletRepository) SetBalanceFloor(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *int64) (*paymentsv1alpha1.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBalanceFloor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBalanceFloor indicates an expected call of SetBalanceFloor.
+func (mr *MockWalletRepositoryMockRecorder) SetBalanceFloor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceFloor", reflect.TypeOf((*MockWalletRepository)(nil).SetBalanceFloor), arg0, arg1, arg2, arg3)
+}
+
+// SetWalletState mocks base method.
+func (m *MockWalletRepository) SetWalletState(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 paymentsv1alpha1.Wallet_State, arg4 []paymentsv1alpha1.Wallet_State, arg5 string) (*paymentsv1alpha1.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletState", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*paymentsv1alpha1.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletState indicates an expected call of SetWalletState.
+func (mr *MockWalletRepositoryMockRecorder) SetWalletState(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletState", reflect.TypeOf((*MockWalletRepository)(nil).SetWalletState), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// VerifyWallet mocks base method.
+func (m *MockWalletRepository) VerifyWallet(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 bool) ([]*paymentsv1alpha1.LedgerDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyWallet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*paymentsv1alpha1.LedgerDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyWallet indicates an expected call of VerifyWallet.
+func (mr *MockWalletRepositoryMockRecorder) VerifyWallet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyWallet", reflect.TypeOf((*MockWalletRepository)(nil).VerifyWallet), arg0, arg1, arg2, arg3)
+}
+
+// WatchWallet mocks base method.
+func (m *MockWalletRepository) WatchWallet(arg0 context.Context, arg1 logger.Logger, arg2 string, arg3 *pagination.Cursor, arg4 chan<- *walletrepository.WalletEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchWallet", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchWallet indicates an expected call of WatchWallet.
+func (mr *MockWalletRepositoryMockRecorder) WatchWallet(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchWallet", reflect.TypeOf((*MockWalletRepository)(nil).WatchWallet), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,200 @@
+package walletrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/aidarkhanov/nanoid"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrTransactionNotFound is returned when reversing a transaction or batch that does not exist.
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrAlreadyReversed is returned when reversing a transaction that was fully reversed already or is itself a reversal.
+	ErrAlreadyReversed = errors.New("transaction is already reversed")
+
+	// ErrReversalExceedsAmount is returned when a partial reversal would reverse more than the original amount.
+	ErrReversalExceedsAmount = errors.New("reversal exceeds the unreversed amount of the transaction")
+)
+
+// ReverseTransaction posts a mirror-image transaction for the amount of a transaction, or the part of it not reversed yet if amount is 0.
+// The reversal and the reversed amount of the original are written in a single transaction.
+// Reversals fail with ErrWalletNotActive like other transactions when the state of the wallet does not allow them.
+func (r *FirestoreImpl) ReverseTransaction(ctx context.Context, log logger.Logger, userId string, transactionId string, amount int64, reason string) (*pb.Transaction, *string, error) {
+	ref := r.firestore.Collection("transactions").Doc(transactionId)
+	batchId := nanoid.New()
+	var entries Entries
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+
+		if status.Code(err) == codes.NotFound {
+			return ErrTransactionNotFound
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if transactionWalletId(doc.Data()) != userId {
+			return ErrTransactionNotFound
+		}
+
+		entry, err := reversalEntry(doc, amount, reason)
+
+		if err != nil {
+			return err
+		}
+
+		entries = Entries{entry}
+
+		// Reversals are corrections and are not held to the balance floor of the wallet, only to its state.
+		if err := r.checkWalletStates(tx, &entries); err != nil {
+			return err
+		}
+
+		if err := r.writeTransactions(tx, batchId, &entries); err != nil {
+			return err
+		}
+
+		return tx.Update(ref, []firestore.Update{
+			{Path: "reversed_amount", Value: firestore.Increment(entry.Transaction.Amount)},
+		})
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries[0].Transaction, &batchId, nil
+}
+
+// ReverseBatch posts mirror-image transactions for the unreversed amounts of all transactions of a batch in a single transaction.
+// Transactions of the batch that were fully reversed already are skipped.
+func (r *FirestoreImpl) ReverseBatch(ctx context.Context, log logger.Logger, batchId string, reason string) ([]*pb.Transaction, *string, error) {
+	reversalBatchId := nanoid.New()
+	var entries Entries
+
+	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docs, err := tx.Documents(r.firestore.Collection("transactions").Where("batch_id", "==", batchId)).GetAll()
+
+		if err != nil {
+			return err
+		}
+
+		if len(docs) == 0 {
+			return ErrTransactionNotFound
+		}
+
+		entries = make(Entries, 0, len(docs))
+		reversed := make([]*firestore.DocumentRef, 0, len(docs))
+
+		for _, doc := range docs {
+			entry, err := reversalEntry(doc, 0, reason)
+
+			if errors.Is(err, ErrAlreadyReversed) && doc.Data()["reversal_of"] == nil {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, entry)
+			reversed = append(reversed, doc.Ref)
+		}
+
+		if len(entries) == 0 {
+			return fmt.Errorf("%w: batch %v", ErrAlreadyReversed, batchId)
+		}
+
+		if err := r.checkWalletStates(tx, &entries); err != nil {
+			return err
+		}
+
+		if err := r.writeTransactions(tx, reversalBatchId, &entries); err != nil {
+			return err
+		}
+
+		for i, ref := range reversed {
+			if err := tx.Update(ref, []firestore.Update{
+				{Path: "reversed_amount", Value: firestore.Increment(entries[i].Transaction.Amount)},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transactions := make([]*pb.Transaction, 0, len(entries))
+
+	for _, entry := range entries {
+		transactions = append(transactions, entry.Transaction)
+	}
+
+	return transactions, &reversalBatchId, nil
+}
+
+// reversalEntry builds the entry reversing amount of a transaction document, or all of its unreversed amount if amount is 0.
+// Legacy transaction documents, which store the wallet id as walletId and the type without the TYPE_ prefix, are reversed too.
+func reversalEntry(doc *firestore.DocumentSnapshot, amount int64, reason string) (*Entry, error) {
+	data := doc.Data()
+	walletId := transactionWalletId(data)
+	name := "users/" + walletId + "/wallet/transactions/" + doc.Ref.ID
+
+	if data["reversal_of"] != nil {
+		return nil, fmt.Errorf("%w: %v is a reversal", ErrAlreadyReversed, name)
+	}
+
+	original, _ := money.Amount(data["amount"])
+	reversed, _ := money.Amount(data["reversed_amount"])
+	remaining := original - reversed
+
+	if remaining <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrAlreadyReversed, name)
+	}
+
+	if amount == 0 {
+		amount = remaining
+	}
+
+	if amount > remaining {
+		return nil, fmt.Errorf("%w: %v has %d left to reverse", ErrReversalExceedsAmount, name, remaining)
+	}
+
+	transactionType := pb.Transaction_TYPE_DEBIT
+
+	if isDebit(data) {
+		transactionType = pb.Transaction_TYPE_CREDIT
+	}
+
+	currencyCode := money.CurrencyCode(data["currency_code"])
+
+	return &Entry{
+		UserId: walletId,
+		Transaction: &pb.Transaction{
+			Type:         transactionType,
+			Amount:       amount,
+			CurrencyCode: currencyCode,
+			ReversalOf:   &name,
+			Details: &pb.Transaction_Details{
+				DisplayName: "Reversal",
+				Description: &reason,
+				Reference:   name,
+			},
+		},
+	}, nil
+}
@@ -4,7 +4,9 @@ package walletrepository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/firestore"
@@ -13,26 +15,111 @@ import (
 	"github.com/dragonfish/go/v2/pkg/logger"
 	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
 	"github.com/ride-app/payments-service/config"
-	"google.golang.org/api/iterator"
+	"github.com/ride-app/payments-service/internal/money"
+	"github.com/ride-app/payments-service/internal/outbox"
+	"github.com/ride-app/payments-service/internal/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var (
+	// ErrInsufficientFunds is returned when a batch of transactions would take a wallet below its balance floor.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	// ErrWalletNotActive is returned when a batch of transactions moves money the state of a wallet does not allow,
+	// for example a debit from a frozen wallet.
+	ErrWalletNotActive = errors.New("wallet is not active")
+
+	// ErrTransactionExists is returned when an entry names a transaction that was already created.
+	ErrTransactionExists = errors.New("transaction already exists")
+)
+
 // Entries is a map where the key is the wallet ID against which the transaction is made.
 type Entries = []*Entry
 
 type Entry struct {
 	UserId      string
 	Transaction *pb.Transaction
+
+	// TransactionId names the transaction instead of a random id. Batches applying an external event, like a captured
+	// payment, derive it from the event so that a redelivered event fails with ErrTransactionExists instead of moving
+	// the money twice.
+	TransactionId string
+
+	// Settles marks a credit of money that has already moved outside of the ledger, like a captured payment or a
+	// payout returned by the gateway. Wallets that are only settled into are credited whatever their state, since
+	// rejecting the credit would leave the ledger out of step with the gateway.
+	Settles bool
+}
+
+// Condition compares a field of a transaction document with a value, for example create_time >= a time.
+type Condition struct {
+	Path     string
+	Operator string
+	Value    interface{}
+}
+
+// Filter narrows down and orders the transactions returned by GetTransactions.
+// A nil filter returns all transactions of the wallet, newest first.
+type Filter struct {
+	Conditions []Condition
+
+	// Ascending lists the oldest transactions first.
+	Ascending bool
+}
+
+// apply adds the conditions of the filter to a query and returns the direction to order it in.
+func (f *Filter) apply(query firestore.Query) (firestore.Query, firestore.Direction) {
+	if f == nil {
+		return query, firestore.Desc
+	}
+
+	for _, condition := range f.Conditions {
+		query = query.Where(condition.Path, condition.Operator, condition.Value)
+	}
+
+	if f.Ascending {
+		return query, firestore.Asc
+	}
+
+	return query, firestore.Desc
 }
 
 type WalletRepository interface {
 	GetWallet(ctx context.Context, log logger.Logger, userId string) (*pb.Wallet, error)
 
+	CreateWallet(ctx context.Context, log logger.Logger, userId string, currencyCode string) (*pb.Wallet, error)
+
+	SetWalletState(ctx context.Context, log logger.Logger, userId string, state pb.Wallet_State, from []pb.Wallet_State, reason string) (*pb.Wallet, error)
+
+	SetBalanceFloor(ctx context.Context, log logger.Logger, userId string, balanceFloor *int64) (*pb.Wallet, error)
+
 	CreateTransactions(ctx context.Context, log logger.Logger, entries *Entries) (*string, error)
 
 	GetTransaction(ctx context.Context, log logger.Logger, userId string, transactionId string) (*pb.Transaction, error)
 
-	GetTransactions(ctx context.Context, log logger.Logger, userId string, batchId *string) ([]*pb.Transaction, error)
+	GetTransactions(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Transaction, *pagination.Cursor, error)
+
+	CreateHold(ctx context.Context, log logger.Logger, hold *pb.Hold) (*pb.Hold, error)
+
+	GetHold(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Hold, error)
+
+	CaptureHold(ctx context.Context, log logger.Logger, hold *pb.Hold, entries *Entries) (*pb.Hold, *string, error)
+
+	ReleaseHold(ctx context.Context, log logger.Logger, userId string, id string) (*pb.Hold, error)
+
+	ReverseTransaction(ctx context.Context, log logger.Logger, userId string, transactionId string, amount int64, reason string) (*pb.Transaction, *string, error)
+
+	ReverseBatch(ctx context.Context, log logger.Logger, batchId string, reason string) ([]*pb.Transaction, *string, error)
+
+	GetWalletIds(ctx context.Context, log logger.Logger, page *pagination.Page) ([]string, *pagination.Cursor, error)
+
+	VerifyWallet(ctx context.Context, log logger.Logger, userId string, repair bool) ([]*pb.LedgerDiscrepancy, error)
+
+	ReconcileTransaction(ctx context.Context, log logger.Logger, transactionId string) error
+
+	WatchWallet(ctx context.Context, log logger.Logger, userId string, after *pagination.Cursor, events chan<- *WalletEvent) error
 }
 
 type FirestoreImpl struct {
@@ -62,76 +149,326 @@ func (r *FirestoreImpl) GetWallet(ctx context.Context, log logger.Logger, userId
 		return nil, nil
 	}
 
+	holds, err := r.activeHolds(userId).Documents(ctx).GetAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	currencyCode := walletCurrency(doc)
+	balances := walletBalances(doc)
+
 	wallet := pb.Wallet{
-		Name:       "users/" + userId + "/wallet",
-		Balance:    int64(doc.Data()["balance"].(int64)),
-		CreateTime: timestamppb.New(doc.CreateTime),
-		UpdateTime: timestamppb.New(doc.UpdateTime),
+		Name:             "users/" + userId + "/wallet",
+		Balance:          balances[currencyCode],
+		BalanceFloor:     r.balanceFloor(doc),
+		AvailableBalance: balances[currencyCode] - heldAmount(holds, "", currencyCode),
+		CurrencyCode:     currencyCode,
+		State:            walletState(doc),
+		CreateTime:       timestamppb.New(doc.CreateTime),
+		UpdateTime:       timestamppb.New(doc.UpdateTime),
+	}
+
+	wallet.StateReason, _ = doc.Data()["state_reason"].(string)
+
+	for _, currencyCode := range sortedCurrencies(balances) {
+		wallet.Balances = append(wallet.Balances, &pb.Wallet_Balance{
+			CurrencyCode:     currencyCode,
+			Balance:          balances[currencyCode],
+			AvailableBalance: balances[currencyCode] - heldAmount(holds, "", currencyCode),
+		})
 	}
 
 	return &wallet, nil
 
 }
 
+// CreateWallet creates an empty wallet for a user with a primary currency, or the default currency if currencyCode is empty.
+// Creating a wallet that exists already returns the existing wallet.
+func (r *FirestoreImpl) CreateWallet(ctx context.Context, log logger.Logger, userId string, currencyCode string) (*pb.Wallet, error) {
+	_, err := r.firestore.Collection("wallets").Doc(userId).Create(ctx, map[string]interface{}{
+		"balance":       int64(0),
+		"currency_code": money.CurrencyCode(currencyCode),
+		"state":         pb.Wallet_STATE_ACTIVE.String(),
+		"create_time":   time.Now(),
+	})
+
+	if status.Code(err) == codes.AlreadyExists {
+		log.Info("Wallet already exists")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r.GetWallet(ctx, log, userId)
+}
+
 func (r *FirestoreImpl) CreateTransactions(ctx context.Context, log logger.Logger, entries *Entries) (*string, error) {
 
 	batchId := nanoid.New()
 
 	err := r.firestore.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
-		for _, entry := range *entries {
-			transactionId := nanoid.New()
-			transaction := entry.Transaction
-
-			transaction.Name = fmt.Sprintf("users/%v/wallet/transactions/%v", entry.UserId, transactionId)
-			transaction.BatchId = &batchId
-
-			createTime := time.Now()
-
-			doc := map[string]interface{}{
-				"wallet_id": entry.UserId,
-				"amount":    transaction.Amount,
-				"type":      pb.Transaction_Type_name[int32(transaction.Type.Number())],
-				"batch_id":  batchId,
-				"details": map[string]interface{}{
-					"display_name": transaction.Details.DisplayName,
-					"description":  transaction.Details.Description,
-					"reference":    transaction.Details.Reference,
-				},
-				"create_time": createTime,
+		if err := r.checkWallets(tx, entries, ""); err != nil {
+			return err
+		}
+
+		return r.writeTransactions(tx, batchId, entries)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchId, nil
+}
+
+// writeTransactions adds the transaction documents of a batch, their transaction.created events and the balance changes
+// of their wallets to a transaction.
+// Every transaction records the balance of its wallet before and after it, applying the entries in order, so it reads
+// the wallets of the batch, and the transactions named by the entries, and must be called before any other write of the
+// transaction.
+func (r *FirestoreImpl) writeTransactions(tx *firestore.Transaction, batchId string, entries *Entries) error {
+	balances := map[string]map[string]int64{}
+
+	for _, entry := range *entries {
+		if balances[entry.UserId] != nil {
+			continue
+		}
+
+		doc, err := tx.Get(r.firestore.Doc(fmt.Sprintf("wallets/%v", entry.UserId)))
+
+		if err != nil {
+			return err
+		}
+
+		balances[entry.UserId] = walletBalances(doc)
+	}
+
+	for _, entry := range *entries {
+		if entry.TransactionId == "" {
+			continue
+		}
+
+		_, err := tx.Get(r.firestore.Doc(fmt.Sprintf("transactions/%v", entry.TransactionId)))
+
+		if err == nil {
+			return fmt.Errorf("%w: %v", ErrTransactionExists, entry.TransactionId)
+		}
+
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+
+	for _, entry := range *entries {
+		transactionId := entry.TransactionId
+
+		if transactionId == "" {
+			transactionId = nanoid.New()
+		}
+		transaction := entry.Transaction
+
+		transaction.Name = fmt.Sprintf("users/%v/wallet/transactions/%v", entry.UserId, transactionId)
+		transaction.BatchId = &batchId
+
+		createTime := time.Now()
+
+		if transaction.CurrencyCode == "" {
+			transaction.CurrencyCode = money.DefaultCurrencyCode
+		}
+
+		if transaction.Details == nil {
+			transaction.Details = &pb.Transaction_Details{}
+		}
+
+		amount := transaction.Amount
+
+		if transaction.Type == pb.Transaction_TYPE_DEBIT {
+			amount = -amount
+		}
+
+		balanceBefore := balances[entry.UserId][transaction.CurrencyCode]
+		balanceAfter := balanceBefore + amount
+		balances[entry.UserId][transaction.CurrencyCode] = balanceAfter
+		transaction.BalanceBefore = &balanceBefore
+		transaction.BalanceAfter = &balanceAfter
+
+		doc := map[string]interface{}{
+			"wallet_id":     entry.UserId,
+			"amount":        transaction.Amount,
+			"currency_code": transaction.CurrencyCode,
+			"type":          pb.Transaction_Type_name[int32(transaction.Type.Number())],
+			"batch_id":      batchId,
+			"details": map[string]interface{}{
+				"display_name": transaction.Details.DisplayName,
+				"description":  transaction.Details.Description,
+				"reference":    transaction.Details.Reference,
+			},
+			"create_time":    createTime,
+			"balance_before": balanceBefore,
+			"balance_after":  balanceAfter,
+		}
+
+		if transaction.ReversalOf != nil {
+			doc["reversal_of"] = *transaction.ReversalOf
+		}
+
+		if transaction.Fx != nil {
+			doc["fx"] = map[string]interface{}{
+				"source_currency_code": transaction.Fx.SourceCurrencyCode,
+				"source_amount":        transaction.Fx.SourceAmount,
+				"target_currency_code": transaction.Fx.TargetCurrencyCode,
+				"target_amount":        transaction.Fx.TargetAmount,
 			}
+		}
+
+		transaction.CreateTime = timestamppb.New(createTime)
+
+		if err := tx.Set(r.firestore.Doc(fmt.Sprintf("transactions/%v", transactionId)), doc); err != nil {
+			return err
+		}
 
-			transaction.CreateTime = timestamppb.New(createTime)
+		if err := outbox.Add(tx, r.firestore, outbox.TransactionCreated(transaction)); err != nil {
+			return err
+		}
+
+		err := tx.Update(r.firestore.Doc(fmt.Sprintf("wallets/%v", entry.UserId)), []firestore.Update{
+			{
+				Path:  balanceField(transaction.CurrencyCode),
+				Value: firestore.Increment(amount),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
 
-			if err := tx.Set(r.firestore.Doc(fmt.Sprintf("transactions/%v", transactionId)), doc); err != nil {
+	return nil
+}
+
+// checkWallets fails with ErrWalletNotActive when the state of a wallet does not allow its entries, and with
+// ErrInsufficientFunds when the entries would take the available balance of a wallet in a currency below its floor.
+// The balance floor of a wallet applies to its primary currency, balances in other currencies can not go below 0.
+// The wallets and their holds are read inside the transaction, so concurrent batches can not spend the same balance twice.
+// The hold being captured, if any, is excluded since its funds are the ones being spent.
+func (r *FirestoreImpl) checkWallets(tx *firestore.Transaction, entries *Entries, capturedHoldId string) error {
+	changes := map[string]map[string]int64{}
+	debited := map[string]bool{}
+	restricted := restrictedWallets(entries)
+
+	for _, entry := range *entries {
+		amount := entry.Transaction.Amount
+
+		if entry.Transaction.Type == pb.Transaction_TYPE_DEBIT {
+			amount = -amount
+			debited[entry.UserId] = true
+		}
+
+		if changes[entry.UserId] == nil {
+			changes[entry.UserId] = map[string]int64{}
+		}
+
+		changes[entry.UserId][money.CurrencyCode(entry.Transaction.CurrencyCode)] += amount
+	}
+
+	for userId, currencies := range changes {
+		doc, err := tx.Get(r.firestore.Doc(fmt.Sprintf("wallets/%v", userId)))
+
+		if err != nil {
+			return err
+		}
+
+		if restricted[userId] {
+			if err := checkWalletState(doc, debited[userId]); err != nil {
 				return err
 			}
+		}
 
-			amount := transaction.Amount
+		holds, err := tx.Documents(r.activeHolds(userId)).GetAll()
 
-			if transaction.Type == pb.Transaction_TYPE_DEBIT {
-				amount = -amount
-			}
+		if err != nil {
+			return err
+		}
 
-			err := tx.Update(r.firestore.Doc(fmt.Sprintf("wallets/%v", entry.UserId)), []firestore.Update{
-				{
-					Path:  "balance",
-					Value: firestore.Increment(amount),
-				},
-			})
+		balances := walletBalances(doc)
 
-			if err != nil {
-				return err
+		for currencyCode, change := range currencies {
+			available := balances[currencyCode] - heldAmount(holds, capturedHoldId, currencyCode)
+			floor := int64(0)
+
+			if currencyCode == walletCurrency(doc) {
+				floor = r.balanceFloor(doc)
+			}
+
+			if change < 0 && available+change < floor {
+				return fmt.Errorf("%w in wallet %v in %v", ErrInsufficientFunds, userId, currencyCode)
 			}
 		}
+	}
 
-		return nil
-	})
+	return nil
+}
 
-	if err != nil {
-		return nil, err
+// checkWalletStates fails with ErrWalletNotActive when the state of a wallet does not allow its entries. Unlike
+// checkWallets, it does not hold the entries to the available balance of their wallets.
+func (r *FirestoreImpl) checkWalletStates(tx *firestore.Transaction, entries *Entries) error {
+	debited := map[string]bool{}
+	restricted := restrictedWallets(entries)
+
+	for _, entry := range *entries {
+		debited[entry.UserId] = debited[entry.UserId] || entry.Transaction.Type == pb.Transaction_TYPE_DEBIT
 	}
 
-	return &batchId, nil
+	for userId, debit := range debited {
+		if !restricted[userId] {
+			continue
+		}
+
+		doc, err := tx.Get(r.firestore.Doc(fmt.Sprintf("wallets/%v", userId)))
+
+		if err != nil {
+			return err
+		}
+
+		if err := checkWalletState(doc, debit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restrictedWallets returns the wallets of entries whose state has to allow them, which are all wallets but the ones
+// that are only credited by settlements.
+func restrictedWallets(entries *Entries) map[string]bool {
+	restricted := map[string]bool{}
+
+	for _, entry := range *entries {
+		restricted[entry.UserId] = restricted[entry.UserId] || !entry.Settles || entry.Transaction.Type != pb.Transaction_TYPE_CREDIT
+	}
+
+	return restricted
+}
+
+// checkWalletState fails with ErrWalletNotActive when money can not move in or, if debited is set, out of a wallet.
+func checkWalletState(doc *firestore.DocumentSnapshot, debited bool) error {
+	switch state := walletState(doc); {
+	case state == pb.Wallet_STATE_FROZEN && debited,
+		state == pb.Wallet_STATE_SUSPENDED,
+		state == pb.Wallet_STATE_CLOSED:
+		return fmt.Errorf("%w: wallet %v is %v", ErrWalletNotActive, doc.Ref.ID, state)
+	}
+
+	return nil
+}
+
+// balanceFloor returns the balance floor of a wallet document, or the default floor if the wallet has none.
+func (r *FirestoreImpl) balanceFloor(doc *firestore.DocumentSnapshot) int64 {
+	if floor, ok := money.Amount(doc.Data()["balance_floor"]); ok {
+		return floor
+	}
+
+	return r.config.DefaultBalanceFloor
 }
 
 func (r *FirestoreImpl) GetTransaction(ctx context.Context, log logger.Logger, userId string, transactionId string) (*pb.Transaction, error) {
@@ -150,61 +487,99 @@ func (r *FirestoreImpl) GetTransaction(ctx context.Context, log logger.Logger, u
 	return transaction, nil
 }
 
-func (r *FirestoreImpl) GetTransactions(ctx context.Context, log logger.Logger, userId string, batchId *string) ([]*pb.Transaction, error) {
-	query := r.firestore.Collection("transactions").Where("wallet_id", "==", userId)
-
-	if batchId != nil {
-		query = query.Where("batch_id", "==", *batchId)
-	}
+func (r *FirestoreImpl) GetTransactions(ctx context.Context, log logger.Logger, userId string, filter *Filter, page *pagination.Page) ([]*pb.Transaction, *pagination.Cursor, error) {
+	query, direction := filter.apply(r.firestore.Collection("transactions").Where("wallet_id", "==", userId))
 
-	iter := query.Documents(ctx)
-	defer iter.Stop()
+	docs, err := page.Query(query, direction).Documents(ctx).GetAll()
 
-	var transactions []*pb.Transaction
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for {
-		doc, err := iter.Next()
+	docs, next := page.Next(docs)
 
-		if err == iterator.Done {
-			break
-		}
-
-		if err != nil {
-			return nil, err
-		}
+	transactions := make([]*pb.Transaction, 0, len(docs))
 
+	for _, doc := range docs {
 		transaction := transactionFromDoc(doc)
 
 		transactions = append(transactions, transaction)
 	}
 
-	return transactions, nil
+	return transactions, next, nil
+}
+
+// transactionFromDoc converts a transaction document to a Transaction. Documents written by the old Cloud Functions,
+// which store the wallet id as walletId, the type without its TYPE_ prefix and the amount as a double, are read as well.
+// transactionWalletId returns the wallet id of a transaction document, including legacy documents that store it as walletId.
+func transactionWalletId(data map[string]interface{}) string {
+	if walletId, ok := data["wallet_id"].(string); ok {
+		return walletId
+	}
+
+	walletId, _ := data["walletId"].(string)
+
+	return walletId
+}
+
+// isDebit reports whether a transaction document is a debit. Legacy documents store the type without the TYPE_ prefix.
+func isDebit(data map[string]interface{}) bool {
+	return data["type"] == pb.Transaction_TYPE_DEBIT.String() || data["type"] == "DEBIT"
 }
 
 func transactionFromDoc(doc *firestore.DocumentSnapshot) *pb.Transaction {
+	data := doc.Data()
+	walletId := transactionWalletId(data)
+
+	transactionType, _ := data["type"].(string)
+
+	if !strings.HasPrefix(transactionType, "TYPE_") {
+		transactionType = "TYPE_" + transactionType
+	}
+
 	transaction := &pb.Transaction{
-		Name:       "users/" + doc.Data()["wallet_id"].(string) + "/wallet/transactions/" + doc.Ref.ID,
-		Amount:     doc.Data()["amount"].(int32),
-		CreateTime: timestamppb.New(doc.CreateTime),
-		Type:       pb.Transaction_Type(pb.Transaction_Type_value[doc.Data()["type"].(string)]),
-		Details: &pb.Transaction_Details{
-			DisplayName: doc.Data()["details"].(map[string]interface{})["display_name"].(string),
-			Reference:   doc.Data()["details"].(map[string]interface{})["reference"].(string),
-		},
+		Name:         "users/" + walletId + "/wallet/transactions/" + doc.Ref.ID,
+		CurrencyCode: money.CurrencyCode(data["currency_code"]),
+		CreateTime:   timestamppb.New(doc.CreateTime),
+		Type:         pb.Transaction_Type(pb.Transaction_Type_value[transactionType]),
+		Details:      &pb.Transaction_Details{},
+	}
+
+	transaction.Amount, _ = money.Amount(data["amount"])
+
+	if fx, ok := data["fx"].(map[string]interface{}); ok {
+		transaction.Fx = &pb.FxConversion{
+			SourceCurrencyCode: money.CurrencyCode(fx["source_currency_code"]),
+			TargetCurrencyCode: money.CurrencyCode(fx["target_currency_code"]),
+		}
+		transaction.Fx.SourceAmount, _ = money.Amount(fx["source_amount"])
+		transaction.Fx.TargetAmount, _ = money.Amount(fx["target_amount"])
 	}
+	transaction.ReversedAmount, _ = money.Amount(data["reversed_amount"])
 
-	batchIdData, err := doc.DataAt("batch_id")
+	if balanceBefore, ok := money.Amount(data["balance_before"]); ok {
+		transaction.BalanceBefore = &balanceBefore
+	}
 
-	if err == nil {
-		batchId := batchIdData.(string)
+	if balanceAfter, ok := money.Amount(data["balance_after"]); ok {
+		transaction.BalanceAfter = &balanceAfter
+	}
+
+	if batchId, ok := data["batch_id"].(string); ok {
 		transaction.BatchId = &batchId
 	}
 
-	descriptionData, err := doc.DataAt("details.description")
+	if reversalOf, ok := data["reversal_of"].(string); ok {
+		transaction.ReversalOf = &reversalOf
+	}
 
-	if err == nil {
-		description := descriptionData.(string)
-		transaction.Details.Description = &description
+	if details, ok := data["details"].(map[string]interface{}); ok {
+		transaction.Details.DisplayName, _ = details["display_name"].(string)
+		transaction.Details.Reference, _ = details["reference"].(string)
+
+		if description, ok := details["description"].(string); ok {
+			transaction.Details.Description = &description
+		}
 	}
 
 	return transaction
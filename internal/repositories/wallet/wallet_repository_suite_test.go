@@ -0,0 +1,13 @@
+package walletrepository_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestWalletRepository(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wallet Repository Suite")
+}
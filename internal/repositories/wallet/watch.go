@@ -0,0 +1,148 @@
+package walletrepository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/dragonfish/go/v2/pkg/logger"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/pagination"
+)
+
+// watchClockSkew is how far before the first wallet of a watch without a cursor transactions are listened for.
+// Transactions are stamped with their create time before they are committed, so a transaction committed right after the
+// wallet was read may have been created before it.
+const watchClockSkew = time.Minute
+
+// WalletEvent is either the current state of a watched wallet or a transaction committed on it.
+type WalletEvent struct {
+	Wallet *pb.Wallet
+
+	Transaction *pb.Transaction
+
+	// Cursor is the position of the transaction, to resume the watch after it.
+	Cursor *pagination.Cursor
+}
+
+// WatchWallet sends the wallet to events, then the wallet whenever it changes and every transaction committed on it in
+// create time order, until ctx is done or a listener fails. Without a cursor only transactions committed after the first
+// wallet was read are sent, and with a cursor every transaction after it is sent first, so that a reconnecting watcher
+// does not miss transactions.
+func (r *FirestoreImpl) WatchWallet(ctx context.Context, log logger.Logger, userId string, after *pagination.Cursor, events chan<- *WalletEvent) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make(chan error, 2)
+	started := make(chan time.Time, 1)
+
+	go func() {
+		errs <- r.watchWalletDoc(ctx, log, userId, events, started)
+	}()
+
+	go func() {
+		select {
+		case since := <-started:
+			errs <- r.watchTransactions(ctx, log, userId, after, since, events)
+		case <-ctx.Done():
+			errs <- ctx.Err()
+		}
+	}()
+
+	err := <-errs
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// watchWalletDoc sends the wallet to events every time its document changes, and sends the update time of the first
+// wallet to started once it is sent.
+func (r *FirestoreImpl) watchWalletDoc(ctx context.Context, log logger.Logger, userId string, events chan<- *WalletEvent, started chan<- time.Time) error {
+	iter := r.firestore.Collection("wallets").Doc(userId).Snapshots(ctx)
+	defer iter.Stop()
+
+	for {
+		doc, err := iter.Next()
+
+		if err != nil {
+			return err
+		}
+
+		if !doc.Exists() {
+			return ErrWalletNotFound
+		}
+
+		log.Debug("Wallet changed")
+		wallet, err := r.GetWallet(ctx, log, userId)
+
+		if err != nil {
+			return err
+		}
+
+		select {
+		case events <- &WalletEvent{Wallet: wallet}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if started != nil {
+			started <- wallet.UpdateTime.AsTime()
+			started = nil
+		}
+	}
+}
+
+// watchTransactions sends the transactions of a wallet added after a cursor to events, or the ones committed after since
+// if there is no cursor. Transactions update their wallet in the same commit, so the ones committed at or before the
+// update time of a wallet are already part of its balance.
+func (r *FirestoreImpl) watchTransactions(ctx context.Context, log logger.Logger, userId string, after *pagination.Cursor, since time.Time, events chan<- *WalletEvent) error {
+	query := r.firestore.Collection("transactions").
+		Where("wallet_id", "==", userId).
+		OrderBy("create_time", firestore.Asc).
+		OrderBy(firestore.DocumentID, firestore.Asc)
+
+	if after != nil {
+		query = query.StartAfter(after.CreateTime, after.Id)
+	} else {
+		query = query.Where("create_time", ">=", since.Add(-watchClockSkew))
+	}
+
+	iter := query.Snapshots(ctx)
+	defer iter.Stop()
+
+	for {
+		snapshot, err := iter.Next()
+
+		if err != nil {
+			return err
+		}
+
+		for _, change := range snapshot.Changes {
+			if change.Kind != firestore.DocumentAdded {
+				continue
+			}
+
+			// Without a cursor, transactions committed before the first wallet was read are part of its balance.
+			if after == nil && !change.Doc.CreateTime.After(since) {
+				continue
+			}
+
+			createTime, _ := change.Doc.Data()["create_time"].(time.Time)
+			event := &WalletEvent{
+				Transaction: transactionFromDoc(change.Doc),
+				Cursor:      &pagination.Cursor{CreateTime: createTime, Id: change.Doc.Ref.ID},
+			}
+
+			log.Debugf("Transaction %s committed", change.Doc.Ref.ID)
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
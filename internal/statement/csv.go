@@ -0,0 +1,28 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+)
+
+// WriteCSV renders a statement as CSV with a header line, the opening balance, a line per transaction and the closing balance.
+// Times are in IST and amounts in the major unit of the currency of the statement.
+func WriteCSV(w io.Writer, s *pb.Statement) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"Time (IST)", "Description", "Reference", "Type", "Amount (" + s.CurrencyCode + ")", "Balance (" + s.CurrencyCode + ")"}); err != nil {
+		return err
+	}
+
+	for _, row := range rows(s) {
+		if err := writer.Write([]string{row.Time, row.Description, row.Reference, row.Type, row.Amount, row.Balance}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,149 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+)
+
+// Layout of the PDF in points. Pages are A4 and text is set in 9 point Courier, so columns line up without font metrics.
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	margin       = 40
+	fontSize     = 9
+	leading      = 12
+	linesPerPage = (pageHeight - 2*margin) / leading
+)
+
+const rowFormat = "%-19s  %-30s  %-6s  %12s  %12s"
+
+// WritePDF renders a statement as a PDF with a summary followed by a table of the transactions, repeating the table
+// header on every page. Times are in IST and amounts in the major unit of the currency of the statement.
+func WritePDF(w io.Writer, s *pb.Statement) error {
+	start := s.Interval.GetStartTime().AsTime().In(IST).Format(time.DateTime)
+	end := s.Interval.GetEndTime().AsTime().In(IST).Format(time.DateTime)
+
+	summary := []string{
+		"Wallet statement",
+		"",
+		"Wallet:          " + s.Wallet,
+		"Period:          " + start + " to " + end + " IST",
+		"Currency:        " + s.CurrencyCode,
+		"Opening balance: " + money.Format(s.OpeningBalance, s.CurrencyCode),
+		"Closing balance: " + money.Format(s.ClosingBalance, s.CurrencyCode),
+		fmt.Sprintf("Credits:         %s in %d transactions", money.Format(s.TotalCredits, s.CurrencyCode), s.CreditCount),
+		fmt.Sprintf("Debits:          %s in %d transactions", money.Format(s.TotalDebits, s.CurrencyCode), s.DebitCount),
+		"",
+	}
+
+	header := []string{
+		fmt.Sprintf(rowFormat, "Time", "Description", "Type", "Amount", "Balance"),
+		strings.Repeat("-", len(fmt.Sprintf(rowFormat, "", "", "", "", ""))),
+	}
+
+	var pages [][]string
+	page := append([]string{}, summary...)
+	page = append(page, header...)
+
+	for _, row := range rows(s) {
+		if len(page) == linesPerPage {
+			pages = append(pages, page)
+			page = append([]string{}, header...)
+		}
+
+		page = append(page, fmt.Sprintf(rowFormat, row.Time, truncate(row.Description, 30), row.Type, row.Amount, row.Balance))
+	}
+
+	pages = append(pages, page)
+
+	return writePages(w, pages)
+}
+
+// writePages writes a PDF document with a page for every slice of lines and a page number at the bottom of each page.
+// Objects 1 to 3 are the catalog, the page tree and the font, followed by a page and its content stream for every page.
+func writePages(w io.Writer, pages [][]string) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	kids := make([]string, 0, len(pages))
+
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range pages {
+		var content strings.Builder
+
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin)
+
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) '\n", escape(line))
+		}
+
+		fmt.Fprintf(&content, "ET\nBT\n/F1 %d Tf\n%d %d Td\n(%s) Tj\nET\n", fontSize, margin, margin/2, escape(fmt.Sprintf("Page %d of %d", i+1, len(pages))))
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, 5+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// escape escapes a line for a PDF string literal. Characters outside printable ASCII are replaced with "?".
+func escape(line string) string {
+	var escaped strings.Builder
+
+	for _, r := range line {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < ' ' || r > '~':
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
+
+// truncate shortens a string to at most n characters.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n-3]) + "..."
+}
@@ -0,0 +1,85 @@
+// Package statement renders wallet statements as CSV and PDF files.
+// Statement periods and times are in Indian Standard Time, which has no daylight saving time.
+package statement
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/money"
+)
+
+const (
+	CSVContentType = "text/csv"
+	PDFContentType = "application/pdf"
+)
+
+// IST is Indian Standard Time.
+var IST = time.FixedZone("IST", 5*60*60+30*60)
+
+// Month returns the start and the end of a calendar month in IST, for example "2024-05".
+func Month(month string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation("2006-01", month, IST)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid month %q: %w", month, err)
+	}
+
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// row is a line of a rendered statement.
+type row struct {
+	Time        string
+	Description string
+	Reference   string
+	Type        string
+	Amount      string
+	Balance     string
+}
+
+// rows returns the lines of a statement: the opening balance, a line per transaction with the balance after it,
+// and the closing balance.
+func rows(s *pb.Statement) []row {
+	start := s.Interval.GetStartTime().AsTime().In(IST).Format(time.DateTime)
+	end := s.Interval.GetEndTime().AsTime().In(IST).Format(time.DateTime)
+
+	rows := make([]row, 0, len(s.Transactions)+2)
+	rows = append(rows, row{
+		Time:        start,
+		Description: "Opening balance",
+		Balance:     money.Format(s.OpeningBalance, s.CurrencyCode),
+	})
+
+	balance := s.OpeningBalance
+
+	for _, transaction := range s.Transactions {
+		amount := transaction.Amount
+		transactionType := "Credit"
+
+		if transaction.Type == pb.Transaction_TYPE_DEBIT {
+			amount = -amount
+			transactionType = "Debit"
+		}
+
+		balance += amount
+
+		rows = append(rows, row{
+			Time:        transaction.CreateTime.AsTime().In(IST).Format(time.DateTime),
+			Description: transaction.Details.GetDisplayName(),
+			Reference:   transaction.Details.GetReference(),
+			Type:        transactionType,
+			Amount:      money.Format(amount, s.CurrencyCode),
+			Balance:     money.Format(balance, s.CurrencyCode),
+		})
+	}
+
+	rows = append(rows, row{
+		Time:        end,
+		Description: "Closing balance",
+		Balance:     money.Format(s.ClosingBalance, s.CurrencyCode),
+	})
+
+	return rows
+}
@@ -0,0 +1,156 @@
+package statement_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	pb "github.com/ride-app/payments-service/api/ride/payments/v1alpha1"
+	"github.com/ride-app/payments-service/internal/statement"
+	"google.golang.org/genproto/googleapis/type/interval"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Statement", func() {
+	var s *pb.Statement
+
+	BeforeEach(func() {
+		start, end, err := statement.Month("2024-05")
+		Expect(err).NotTo(HaveOccurred())
+
+		s = &pb.Statement{
+			Wallet:         "users/user1/wallet",
+			Interval:       &interval.Interval{StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)},
+			CurrencyCode:   "INR",
+			OpeningBalance: 10000,
+			ClosingBalance: 12500,
+			TotalCredits:   5000,
+			TotalDebits:    2500,
+			CreditCount:    1,
+			DebitCount:     1,
+			Transactions: []*pb.Transaction{
+				{
+					Type:       pb.Transaction_TYPE_CREDIT,
+					Amount:     5000,
+					CreateTime: timestamppb.New(time.Date(2024, 5, 1, 18, 45, 0, 0, time.UTC)),
+					Details:    &pb.Transaction_Details{DisplayName: "Recharge", Reference: "users/user1/wallet/recharges/recharge1"},
+				},
+				{
+					Type:       pb.Transaction_TYPE_DEBIT,
+					Amount:     2500,
+					CreateTime: timestamppb.New(time.Date(2024, 5, 2, 4, 0, 0, 0, time.UTC)),
+					Details:    &pb.Transaction_Details{DisplayName: "Ride fare (airport)", Reference: "rides/ride1"},
+				},
+			},
+		}
+	})
+
+	Describe("Month", func() {
+		It("returns the calendar month in IST", func() {
+			start, end, err := statement.Month("2024-05")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(start.UTC()).To(Equal(time.Date(2024, 4, 30, 18, 30, 0, 0, time.UTC)))
+			Expect(end.UTC()).To(Equal(time.Date(2024, 5, 31, 18, 30, 0, 0, time.UTC)))
+		})
+
+		It("rolls over to the next year in December", func() {
+			_, end, err := statement.Month("2024-12")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(end.In(statement.IST).Format(time.DateTime)).To(Equal("2025-01-01 00:00:00"))
+		})
+
+		DescribeTable("rejects invalid months",
+			func(month string) {
+				_, _, err := statement.Month(month)
+
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("month out of range", "2024-13"),
+			Entry("date", "2024-05-01"),
+			Entry("empty", ""),
+		)
+	})
+
+	Describe("WriteCSV", func() {
+		It("writes the opening balance, the transactions with running balances and the closing balance in IST", func() {
+			var buf bytes.Buffer
+
+			Expect(statement.WriteCSV(&buf, s)).To(Succeed())
+
+			records, err := csv.NewReader(&buf).ReadAll()
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([][]string{
+				{"Time (IST)", "Description", "Reference", "Type", "Amount (INR)", "Balance (INR)"},
+				{"2024-05-01 00:00:00", "Opening balance", "", "", "", "100.00"},
+				{"2024-05-02 00:15:00", "Recharge", "users/user1/wallet/recharges/recharge1", "Credit", "50.00", "150.00"},
+				{"2024-05-02 09:30:00", "Ride fare (airport)", "rides/ride1", "Debit", "-25.00", "125.00"},
+				{"2024-06-01 00:00:00", "Closing balance", "", "", "", "125.00"},
+			}))
+		})
+	})
+
+	Describe("WritePDF", func() {
+		render := func() string {
+			var buf bytes.Buffer
+
+			Expect(statement.WritePDF(&buf, s)).To(Succeed())
+
+			return buf.String()
+		}
+
+		It("writes a PDF document with the summary and the transactions", func() {
+			pdf := render()
+
+			Expect(pdf).To(HavePrefix("%PDF-1.4\n"))
+			Expect(pdf).To(HaveSuffix("%%EOF\n"))
+			Expect(pdf).To(ContainSubstring("Period:          2024-05-01 00:00:00 to 2024-06-01 00:00:00 IST"))
+			Expect(pdf).To(ContainSubstring("Credits:         50.00 in 1 transactions"))
+			Expect(pdf).To(ContainSubstring(`Ride fare \(airport\)`))
+			Expect(pdf).To(ContainSubstring("(Page 1 of 1) Tj"))
+		})
+
+		It("points the cross-reference table at every object", func() {
+			pdf := render()
+
+			xref := strings.LastIndex(pdf, "\nxref\n") + 1
+			Expect(pdf).To(ContainSubstring(fmt.Sprintf("startxref\n%d\n", xref)))
+
+			lines := strings.Split(pdf[xref:], "\n")
+
+			for i, line := range lines[3:] {
+				if !strings.HasSuffix(line, " n ") {
+					break
+				}
+
+				var offset int
+				_, err := fmt.Sscanf(line, "%010d", &offset)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pdf[offset:]).To(HavePrefix(fmt.Sprintf("%d 0 obj\n", i+1)))
+			}
+		})
+
+		It("spreads long statements over pages", func() {
+			transaction := s.Transactions[0]
+
+			for i := 0; i < 100; i++ {
+				s.Transactions = append(s.Transactions, transaction)
+			}
+
+			Expect(render()).To(ContainSubstring("(Page 2 of 2) Tj"))
+		})
+
+		It("replaces characters Courier can not show", func() {
+			s.Transactions[0].Details.DisplayName = "Recharge ₹"
+
+			Expect(render()).To(ContainSubstring("Recharge ?"))
+		})
+	})
+})
@@ -0,0 +1,264 @@
+// Package events encodes and decodes the events published by the payments service. Events are published to Pub/Sub
+// as binary protobuf messages of the ride.payments.events.v1 package, with the CloudEvents attributes of the envelope
+// in the attributes of the Pub/Sub message, as defined by the CloudEvents Pub/Sub protocol binding.
+//
+// Consumers decode a received message with DecodeMessage, or with Decode for other transports, and switch on the type
+// of Event.Data. Delivery is at least once, so consumers should deduplicate events by Event.Id.
+//
+//	event, err := events.DecodeMessage(message)
+//
+//	if errors.Is(err, events.ErrUnknownType) {
+//		message.Ack()
+//		return
+//	}
+//
+//	switch data := event.Data.(type) {
+//	case *eventsv1.PayoutStatusChanged:
+//		...
+//	}
+package events
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	eventsv1 "github.com/ride-app/payments-service/api/ride/payments/events/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification of the envelope.
+	SpecVersion = "1.0"
+
+	// Source is the source of every event published by the payments service.
+	Source = "ride-app/payments-service"
+
+	// ContentType is the content type of the data of every event.
+	ContentType = "application/protobuf"
+
+	// SchemaVersion is the version of the schema of the data of published events. Its major version is the version of
+	// the proto package the data is defined in, and its minor version is increased whenever fields are added.
+	SchemaVersion = "1.0"
+)
+
+// Types of the events published by the payments service.
+const (
+	TypeTransactionCreated    = "ride.payments.transaction.created"
+	TypeTransferCreated       = "ride.payments.transfer.created"
+	TypePayoutStatusChanged   = "ride.payments.payout.status_changed"
+	TypeRechargeStatusChanged = "ride.payments.recharge.status_changed"
+	TypePayoutAccountUpdated  = "ride.payments.payout_account.updated"
+)
+
+// Attributes of the envelope, as named in Pub/Sub message attributes and HTTP headers.
+const (
+	attributeId            = "ce-id"
+	attributeSource        = "ce-source"
+	attributeSpecVersion   = "ce-specversion"
+	attributeType          = "ce-type"
+	attributeSubject       = "ce-subject"
+	attributeTime          = "ce-time"
+	attributeDataSchema    = "ce-dataschema"
+	attributeSchemaVersion = "ce-schemaversion"
+	attributeContentType   = "content-type"
+)
+
+var (
+	// ErrUnknownType is returned when decoding an event of a type this version of the library does not know.
+	// Consumers that only handle some types can ignore these events.
+	ErrUnknownType = errors.New("unknown event type")
+
+	// ErrUnsupportedVersion is returned when decoding an event of a spec or schema major version this version of the
+	// library can not read.
+	ErrUnsupportedVersion = errors.New("unsupported event version")
+
+	// ErrInvalidEvent is returned when decoding an event with missing or malformed attributes or data.
+	ErrInvalidEvent = errors.New("invalid event")
+)
+
+// schemas maps each event type to the message its data is encoded as.
+var schemas = map[string]proto.Message{
+	TypeTransactionCreated:    (*eventsv1.TransactionCreated)(nil),
+	TypeTransferCreated:       (*eventsv1.TransferCreated)(nil),
+	TypePayoutStatusChanged:   (*eventsv1.PayoutStatusChanged)(nil),
+	TypeRechargeStatusChanged: (*eventsv1.RechargeStatusChanged)(nil),
+	TypePayoutAccountUpdated:  (*eventsv1.PayoutAccountUpdated)(nil),
+}
+
+// Event is an event published by the payments service.
+type Event struct {
+	// Id is unique per event. An event delivered more than once has the same id every time.
+	Id string
+
+	Type string
+
+	Source string
+
+	// Subject is the relative resource name of the resource the event is about, for example "users/user1/wallet/payouts/payout1".
+	Subject string
+
+	// Time is when the change described by the event was made.
+	Time time.Time
+
+	// SchemaVersion is the version of the schema Data was encoded with. Encoding an event without one stamps SchemaVersion.
+	SchemaVersion string
+
+	// Data is one of the messages of the ride.payments.events.v1 package, depending on Type.
+	Data proto.Message
+}
+
+// DataSchema returns the schema of the data of events of a type, which is the type URL of its message.
+func DataSchema(eventType string) (string, error) {
+	schema, ok := schemas[eventType]
+
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownType, eventType)
+	}
+
+	return "type.googleapis.com/" + string(schema.ProtoReflect().Descriptor().FullName()), nil
+}
+
+// Attributes returns the envelope of an event as Pub/Sub message attributes. Data is not used.
+func Attributes(event *Event) (map[string]string, error) {
+	dataSchema, err := DataSchema(event.Type)
+
+	if err != nil {
+		return nil, err
+	}
+
+	source := event.Source
+
+	if source == "" {
+		source = Source
+	}
+
+	schemaVersion := event.SchemaVersion
+
+	if schemaVersion == "" {
+		schemaVersion = SchemaVersion
+	}
+
+	attributes := map[string]string{
+		attributeId:            event.Id,
+		attributeSource:        source,
+		attributeSpecVersion:   SpecVersion,
+		attributeType:          event.Type,
+		attributeTime:          event.Time.UTC().Format(time.RFC3339Nano),
+		attributeDataSchema:    dataSchema,
+		attributeSchemaVersion: schemaVersion,
+		attributeContentType:   ContentType,
+	}
+
+	if event.Subject != "" {
+		attributes[attributeSubject] = event.Subject
+	}
+
+	return attributes, nil
+}
+
+// Encode returns the envelope and the binary protobuf data of an event, to publish as a Pub/Sub message.
+func Encode(event *Event) (map[string]string, []byte, error) {
+	attributes, err := Attributes(event)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := schemas[event.Type].ProtoReflect().Descriptor().FullName()
+
+	if event.Data == nil || event.Data.ProtoReflect().Descriptor().FullName() != schema {
+		return nil, nil, fmt.Errorf("%w: data of %s event is not a %s", ErrInvalidEvent, event.Type, schema)
+	}
+
+	data, err := proto.Marshal(event.Data)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attributes, data, nil
+}
+
+// DecodeMessage decodes an event received from a Pub/Sub subscription.
+func DecodeMessage(message *pubsub.Message) (*Event, error) {
+	return Decode(message.Attributes, message.Data)
+}
+
+// DecodeRequest decodes an event delivered over HTTP in binary content mode, where the attributes of the envelope are
+// sent as headers and the data as the body.
+func DecodeRequest(header http.Header, body []byte) (*Event, error) {
+	attributes := make(map[string]string, len(header))
+
+	for key := range header {
+		attributes[strings.ToLower(key)] = header.Get(key)
+	}
+
+	return Decode(attributes, body)
+}
+
+// Decode decodes an event from the attributes of its envelope and its binary protobuf data. Fields added to the data
+// by later minor versions of the schema are ignored.
+func Decode(attributes map[string]string, data []byte) (*Event, error) {
+	if specVersion := attributes[attributeSpecVersion]; majorVersion(specVersion) != majorVersion(SpecVersion) {
+		return nil, fmt.Errorf("%w: spec version %q", ErrUnsupportedVersion, specVersion)
+	}
+
+	eventType := attributes[attributeType]
+	schema, ok := schemas[eventType]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, eventType)
+	}
+
+	// Events published before schema versions were stamped are of the first version.
+	schemaVersion := attributes[attributeSchemaVersion]
+
+	if schemaVersion == "" {
+		schemaVersion = SchemaVersion
+	}
+
+	if majorVersion(schemaVersion) != majorVersion(SchemaVersion) {
+		return nil, fmt.Errorf("%w: schema version %q of %s", ErrUnsupportedVersion, schemaVersion, eventType)
+	}
+
+	if contentType := attributes[attributeContentType]; contentType != "" && contentType != ContentType {
+		return nil, fmt.Errorf("%w: content type %q", ErrInvalidEvent, contentType)
+	}
+
+	if attributes[attributeId] == "" {
+		return nil, fmt.Errorf("%w: missing id", ErrInvalidEvent)
+	}
+
+	eventTime, err := time.Parse(time.RFC3339Nano, attributes[attributeTime])
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: time: %v", ErrInvalidEvent, err)
+	}
+
+	message := schema.ProtoReflect().Type().New().Interface()
+
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, fmt.Errorf("%w: data: %v", ErrInvalidEvent, err)
+	}
+
+	return &Event{
+		Id:            attributes[attributeId],
+		Type:          eventType,
+		Source:        attributes[attributeSource],
+		Subject:       attributes[attributeSubject],
+		Time:          eventTime,
+		SchemaVersion: schemaVersion,
+		Data:          message,
+	}, nil
+}
+
+// majorVersion returns the major version of a version string such as "1.0".
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+
+	return major
+}
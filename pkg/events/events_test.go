@@ -0,0 +1,164 @@
+package events_test
+
+import (
+	"net/http"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	eventsv1 "github.com/ride-app/payments-service/api/ride/payments/events/v1"
+	"github.com/ride-app/payments-service/pkg/events"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Events", func() {
+	var event *events.Event
+
+	BeforeEach(func() {
+		event = &events.Event{
+			Id:      "event1",
+			Type:    events.TypePayoutStatusChanged,
+			Subject: "users/user1/wallet/payouts/payout1",
+			Time:    time.Date(2024, 5, 1, 10, 30, 0, 123, time.UTC),
+			Data: &eventsv1.PayoutStatusChanged{
+				Payout:       "users/user1/wallet/payouts/payout1",
+				Wallet:       "users/user1/wallet",
+				Amount:       700,
+				CurrencyCode: "INR",
+			},
+		}
+	})
+
+	// expectEqual checks that a decoded event is the event that was encoded.
+	expectEqual := func(decoded *events.Event) {
+		Expect(decoded.Id).To(Equal(event.Id))
+		Expect(decoded.Type).To(Equal(event.Type))
+		Expect(decoded.Source).To(Equal(events.Source))
+		Expect(decoded.Subject).To(Equal(event.Subject))
+		Expect(decoded.Time.Equal(event.Time)).To(BeTrue())
+		Expect(decoded.SchemaVersion).To(Equal(events.SchemaVersion))
+		Expect(proto.Equal(decoded.Data, event.Data)).To(BeTrue())
+	}
+
+	Describe("Encode", func() {
+		It("stamps the source, schema version and data schema of the event", func() {
+			attributes, _, err := events.Encode(event)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attributes).To(HaveKeyWithValue("ce-source", events.Source))
+			Expect(attributes).To(HaveKeyWithValue("ce-schemaversion", events.SchemaVersion))
+			Expect(attributes).To(HaveKeyWithValue("ce-dataschema", "type.googleapis.com/ride.payments.events.v1.PayoutStatusChanged"))
+			Expect(attributes).To(HaveKeyWithValue("content-type", events.ContentType))
+		})
+
+		It("rejects events of unknown types", func() {
+			event.Type = "ride.payments.wallet.deleted"
+
+			_, _, err := events.Encode(event)
+
+			Expect(err).To(MatchError(events.ErrUnknownType))
+		})
+
+		It("rejects data that is not the message of the event type", func() {
+			event.Data = &eventsv1.TransferCreated{}
+
+			_, _, err := events.Encode(event)
+
+			Expect(err).To(MatchError(events.ErrInvalidEvent))
+		})
+	})
+
+	Describe("Decode", func() {
+		var (
+			attributes map[string]string
+			data       []byte
+		)
+
+		BeforeEach(func() {
+			var err error
+			attributes, data, err = events.Encode(event)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("decodes an encoded event", func() {
+			decoded, err := events.Decode(attributes, data)
+
+			Expect(err).NotTo(HaveOccurred())
+			expectEqual(decoded)
+		})
+
+		It("decodes an event received from a subscription", func() {
+			decoded, err := events.DecodeMessage(&pubsub.Message{Attributes: attributes, Data: data})
+
+			Expect(err).NotTo(HaveOccurred())
+			expectEqual(decoded)
+		})
+
+		It("decodes an event delivered over HTTP", func() {
+			header := http.Header{}
+
+			for key, value := range attributes {
+				header.Set(key, value)
+			}
+
+			decoded, err := events.DecodeRequest(header, data)
+
+			Expect(err).NotTo(HaveOccurred())
+			expectEqual(decoded)
+		})
+
+		It("decodes events of later minor schema versions", func() {
+			attributes["ce-schemaversion"] = "1.7"
+
+			decoded, err := events.Decode(attributes, data)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.SchemaVersion).To(Equal("1.7"))
+		})
+
+		It("decodes events published before schema versions were stamped as the first version", func() {
+			delete(attributes, "ce-schemaversion")
+
+			decoded, err := events.Decode(attributes, data)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded.SchemaVersion).To(Equal(events.SchemaVersion))
+		})
+
+		It("rejects events of unknown types", func() {
+			attributes["ce-type"] = "ride.payments.wallet.deleted"
+
+			_, err := events.Decode(attributes, data)
+
+			Expect(err).To(MatchError(events.ErrUnknownType))
+		})
+
+		DescribeTable("rejects events of unsupported major versions",
+			func(attribute string, version string) {
+				attributes[attribute] = version
+
+				_, err := events.Decode(attributes, data)
+
+				Expect(err).To(MatchError(events.ErrUnsupportedVersion))
+			},
+			Entry("spec version", "ce-specversion", "2.0"),
+			Entry("missing spec version", "ce-specversion", ""),
+			Entry("schema version", "ce-schemaversion", "2.0"),
+		)
+
+		DescribeTable("rejects invalid events",
+			func(attribute string, value string) {
+				attributes[attribute] = value
+
+				_, err := events.Decode(attributes, data)
+
+				Expect(err).To(MatchError(events.ErrInvalidEvent))
+			},
+			Entry("missing id", "ce-id", ""),
+			Entry("malformed time", "ce-time", "yesterday"),
+			Entry("other content type", "content-type", "application/json"),
+		)
+	})
+})